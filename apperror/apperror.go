@@ -0,0 +1,144 @@
+package apperror
+
+import (
+	"errors"
+	"halill/i18n"
+	"net/http"
+)
+
+// Kind 는 에러의 종류입니다. 응답의 HTTP 상태 코드는 Kind 로 정해집니다.
+type Kind int
+
+const (
+	KindBadRequest Kind = iota + 1
+	KindNotFound
+	KindForbidden
+	KindConflict
+	KindValidation
+	KindUnauthorized
+	KindUnavailable
+	KindTooManyRequests
+)
+
+func (k Kind) Status() int {
+	switch k {
+	case KindBadRequest:
+		return http.StatusBadRequest
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindForbidden:
+		return http.StatusForbidden
+	case KindConflict:
+		return http.StatusConflict
+	case KindValidation:
+		return http.StatusUnprocessableEntity
+	case KindUnauthorized:
+		return http.StatusUnauthorized
+	case KindUnavailable:
+		return http.StatusServiceUnavailable
+	case KindTooManyRequests:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Error 는 서비스와 저장소가 반환하는 도메인 에러입니다.
+// Code 는 클라이언트가 분기에 사용하는 고정된 식별자이고, 사람이 읽는 메시지는 i18n 카탈로그에서 Code 로 찾습니다.
+type Error struct {
+	Kind   Kind
+	Code   string
+	Fields []FieldError
+	Err    error
+}
+
+// FieldError 는 요청 본문의 한 필드가 어긴 규칙입니다.
+type FieldError struct {
+	// Field 는 json 필드 이름입니다. (예: title, tag_ids[0])
+	Field string
+	// Rule 은 어긴 규칙입니다. (예: required, max_length)
+	Rule string
+	// Param 은 규칙의 인자입니다. (예: max_length 200 의 200)
+	Param string
+}
+
+func New(kind Kind, code string) *Error {
+	return &Error{Kind: kind, Code: code}
+}
+
+func BadRequest(code string) *Error {
+	return New(KindBadRequest, code)
+}
+
+func NotFound(code string) *Error {
+	return New(KindNotFound, code)
+}
+
+func Forbidden(code string) *Error {
+	return New(KindForbidden, code)
+}
+
+func Conflict(code string) *Error {
+	return New(KindConflict, code)
+}
+
+func Validation(code string) *Error {
+	return New(KindValidation, code)
+}
+
+func Unauthorized(code string) *Error {
+	return New(KindUnauthorized, code)
+}
+
+func Unavailable(code string) *Error {
+	return New(KindUnavailable, code)
+}
+
+func TooManyRequests(code string) *Error {
+	return New(KindTooManyRequests, code)
+}
+
+// Message 는 locale 로 번역한 메시지를 반환합니다.
+func (e *Error) Message(locale i18n.Locale) string {
+	return i18n.Message(locale, e.Code)
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message(i18n.Default) + ": " + e.Err.Error()
+	}
+	return e.Message(i18n.Default)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is 는 Code 가 같으면 같은 에러로 봅니다. 원인을 붙인 에러도 errors.Is 로 미리 정의된 에러와 비교할 수 있습니다.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// Wrap 은 원인 err 를 붙인 복사본을 반환합니다. 미리 정의된 에러는 그대로 둡니다.
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+	return &wrapped
+}
+
+// WithFields 는 필드 에러를 붙인 복사본을 반환합니다.
+func (e *Error) WithFields(fields ...FieldError) *Error {
+	withFields := *e
+	withFields.Fields = fields
+	return &withFields
+}
+
+// As 는 err 체인에서 도메인 에러를 찾습니다.
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
@@ -0,0 +1,39 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError(t *testing.T) {
+	t.Run("Kind 로 상태 코드 결정", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, ErrTodoNotFound.Kind.Status())
+		assert.Equal(t, http.StatusForbidden, ErrForbidden.Kind.Status())
+		assert.Equal(t, http.StatusConflict, ErrTagExists.Kind.Status())
+		assert.Equal(t, http.StatusBadRequest, ErrInvalidCursor.Kind.Status())
+		assert.Equal(t, http.StatusUnprocessableEntity, ErrInvalidPriority.Kind.Status())
+		assert.Equal(t, http.StatusUnauthorized, ErrInvalidToken.Kind.Status())
+		assert.Equal(t, http.StatusTooManyRequests, ErrVerificationRateLimited.Kind.Status())
+	})
+	t.Run("원인을 감싸도 같은 에러", func(t *testing.T) {
+		cause := errors.New("illegal base64 data")
+		err := fmt.Errorf("decode cursor: %w", ErrInvalidCursor.Wrap(cause))
+
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+		assert.True(t, errors.Is(err, cause))
+		assert.False(t, errors.Is(err, ErrInvalidSort))
+		assert.Nil(t, ErrInvalidCursor.Err)
+	})
+	t.Run("체인에서 도메인 에러 찾기", func(t *testing.T) {
+		ae, ok := As(fmt.Errorf("get todo: %w", ErrTodoNotFound))
+		assert.True(t, ok)
+		assert.Equal(t, "todo_not_found", ae.Code)
+
+		_, ok = As(errors.New("connection refused"))
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,51 @@
+package apperror
+
+// 응답의 code 로 내려가는 값이므로 한 번 정한 Code 는 바꾸지 않습니다.
+// 새 에러를 추가하면 i18n/locales 의 모든 번들에 메시지를 추가합니다.
+var (
+	ErrUserNotFound          = NotFound("user_not_found")
+	ErrTodoNotFound          = NotFound("todo_not_found")
+	ErrTrashedTodoNotFound   = NotFound("trashed_todo_not_found")
+	ErrTagNotFound           = NotFound("tag_not_found")
+	ErrProjectNotFound       = NotFound("project_not_found")
+	ErrChecklistItemNotFound = NotFound("checklist_item_not_found")
+	ErrReminderNotFound      = NotFound("reminder_not_found")
+
+	ErrForbidden        = Forbidden("forbidden")
+	ErrEmailNotVerified = Forbidden("email_not_verified")
+
+	ErrEmailTaken = Conflict("email_taken")
+	ErrTagExists  = Conflict("tag_exists")
+
+	ErrInvalidCredentials = Unauthorized("invalid_credentials")
+	ErrInvalidToken       = Unauthorized("invalid_token")
+	ErrExpiredToken       = Unauthorized("expired_token")
+	ErrRevokedToken       = Unauthorized("revoked_token")
+	ErrReusedToken        = Unauthorized("reused_token")
+
+	ErrInvalidPathParam         = BadRequest("invalid_path_param")
+	ErrInvalidBody              = BadRequest("invalid_body")
+	ErrInvalidCursor            = BadRequest("invalid_cursor")
+	ErrInvalidSort              = BadRequest("invalid_sort")
+	ErrInvalidProjectFilter     = BadRequest("invalid_project_filter")
+	ErrInvalidTagMode           = BadRequest("invalid_tag_mode")
+	ErrEmptySearchQuery         = BadRequest("empty_search_query")
+	ErrInvalidProjectDeleteMode = BadRequest("invalid_project_delete_mode")
+	ErrInvalidResetToken        = BadRequest("invalid_reset_token")
+	ErrInvalidVerificationToken = BadRequest("invalid_verification_token")
+
+	// ErrValidationFailed 는 요청 본문이 dto 의 validate 태그를 어겼을 때 필드 에러와 함께 반환합니다.
+	ErrValidationFailed        = Validation("validation_failed")
+	ErrInvalidPriority         = Validation("invalid_priority")
+	ErrInvalidRecurrence       = Validation("invalid_recurrence")
+	ErrRecurrenceNeedsDeadline = Validation("recurrence_needs_deadline")
+	ErrInvalidTimezone         = Validation("invalid_timezone")
+	ErrInvalidChecklistOrder   = Validation("invalid_checklist_order")
+	ErrInvalidReminderOffset   = Validation("invalid_reminder_offset")
+	ErrReminderNeedsDeadline   = Validation("reminder_needs_deadline")
+	ErrInvalidLocale           = Validation("invalid_locale")
+
+	ErrTimeout = Unavailable("timeout")
+
+	ErrVerificationRateLimited = TooManyRequests("verification_rate_limited")
+)
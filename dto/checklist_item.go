@@ -0,0 +1,39 @@
+package dto
+
+import "halill/ent"
+
+type CreateChecklistItemRequest struct {
+	Title string `json:"title" validate:"required,notblank,max=200"`
+}
+
+type UpdateChecklistItemRequest struct {
+	Title     string `json:"title" validate:"required,notblank,max=200"`
+	IsChecked bool   `json:"is_checked"`
+}
+
+// ReorderChecklistRequest 의 item_ids 는 Todo 의 모든 체크리스트 항목을 원하는 순서로 나열해야 합니다.
+type ReorderChecklistRequest struct {
+	ItemIDs []int64 `json:"item_ids"`
+}
+
+type ChecklistItemResponse struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	IsChecked bool   `json:"is_checked"`
+	Position  int    `json:"position"`
+}
+
+// ProgressResponse 는 체크리스트 진행률입니다. (예: 5 개 중 3 개 완료)
+type ProgressResponse struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+func ChecklistItemToDTO(src *ent.ChecklistItem) *ChecklistItemResponse {
+	return &ChecklistItemResponse{
+		ID:        src.ID,
+		Title:     src.Title,
+		IsChecked: src.IsChecked,
+		Position:  src.Position,
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+// ProblemResponse 는 RFC 7807 의 problem details 에 고정된 에러 코드를 더한 응답입니다.
+type ProblemResponse struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+	// Errors 는 검증에 실패한 필드 목록입니다.
+	Errors []*FieldProblem `json:"errors,omitempty"`
+}
+
+type FieldProblem struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "halill/ent"
+
+type ProjectListRequest struct {
+	Archived *bool `query:"archived"`
+}
+
+type CreateProjectRequest struct {
+	Name  string `json:"name" validate:"required,notblank,max=50"`
+	Color string `json:"color"`
+}
+
+// UpdateProjectRequest 는 PUT 으로 프로젝트의 이름, 색상, 순서를 바꿀 때 사용합니다.
+type UpdateProjectRequest struct {
+	Name     string `json:"name" validate:"required,notblank,max=50"`
+	Color    string `json:"color"`
+	Position int    `json:"position"`
+}
+
+// DeleteProjectRequest 의 mode 가 cascade 이면 Todo 도 함께 삭제하고,
+// inbox(기본값)이면 Todo 를 Inbox 로 옮깁니다.
+type DeleteProjectRequest struct {
+	Mode string `query:"mode"`
+}
+
+type ProjectResponse struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Color    string `json:"color"`
+	Position int    `json:"position"`
+	Archived bool   `json:"archived"`
+}
+
+func ProjectToDTO(src *ent.Project) *ProjectResponse {
+	return &ProjectResponse{
+		ID:       src.ID,
+		Name:     src.Name,
+		Color:    src.Color,
+		Position: src.Position,
+		Archived: src.Archived,
+	}
+}
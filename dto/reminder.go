@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"halill/ent"
+	"time"
+)
+
+// CreateReminderRequest 의 offset_minutes 는 마감일 몇 분 전에 알릴지입니다. (예: 1440 은 하루 전)
+type CreateReminderRequest struct {
+	OffsetMinutes int `json:"offset_minutes"`
+}
+
+type ReminderResponse struct {
+	ID            int64      `json:"id"`
+	OffsetMinutes int        `json:"offset_minutes"`
+	RemindAt      *time.Time `json:"remind_at"`
+	SentAt        *time.Time `json:"sent_at"`
+}
+
+func ReminderToDTO(src *ent.Reminder) *ReminderResponse {
+	return &ReminderResponse{
+		ID:            src.ID,
+		OffsetMinutes: src.OffsetMinutes,
+		RemindAt:      src.RemindAt,
+		SentAt:        src.SentAt,
+	}
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "halill/ent"
+
+type TagRequest struct {
+	Name string `json:"name" validate:"required,notblank,max=50"`
+}
+
+type TodoTagsRequest struct {
+	TagIDs []int64 `json:"tag_ids" validate:"required,min=1"`
+}
+
+type TagResponse struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func TagToDTO(src *ent.Tag) *TagResponse {
+	return &TagResponse{
+		ID:   src.ID,
+		Name: src.Name,
+	}
+}
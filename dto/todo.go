@@ -1,30 +1,282 @@
 package dto
 
 import (
+	"encoding/json"
+	"errors"
 	"halill/ent"
 	"time"
 )
 
 type CreateTodoRequest struct {
-	Title    string     `json:"title"`
-	Content  string     `json:"content"`
-	Deadline *time.Time `json:"deadline,omitempty"`
+	Title        string     `json:"title" validate:"required,notblank,max=200"`
+	Content      string     `json:"content" validate:"max=10000"`
+	Deadline     *time.Time `json:"deadline,omitempty" validate:"omitempty,future"`
+	ProjectID    *int64     `json:"project_id,omitempty"`
+	AutoComplete bool       `json:"auto_complete"`
+	Recurrence   string     `json:"recurrence"`
+	Timezone     string     `json:"timezone"`
+	Priority     string     `json:"priority"`
+}
+
+// UpdateTodoRequest 는 PUT 으로 Todo 전체를 교체할 때 사용합니다.
+// deadline 을 보내지 않으면 마감일이 지워지고, project_id 를 보내지 않으면 Inbox 로 옮겨집니다.
+// recurrence 를 보내지 않으면 반복이 해제되고, timezone 을 보내지 않으면 UTC, priority 를 보내지 않으면 none 이 됩니다.
+type UpdateTodoRequest struct {
+	Title        string     `json:"title" validate:"required,notblank,max=200"`
+	Content      string     `json:"content" validate:"max=10000"`
+	Deadline     *time.Time `json:"deadline"`
+	IsCompleted  bool       `json:"is_completed"`
+	ProjectID    *int64     `json:"project_id"`
+	AutoComplete bool       `json:"auto_complete"`
+	Recurrence   string     `json:"recurrence"`
+	Timezone     string     `json:"timezone"`
+	Priority     string     `json:"priority"`
+}
+
+// PatchTodoRequest 는 JSON Merge Patch(RFC 7386) 문서입니다.
+// 없는 필드는 그대로 두고, deadline 에 null 을 보내면 마감일을 지웁니다.
+// project_id 에 null 을 보내면 Inbox 로 옮기고, recurrence 에 null 을 보내면 반복을 해제합니다.
+// timezone 에 null 을 보내면 UTC 로 되돌립니다.
+type PatchTodoRequest struct {
+	Title        *string `validate:"omitempty,notblank,max=200"`
+	Content      *string `validate:"omitempty,max=10000"`
+	Deadline     *time.Time
+	DeadlineSet  bool
+	IsCompleted  *bool
+	ProjectID    *int64
+	ProjectIDSet bool
+	AutoComplete *bool
+	Recurrence   *string
+	Timezone     *string
+	Priority     *string
+}
+
+func (r *PatchTodoRequest) UnmarshalJSON(data []byte) error {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	for key, value := range fields {
+		isNull := string(value) == "null"
+		switch key {
+		case "title":
+			if isNull {
+				return errors.New("title cannot be null")
+			}
+			if err := json.Unmarshal(value, &r.Title); err != nil {
+				return err
+			}
+		case "content":
+			if isNull {
+				return errors.New("content cannot be null")
+			}
+			if err := json.Unmarshal(value, &r.Content); err != nil {
+				return err
+			}
+		case "deadline":
+			r.DeadlineSet = true
+			if err := json.Unmarshal(value, &r.Deadline); err != nil {
+				return err
+			}
+		case "project_id":
+			r.ProjectIDSet = true
+			if err := json.Unmarshal(value, &r.ProjectID); err != nil {
+				return err
+			}
+		case "auto_complete":
+			if isNull {
+				return errors.New("auto_complete cannot be null")
+			}
+			if err := json.Unmarshal(value, &r.AutoComplete); err != nil {
+				return err
+			}
+		case "recurrence":
+			recurrence := ""
+			if !isNull {
+				if err := json.Unmarshal(value, &recurrence); err != nil {
+					return err
+				}
+			}
+			r.Recurrence = &recurrence
+		case "timezone":
+			timezone := ""
+			if !isNull {
+				if err := json.Unmarshal(value, &timezone); err != nil {
+					return err
+				}
+			}
+			r.Timezone = &timezone
+		case "priority":
+			if isNull {
+				return errors.New("priority cannot be null")
+			}
+			if err := json.Unmarshal(value, &r.Priority); err != nil {
+				return err
+			}
+		case "is_completed":
+			if isNull {
+				return errors.New("is_completed cannot be null")
+			}
+			if err := json.Unmarshal(value, &r.IsCompleted); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Apply 는 patch 에 포함된 필드만 todo 에 반영합니다. priority 는 검증이 필요하므로 서비스에서 반영합니다.
+func (r *PatchTodoRequest) Apply(todo *ent.Todo) {
+	if r.Title != nil {
+		todo.Title = *r.Title
+	}
+	if r.Content != nil {
+		todo.Content = *r.Content
+	}
+	if r.DeadlineSet {
+		todo.Deadline = r.Deadline
+	}
+	if r.IsCompleted != nil {
+		todo.IsCompleted = *r.IsCompleted
+	}
+	if r.AutoComplete != nil {
+		todo.AutoComplete = *r.AutoComplete
+	}
+	if r.Recurrence != nil {
+		todo.Recurrence = *r.Recurrence
+	}
+	if r.Timezone != nil {
+		todo.Timezone = *r.Timezone
+	}
+	if r.ProjectIDSet {
+		todo.Edges.Project = nil
+		if r.ProjectID != nil {
+			todo.Edges.Project = &ent.Project{ID: *r.ProjectID}
+		}
+	}
+}
+
+// TodoListRequest 는 GET /todo 의 query parameter 입니다.
+// sort 는 id, deadline, title 중 하나이며 앞에 - 를 붙이면 내림차순입니다.
+// project 는 프로젝트 ID 이며, inbox 를 보내면 프로젝트가 없는 Todo 만 조회합니다.
+// tag 를 여러 번 보내면 tag_mode 가 and(기본값)일 때는 모든 태그가, or 일 때는 하나 이상의 태그가 붙은 Todo 를 조회합니다.
+type TodoListRequest struct {
+	IsCompleted  *bool      `query:"is_completed"`
+	DeadlineFrom *time.Time `query:"deadline_from"`
+	DeadlineTo   *time.Time `query:"deadline_to"`
+	Overdue      bool       `query:"overdue"`
+	Project      string     `query:"project"`
+	Tags         []int64    `query:"tag"`
+	TagMode      string     `query:"tag_mode"`
+	Sort         string     `query:"sort"`
+	Cursor       string     `query:"cursor"`
+	Limit        int        `query:"limit"`
+}
+
+type TodoPageResponse struct {
+	Items      []*TodoResponse `json:"items"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+	TotalCount int             `json:"total_count"`
+}
+
+type TodoSearchRequest struct {
+	Query string `query:"q"`
+	Limit int    `query:"limit"`
+}
+
+type NextTodosRequest struct {
+	Limit int `query:"limit"`
+}
+
+// NextTodoResponse 의 score 는 우선순위, 마감일까지 남은 시간, 마감일이 지났는지로 계산한 점수이며 높을수록 먼저 할 일입니다.
+type NextTodoResponse struct {
+	*TodoResponse
+	Score float64 `json:"score"`
+}
+
+// TodoSearchResponse 의 snippet 은 HTML escape 된 문자열이며 검색어는 <mark> 로 감싸져 있습니다.
+type TodoSearchResponse struct {
+	*TodoResponse
+	Score   float64 `json:"score"`
+	Snippet string  `json:"snippet"`
 }
 
 type TodoResponse struct {
-	ID          int64      `json:"id"`
-	Title       string     `json:"title"`
-	Content     string     `json:"content"`
-	Deadline    *time.Time `json:"deadline"`
-	IsCompleted bool       `json:"is_completed"`
+	ID           int64                    `json:"id"`
+	Title        string                   `json:"title"`
+	Content      string                   `json:"content"`
+	Deadline     *time.Time               `json:"deadline"`
+	IsCompleted  bool                     `json:"is_completed"`
+	CompletedAt  *time.Time               `json:"completed_at"`
+	AutoComplete bool                     `json:"auto_complete"`
+	Priority     string                   `json:"priority"`
+	ProjectID    *int64                   `json:"project_id"`
+	Recurrence   string                   `json:"recurrence"`
+	Timezone     string                   `json:"timezone"`
+	Occurrence   int                      `json:"occurrence"`
+	OriginID     *int64                   `json:"origin_id"`
+	Tags         []*TagResponse           `json:"tags"`
+	Checklist    []*ChecklistItemResponse `json:"checklist"`
+	Progress     *ProgressResponse        `json:"progress"`
+	Reminders    []*ReminderResponse      `json:"reminders"`
+	CreatedAt    time.Time                `json:"created_at"`
+	UpdatedAt    time.Time                `json:"updated_at"`
+	DeletedAt    *time.Time               `json:"deleted_at,omitempty"`
 }
 
 func TodoToDTO(src *ent.Todo) *TodoResponse {
+	tags := make([]*TagResponse, 0)
+	for _, tag := range src.Edges.Tags {
+		tags = append(tags, TagToDTO(tag))
+	}
+
+	checklist := make([]*ChecklistItemResponse, 0)
+	progress := &ProgressResponse{}
+	for _, item := range src.Edges.ChecklistItems {
+		checklist = append(checklist, ChecklistItemToDTO(item))
+		progress.Total++
+		if item.IsChecked {
+			progress.Done++
+		}
+	}
+
+	reminders := make([]*ReminderResponse, 0)
+	for _, reminder := range src.Edges.Reminders {
+		reminders = append(reminders, ReminderToDTO(reminder))
+	}
+
+	var projectID *int64
+	if src.Edges.Project != nil {
+		projectID = &src.Edges.Project.ID
+	}
+
+	var originID *int64
+	if src.Edges.Origin != nil {
+		originID = &src.Edges.Origin.ID
+	}
+
 	return &TodoResponse{
-		ID:          src.ID,
-		Title:       src.Title,
-		Content:     src.Content,
-		Deadline:    src.Deadline,
-		IsCompleted: src.IsCompleted,
+		ID:           src.ID,
+		Title:        src.Title,
+		Content:      src.Content,
+		Deadline:     src.Deadline,
+		IsCompleted:  src.IsCompleted,
+		CompletedAt:  src.CompletedAt,
+		AutoComplete: src.AutoComplete,
+		Priority:     string(src.Priority),
+		ProjectID:    projectID,
+		Recurrence:   src.Recurrence,
+		Timezone:     src.Timezone,
+		Occurrence:   src.Occurrence,
+		OriginID:     originID,
+		Tags:         tags,
+		Checklist:    checklist,
+		Progress:     progress,
+		Reminders:    reminders,
+		CreatedAt:    src.CreatedAt,
+		UpdatedAt:    src.UpdatedAt,
+		DeletedAt:    src.DeletedAt,
 	}
 }
@@ -1,20 +1,65 @@
 package dto
 
-import "halill/ent"
+import (
+	"halill/ent"
+	"time"
+)
 
 type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
 }
 
 type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type LogoutRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// RegistRequest 의 password 는 서비스에서 비밀번호 정책으로 검사합니다.
 type RegistRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
-	Name     string `json:"name"`
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Password string `json:"password" validate:"required"`
+	Name     string `json:"name" validate:"required,notblank,max=50"`
+	Locale   string `json:"locale"`
+}
+
+type UpdateLocaleRequest struct {
+	Locale string `json:"locale"`
+}
+
+// 새 비밀번호는 RegistRequest 의 password 처럼 서비스에서 비밀번호 정책으로 검사합니다.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email" validate:"required,email,max=255"`
+	Password string `json:"password" validate:"required"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" validate:"required"`
 }
 
 type TokenResponse struct {
@@ -23,13 +68,23 @@ type TokenResponse struct {
 }
 
 type UserResponse struct {
-	Email string `json:"email"`
-	Name  string `json:"name"`
+	ID            int64     `json:"id"`
+	Email         string    `json:"email"`
+	Name          string    `json:"name"`
+	Locale        string    `json:"locale,omitempty"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 func UserToDTO(src *ent.User) *UserResponse {
 	return &UserResponse{
-		Email: src.ID,
-		Name:  src.Name,
+		ID:            src.ID,
+		Email:         src.Email,
+		Name:          src.Name,
+		Locale:        src.Locale,
+		EmailVerified: src.EmailVerifiedAt != nil,
+		CreatedAt:     src.CreatedAt,
+		UpdatedAt:     src.UpdatedAt,
 	}
 }
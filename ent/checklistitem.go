@@ -0,0 +1,163 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"halill/ent/checklistitem"
+	"halill/ent/todo"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChecklistItem is the model entity for the ChecklistItem schema.
+type ChecklistItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// IsChecked holds the value of the "is_checked" field.
+	IsChecked bool `json:"is_checked,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChecklistItemQuery when eager-loading is set.
+	Edges                ChecklistItemEdges `json:"edges"`
+	todo_checklist_items *int64
+}
+
+// ChecklistItemEdges holds the relations/edges for other nodes in the graph.
+type ChecklistItemEdges struct {
+	// Todo holds the value of the todo edge.
+	Todo *Todo `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChecklistItemEdges) TodoOrErr() (*Todo, error) {
+	if e.loadedTypes[0] {
+		if e.Todo == nil {
+			// The edge todo was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todo.Label}
+		}
+		return e.Todo, nil
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChecklistItem) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checklistitem.FieldIsChecked:
+			values[i] = new(sql.NullBool)
+		case checklistitem.FieldID, checklistitem.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case checklistitem.FieldTitle:
+			values[i] = new(sql.NullString)
+		case checklistitem.ForeignKeys[0]: // todo_checklist_items
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ChecklistItem", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChecklistItem fields.
+func (ci *ChecklistItem) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checklistitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ci.ID = int64(value.Int64)
+		case checklistitem.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				ci.Title = value.String
+			}
+		case checklistitem.FieldIsChecked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_checked", values[i])
+			} else if value.Valid {
+				ci.IsChecked = value.Bool
+			}
+		case checklistitem.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				ci.Position = int(value.Int64)
+			}
+		case checklistitem.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_checklist_items", value)
+			} else if value.Valid {
+				ci.todo_checklist_items = new(int64)
+				*ci.todo_checklist_items = int64(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryTodo queries the "todo" edge of the ChecklistItem entity.
+func (ci *ChecklistItem) QueryTodo() *TodoQuery {
+	return (&ChecklistItemClient{config: ci.config}).QueryTodo(ci)
+}
+
+// Update returns a builder for updating this ChecklistItem.
+// Note that you need to call ChecklistItem.Unwrap() before calling this method if this ChecklistItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ci *ChecklistItem) Update() *ChecklistItemUpdateOne {
+	return (&ChecklistItemClient{config: ci.config}).UpdateOne(ci)
+}
+
+// Unwrap unwraps the ChecklistItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ci *ChecklistItem) Unwrap() *ChecklistItem {
+	tx, ok := ci.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChecklistItem is not a transactional entity")
+	}
+	ci.config.driver = tx.drv
+	return ci
+}
+
+// String implements the fmt.Stringer.
+func (ci *ChecklistItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChecklistItem(")
+	builder.WriteString(fmt.Sprintf("id=%v", ci.ID))
+	builder.WriteString(", title=")
+	builder.WriteString(ci.Title)
+	builder.WriteString(", is_checked=")
+	builder.WriteString(fmt.Sprintf("%v", ci.IsChecked))
+	builder.WriteString(", position=")
+	builder.WriteString(fmt.Sprintf("%v", ci.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChecklistItems is a parsable slice of ChecklistItem.
+type ChecklistItems []*ChecklistItem
+
+func (ci ChecklistItems) config(cfg config) {
+	for _i := range ci {
+		ci[_i].config = cfg
+	}
+}
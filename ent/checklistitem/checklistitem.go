@@ -0,0 +1,76 @@
+// Code generated by entc, DO NOT EDIT.
+
+package checklistitem
+
+import (
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the checklistitem type in the database.
+	Label = "checklist_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldIsChecked holds the string denoting the is_checked field in the database.
+	FieldIsChecked = "is_checked"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// EdgeTodo holds the string denoting the todo edge name in mutations.
+	EdgeTodo = "todo"
+	// Table holds the table name of the checklistitem in the database.
+	Table = "checklist_items"
+	// TodoTable is the table that holds the todo relation/edge.
+	TodoTable = "checklist_items"
+	// TodoInverseTable is the table name for the Todo entity.
+	// It exists in this package in order to avoid circular dependency with the "todo" package.
+	TodoInverseTable = "todos"
+	// TodoColumn is the table column denoting the todo relation/edge.
+	TodoColumn = "todo_checklist_items"
+)
+
+// Columns holds all SQL columns for checklistitem fields.
+var Columns = []string{
+	FieldID,
+	FieldTitle,
+	FieldIsChecked,
+	FieldPosition,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "checklist_items"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"todo_checklist_items",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "halill/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// DefaultIsChecked holds the default value on creation for the "is_checked" field.
+	DefaultIsChecked bool
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+)
@@ -0,0 +1,339 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"halill/ent/checklistitem"
+	"halill/ent/todo"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChecklistItemCreate is the builder for creating a ChecklistItem entity.
+type ChecklistItemCreate struct {
+	config
+	mutation *ChecklistItemMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (cic *ChecklistItemCreate) SetTitle(s string) *ChecklistItemCreate {
+	cic.mutation.SetTitle(s)
+	return cic
+}
+
+// SetIsChecked sets the "is_checked" field.
+func (cic *ChecklistItemCreate) SetIsChecked(b bool) *ChecklistItemCreate {
+	cic.mutation.SetIsChecked(b)
+	return cic
+}
+
+// SetNillableIsChecked sets the "is_checked" field if the given value is not nil.
+func (cic *ChecklistItemCreate) SetNillableIsChecked(b *bool) *ChecklistItemCreate {
+	if b != nil {
+		cic.SetIsChecked(*b)
+	}
+	return cic
+}
+
+// SetPosition sets the "position" field.
+func (cic *ChecklistItemCreate) SetPosition(i int) *ChecklistItemCreate {
+	cic.mutation.SetPosition(i)
+	return cic
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (cic *ChecklistItemCreate) SetNillablePosition(i *int) *ChecklistItemCreate {
+	if i != nil {
+		cic.SetPosition(*i)
+	}
+	return cic
+}
+
+// SetID sets the "id" field.
+func (cic *ChecklistItemCreate) SetID(i int64) *ChecklistItemCreate {
+	cic.mutation.SetID(i)
+	return cic
+}
+
+// SetTodoID sets the "todo" edge to the Todo entity by ID.
+func (cic *ChecklistItemCreate) SetTodoID(id int64) *ChecklistItemCreate {
+	cic.mutation.SetTodoID(id)
+	return cic
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (cic *ChecklistItemCreate) SetTodo(t *Todo) *ChecklistItemCreate {
+	return cic.SetTodoID(t.ID)
+}
+
+// Mutation returns the ChecklistItemMutation object of the builder.
+func (cic *ChecklistItemCreate) Mutation() *ChecklistItemMutation {
+	return cic.mutation
+}
+
+// Save creates the ChecklistItem in the database.
+func (cic *ChecklistItemCreate) Save(ctx context.Context) (*ChecklistItem, error) {
+	var (
+		err  error
+		node *ChecklistItem
+	)
+	if err := cic.defaults(); err != nil {
+		return nil, err
+	}
+	if len(cic.hooks) == 0 {
+		if err = cic.check(); err != nil {
+			return nil, err
+		}
+		node, err = cic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChecklistItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cic.check(); err != nil {
+				return nil, err
+			}
+			cic.mutation = mutation
+			if node, err = cic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cic.hooks) - 1; i >= 0; i-- {
+			if cic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cic *ChecklistItemCreate) SaveX(ctx context.Context) *ChecklistItem {
+	v, err := cic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cic *ChecklistItemCreate) Exec(ctx context.Context) error {
+	_, err := cic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cic *ChecklistItemCreate) ExecX(ctx context.Context) {
+	if err := cic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cic *ChecklistItemCreate) defaults() error {
+	if _, ok := cic.mutation.IsChecked(); !ok {
+		v := checklistitem.DefaultIsChecked
+		cic.mutation.SetIsChecked(v)
+	}
+	if _, ok := cic.mutation.Position(); !ok {
+		v := checklistitem.DefaultPosition
+		cic.mutation.SetPosition(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cic *ChecklistItemCreate) check() error {
+	if _, ok := cic.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "title"`)}
+	}
+	if v, ok := cic.mutation.Title(); ok {
+		if err := checklistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "title": %w`, err)}
+		}
+	}
+	if _, ok := cic.mutation.IsChecked(); !ok {
+		return &ValidationError{Name: "is_checked", err: errors.New(`ent: missing required field "is_checked"`)}
+	}
+	if _, ok := cic.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "position"`)}
+	}
+	if _, ok := cic.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo", err: errors.New("ent: missing required edge \"todo\"")}
+	}
+	return nil
+}
+
+func (cic *ChecklistItemCreate) sqlSave(ctx context.Context) (*ChecklistItem, error) {
+	_node, _spec := cic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (cic *ChecklistItemCreate) createSpec() (*ChecklistItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChecklistItem{config: cic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: checklistitem.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: checklistitem.FieldID,
+			},
+		}
+	)
+	if id, ok := cic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cic.mutation.Title(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: checklistitem.FieldTitle,
+		})
+		_node.Title = value
+	}
+	if value, ok := cic.mutation.IsChecked(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: checklistitem.FieldIsChecked,
+		})
+		_node.IsChecked = value
+	}
+	if value, ok := cic.mutation.Position(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: checklistitem.FieldPosition,
+		})
+		_node.Position = value
+	}
+	if nodes := cic.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TodoTable,
+			Columns: []string{checklistitem.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.todo_checklist_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ChecklistItemCreateBulk is the builder for creating many ChecklistItem entities in bulk.
+type ChecklistItemCreateBulk struct {
+	config
+	builders []*ChecklistItemCreate
+}
+
+// Save creates the ChecklistItem entities in the database.
+func (cicb *ChecklistItemCreateBulk) Save(ctx context.Context) ([]*ChecklistItem, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cicb.builders))
+	nodes := make([]*ChecklistItem, len(cicb.builders))
+	mutators := make([]Mutator, len(cicb.builders))
+	for i := range cicb.builders {
+		func(i int, root context.Context) {
+			builder := cicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChecklistItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cicb *ChecklistItemCreateBulk) SaveX(ctx context.Context) []*ChecklistItem {
+	v, err := cicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cicb *ChecklistItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := cicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cicb *ChecklistItemCreateBulk) ExecX(ctx context.Context) {
+	if err := cicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
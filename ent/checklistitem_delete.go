@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"halill/ent/checklistitem"
+	"halill/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChecklistItemDelete is the builder for deleting a ChecklistItem entity.
+type ChecklistItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChecklistItemMutation
+}
+
+// Where appends a list predicates to the ChecklistItemDelete builder.
+func (cid *ChecklistItemDelete) Where(ps ...predicate.ChecklistItem) *ChecklistItemDelete {
+	cid.mutation.Where(ps...)
+	return cid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cid *ChecklistItemDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cid.hooks) == 0 {
+		affected, err = cid.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChecklistItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cid.mutation = mutation
+			affected, err = cid.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cid.hooks) - 1; i >= 0; i-- {
+			if cid.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cid.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cid.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cid *ChecklistItemDelete) ExecX(ctx context.Context) int {
+	n, err := cid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cid *ChecklistItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: checklistitem.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: checklistitem.FieldID,
+			},
+		},
+	}
+	if ps := cid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, cid.driver, _spec)
+}
+
+// ChecklistItemDeleteOne is the builder for deleting a single ChecklistItem entity.
+type ChecklistItemDeleteOne struct {
+	cid *ChecklistItemDelete
+}
+
+// Exec executes the deletion query.
+func (cido *ChecklistItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := cido.cid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{checklistitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cido *ChecklistItemDeleteOne) ExecX(ctx context.Context) {
+	cido.cid.ExecX(ctx)
+}
@@ -0,0 +1,998 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"halill/ent/checklistitem"
+	"halill/ent/predicate"
+	"halill/ent/todo"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChecklistItemQuery is the builder for querying ChecklistItem entities.
+type ChecklistItemQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ChecklistItem
+	// eager-loading edges.
+	withTodo *TodoQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChecklistItemQuery builder.
+func (ciq *ChecklistItemQuery) Where(ps ...predicate.ChecklistItem) *ChecklistItemQuery {
+	ciq.predicates = append(ciq.predicates, ps...)
+	return ciq
+}
+
+// Limit adds a limit step to the query.
+func (ciq *ChecklistItemQuery) Limit(limit int) *ChecklistItemQuery {
+	ciq.limit = &limit
+	return ciq
+}
+
+// Offset adds an offset step to the query.
+func (ciq *ChecklistItemQuery) Offset(offset int) *ChecklistItemQuery {
+	ciq.offset = &offset
+	return ciq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ciq *ChecklistItemQuery) Unique(unique bool) *ChecklistItemQuery {
+	ciq.unique = &unique
+	return ciq
+}
+
+// Order adds an order step to the query.
+func (ciq *ChecklistItemQuery) Order(o ...OrderFunc) *ChecklistItemQuery {
+	ciq.order = append(ciq.order, o...)
+	return ciq
+}
+
+// QueryTodo chains the current query on the "todo" edge.
+func (ciq *ChecklistItemQuery) QueryTodo() *TodoQuery {
+	query := &TodoQuery{config: ciq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ciq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ciq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checklistitem.Table, checklistitem.FieldID, selector),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, checklistitem.TodoTable, checklistitem.TodoColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ciq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ChecklistItem entity from the query.
+// Returns a *NotFoundError when no ChecklistItem was found.
+func (ciq *ChecklistItemQuery) First(ctx context.Context) (*ChecklistItem, error) {
+	nodes, err := ciq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{checklistitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) FirstX(ctx context.Context) *ChecklistItem {
+	node, err := ciq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChecklistItem ID from the query.
+// Returns a *NotFoundError when no ChecklistItem ID was found.
+func (ciq *ChecklistItemQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = ciq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{checklistitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := ciq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChecklistItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one ChecklistItem entity is not found.
+// Returns a *NotFoundError when no ChecklistItem entities are found.
+func (ciq *ChecklistItemQuery) Only(ctx context.Context) (*ChecklistItem, error) {
+	nodes, err := ciq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{checklistitem.Label}
+	default:
+		return nil, &NotSingularError{checklistitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) OnlyX(ctx context.Context) *ChecklistItem {
+	node, err := ciq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChecklistItem ID in the query.
+// Returns a *NotSingularError when exactly one ChecklistItem ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ciq *ChecklistItemQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = ciq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = &NotSingularError{checklistitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := ciq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChecklistItems.
+func (ciq *ChecklistItemQuery) All(ctx context.Context) ([]*ChecklistItem, error) {
+	if err := ciq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ciq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) AllX(ctx context.Context) []*ChecklistItem {
+	nodes, err := ciq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChecklistItem IDs.
+func (ciq *ChecklistItemQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := ciq.Select(checklistitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := ciq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ciq *ChecklistItemQuery) Count(ctx context.Context) (int, error) {
+	if err := ciq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ciq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) CountX(ctx context.Context) int {
+	count, err := ciq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ciq *ChecklistItemQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ciq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ciq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := ciq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChecklistItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ciq *ChecklistItemQuery) Clone() *ChecklistItemQuery {
+	if ciq == nil {
+		return nil
+	}
+	return &ChecklistItemQuery{
+		config:     ciq.config,
+		limit:      ciq.limit,
+		offset:     ciq.offset,
+		order:      append([]OrderFunc{}, ciq.order...),
+		predicates: append([]predicate.ChecklistItem{}, ciq.predicates...),
+		withTodo:   ciq.withTodo.Clone(),
+		// clone intermediate query.
+		sql:  ciq.sql.Clone(),
+		path: ciq.path,
+	}
+}
+
+// WithTodo tells the query-builder to eager-load the nodes that are connected to
+// the "todo" edge. The optional arguments are used to configure the query builder of the edge.
+func (ciq *ChecklistItemQuery) WithTodo(opts ...func(*TodoQuery)) *ChecklistItemQuery {
+	query := &TodoQuery{config: ciq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ciq.withTodo = query
+	return ciq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Title string `json:"title,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChecklistItem.Query().
+//		GroupBy(checklistitem.FieldTitle).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ciq *ChecklistItemQuery) GroupBy(field string, fields ...string) *ChecklistItemGroupBy {
+	group := &ChecklistItemGroupBy{config: ciq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ciq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ciq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Title string `json:"title,omitempty"`
+//	}
+//
+//	client.ChecklistItem.Query().
+//		Select(checklistitem.FieldTitle).
+//		Scan(ctx, &v)
+func (ciq *ChecklistItemQuery) Select(fields ...string) *ChecklistItemSelect {
+	ciq.fields = append(ciq.fields, fields...)
+	return &ChecklistItemSelect{ChecklistItemQuery: ciq}
+}
+
+func (ciq *ChecklistItemQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ciq.fields {
+		if !checklistitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ciq.path != nil {
+		prev, err := ciq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ciq.sql = prev
+	}
+	if checklistitem.Policy == nil {
+		return errors.New("ent: uninitialized checklistitem.Policy (forgotten import ent/runtime?)")
+	}
+	if err := checklistitem.Policy.EvalQuery(ctx, ciq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ciq *ChecklistItemQuery) sqlAll(ctx context.Context) ([]*ChecklistItem, error) {
+	var (
+		nodes       = []*ChecklistItem{}
+		withFKs     = ciq.withFKs
+		_spec       = ciq.querySpec()
+		loadedTypes = [1]bool{
+			ciq.withTodo != nil,
+		}
+	)
+	if ciq.withTodo != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, checklistitem.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ChecklistItem{config: ciq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ciq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ciq.withTodo; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*ChecklistItem)
+		for i := range nodes {
+			if nodes[i].todo_checklist_items == nil {
+				continue
+			}
+			fk := *nodes[i].todo_checklist_items
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(todo.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_checklist_items" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Todo = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ciq *ChecklistItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ciq.querySpec()
+	return sqlgraph.CountNodes(ctx, ciq.driver, _spec)
+}
+
+func (ciq *ChecklistItemQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ciq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ciq *ChecklistItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   checklistitem.Table,
+			Columns: checklistitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: checklistitem.FieldID,
+			},
+		},
+		From:   ciq.sql,
+		Unique: true,
+	}
+	if unique := ciq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ciq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checklistitem.FieldID)
+		for i := range fields {
+			if fields[i] != checklistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ciq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ciq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ciq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ciq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ciq *ChecklistItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ciq.driver.Dialect())
+	t1 := builder.Table(checklistitem.Table)
+	columns := ciq.fields
+	if len(columns) == 0 {
+		columns = checklistitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ciq.sql != nil {
+		selector = ciq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range ciq.predicates {
+		p(selector)
+	}
+	for _, p := range ciq.order {
+		p(selector)
+	}
+	if offset := ciq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ciq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ChecklistItemGroupBy is the group-by builder for ChecklistItem entities.
+type ChecklistItemGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cigb *ChecklistItemGroupBy) Aggregate(fns ...AggregateFunc) *ChecklistItemGroupBy {
+	cigb.fns = append(cigb.fns, fns...)
+	return cigb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cigb *ChecklistItemGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cigb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cigb.sql = query
+	return cigb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cigb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cigb *ChecklistItemGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cigb.fields) > 1 {
+		return nil, errors.New("ent: ChecklistItemGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cigb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cigb *ChecklistItemGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cigb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = fmt.Errorf("ent: ChecklistItemGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) StringX(ctx context.Context) string {
+	v, err := cigb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cigb *ChecklistItemGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cigb.fields) > 1 {
+		return nil, errors.New("ent: ChecklistItemGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cigb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cigb *ChecklistItemGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cigb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = fmt.Errorf("ent: ChecklistItemGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) IntX(ctx context.Context) int {
+	v, err := cigb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cigb *ChecklistItemGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cigb.fields) > 1 {
+		return nil, errors.New("ent: ChecklistItemGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cigb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cigb *ChecklistItemGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cigb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = fmt.Errorf("ent: ChecklistItemGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cigb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cigb *ChecklistItemGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cigb.fields) > 1 {
+		return nil, errors.New("ent: ChecklistItemGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cigb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cigb *ChecklistItemGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cigb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = fmt.Errorf("ent: ChecklistItemGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cigb *ChecklistItemGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cigb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cigb *ChecklistItemGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cigb.fields {
+		if !checklistitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cigb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cigb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cigb *ChecklistItemGroupBy) sqlQuery() *sql.Selector {
+	selector := cigb.sql.Select()
+	aggregation := make([]string, 0, len(cigb.fns))
+	for _, fn := range cigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(cigb.fields)+len(cigb.fns))
+		for _, f := range cigb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(cigb.fields...)...)
+}
+
+// ChecklistItemSelect is the builder for selecting fields of ChecklistItem entities.
+type ChecklistItemSelect struct {
+	*ChecklistItemQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cis *ChecklistItemSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cis.sql = cis.ChecklistItemQuery.sqlQuery(ctx)
+	return cis.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cis *ChecklistItemSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cis.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cis *ChecklistItemSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cis.fields) > 1 {
+		return nil, errors.New("ent: ChecklistItemSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cis *ChecklistItemSelect) StringsX(ctx context.Context) []string {
+	v, err := cis.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cis *ChecklistItemSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cis.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = fmt.Errorf("ent: ChecklistItemSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cis *ChecklistItemSelect) StringX(ctx context.Context) string {
+	v, err := cis.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cis *ChecklistItemSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cis.fields) > 1 {
+		return nil, errors.New("ent: ChecklistItemSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cis *ChecklistItemSelect) IntsX(ctx context.Context) []int {
+	v, err := cis.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cis *ChecklistItemSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cis.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = fmt.Errorf("ent: ChecklistItemSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cis *ChecklistItemSelect) IntX(ctx context.Context) int {
+	v, err := cis.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cis *ChecklistItemSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cis.fields) > 1 {
+		return nil, errors.New("ent: ChecklistItemSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cis *ChecklistItemSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cis.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cis *ChecklistItemSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cis.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = fmt.Errorf("ent: ChecklistItemSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cis *ChecklistItemSelect) Float64X(ctx context.Context) float64 {
+	v, err := cis.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cis *ChecklistItemSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cis.fields) > 1 {
+		return nil, errors.New("ent: ChecklistItemSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cis *ChecklistItemSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cis.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cis *ChecklistItemSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cis.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = fmt.Errorf("ent: ChecklistItemSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cis *ChecklistItemSelect) BoolX(ctx context.Context) bool {
+	v, err := cis.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cis *ChecklistItemSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cis.sql.Query()
+	if err := cis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
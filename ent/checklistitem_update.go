@@ -0,0 +1,520 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"halill/ent/checklistitem"
+	"halill/ent/predicate"
+	"halill/ent/todo"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChecklistItemUpdate is the builder for updating ChecklistItem entities.
+type ChecklistItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChecklistItemMutation
+}
+
+// Where appends a list predicates to the ChecklistItemUpdate builder.
+func (ciu *ChecklistItemUpdate) Where(ps ...predicate.ChecklistItem) *ChecklistItemUpdate {
+	ciu.mutation.Where(ps...)
+	return ciu
+}
+
+// SetTitle sets the "title" field.
+func (ciu *ChecklistItemUpdate) SetTitle(s string) *ChecklistItemUpdate {
+	ciu.mutation.SetTitle(s)
+	return ciu
+}
+
+// SetIsChecked sets the "is_checked" field.
+func (ciu *ChecklistItemUpdate) SetIsChecked(b bool) *ChecklistItemUpdate {
+	ciu.mutation.SetIsChecked(b)
+	return ciu
+}
+
+// SetNillableIsChecked sets the "is_checked" field if the given value is not nil.
+func (ciu *ChecklistItemUpdate) SetNillableIsChecked(b *bool) *ChecklistItemUpdate {
+	if b != nil {
+		ciu.SetIsChecked(*b)
+	}
+	return ciu
+}
+
+// SetPosition sets the "position" field.
+func (ciu *ChecklistItemUpdate) SetPosition(i int) *ChecklistItemUpdate {
+	ciu.mutation.ResetPosition()
+	ciu.mutation.SetPosition(i)
+	return ciu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (ciu *ChecklistItemUpdate) SetNillablePosition(i *int) *ChecklistItemUpdate {
+	if i != nil {
+		ciu.SetPosition(*i)
+	}
+	return ciu
+}
+
+// AddPosition adds i to the "position" field.
+func (ciu *ChecklistItemUpdate) AddPosition(i int) *ChecklistItemUpdate {
+	ciu.mutation.AddPosition(i)
+	return ciu
+}
+
+// SetTodoID sets the "todo" edge to the Todo entity by ID.
+func (ciu *ChecklistItemUpdate) SetTodoID(id int64) *ChecklistItemUpdate {
+	ciu.mutation.SetTodoID(id)
+	return ciu
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (ciu *ChecklistItemUpdate) SetTodo(t *Todo) *ChecklistItemUpdate {
+	return ciu.SetTodoID(t.ID)
+}
+
+// Mutation returns the ChecklistItemMutation object of the builder.
+func (ciu *ChecklistItemUpdate) Mutation() *ChecklistItemMutation {
+	return ciu.mutation
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (ciu *ChecklistItemUpdate) ClearTodo() *ChecklistItemUpdate {
+	ciu.mutation.ClearTodo()
+	return ciu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ciu *ChecklistItemUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ciu.hooks) == 0 {
+		if err = ciu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ciu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChecklistItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ciu.check(); err != nil {
+				return 0, err
+			}
+			ciu.mutation = mutation
+			affected, err = ciu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ciu.hooks) - 1; i >= 0; i-- {
+			if ciu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ciu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ciu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ciu *ChecklistItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := ciu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ciu *ChecklistItemUpdate) Exec(ctx context.Context) error {
+	_, err := ciu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ciu *ChecklistItemUpdate) ExecX(ctx context.Context) {
+	if err := ciu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ciu *ChecklistItemUpdate) check() error {
+	if v, ok := ciu.mutation.Title(); ok {
+		if err := checklistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf("ent: validator failed for field \"title\": %w", err)}
+		}
+	}
+	if _, ok := ciu.mutation.TodoID(); ciu.mutation.TodoCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"todo\"")
+	}
+	return nil
+}
+
+func (ciu *ChecklistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   checklistitem.Table,
+			Columns: checklistitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: checklistitem.FieldID,
+			},
+		},
+	}
+	if ps := ciu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ciu.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: checklistitem.FieldTitle,
+		})
+	}
+	if value, ok := ciu.mutation.IsChecked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: checklistitem.FieldIsChecked,
+		})
+	}
+	if value, ok := ciu.mutation.Position(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: checklistitem.FieldPosition,
+		})
+	}
+	if value, ok := ciu.mutation.AddedPosition(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: checklistitem.FieldPosition,
+		})
+	}
+	if ciu.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TodoTable,
+			Columns: []string{checklistitem.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ciu.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TodoTable,
+			Columns: []string{checklistitem.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ciu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checklistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ChecklistItemUpdateOne is the builder for updating a single ChecklistItem entity.
+type ChecklistItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChecklistItemMutation
+}
+
+// SetTitle sets the "title" field.
+func (ciuo *ChecklistItemUpdateOne) SetTitle(s string) *ChecklistItemUpdateOne {
+	ciuo.mutation.SetTitle(s)
+	return ciuo
+}
+
+// SetIsChecked sets the "is_checked" field.
+func (ciuo *ChecklistItemUpdateOne) SetIsChecked(b bool) *ChecklistItemUpdateOne {
+	ciuo.mutation.SetIsChecked(b)
+	return ciuo
+}
+
+// SetNillableIsChecked sets the "is_checked" field if the given value is not nil.
+func (ciuo *ChecklistItemUpdateOne) SetNillableIsChecked(b *bool) *ChecklistItemUpdateOne {
+	if b != nil {
+		ciuo.SetIsChecked(*b)
+	}
+	return ciuo
+}
+
+// SetPosition sets the "position" field.
+func (ciuo *ChecklistItemUpdateOne) SetPosition(i int) *ChecklistItemUpdateOne {
+	ciuo.mutation.ResetPosition()
+	ciuo.mutation.SetPosition(i)
+	return ciuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (ciuo *ChecklistItemUpdateOne) SetNillablePosition(i *int) *ChecklistItemUpdateOne {
+	if i != nil {
+		ciuo.SetPosition(*i)
+	}
+	return ciuo
+}
+
+// AddPosition adds i to the "position" field.
+func (ciuo *ChecklistItemUpdateOne) AddPosition(i int) *ChecklistItemUpdateOne {
+	ciuo.mutation.AddPosition(i)
+	return ciuo
+}
+
+// SetTodoID sets the "todo" edge to the Todo entity by ID.
+func (ciuo *ChecklistItemUpdateOne) SetTodoID(id int64) *ChecklistItemUpdateOne {
+	ciuo.mutation.SetTodoID(id)
+	return ciuo
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (ciuo *ChecklistItemUpdateOne) SetTodo(t *Todo) *ChecklistItemUpdateOne {
+	return ciuo.SetTodoID(t.ID)
+}
+
+// Mutation returns the ChecklistItemMutation object of the builder.
+func (ciuo *ChecklistItemUpdateOne) Mutation() *ChecklistItemMutation {
+	return ciuo.mutation
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (ciuo *ChecklistItemUpdateOne) ClearTodo() *ChecklistItemUpdateOne {
+	ciuo.mutation.ClearTodo()
+	return ciuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ciuo *ChecklistItemUpdateOne) Select(field string, fields ...string) *ChecklistItemUpdateOne {
+	ciuo.fields = append([]string{field}, fields...)
+	return ciuo
+}
+
+// Save executes the query and returns the updated ChecklistItem entity.
+func (ciuo *ChecklistItemUpdateOne) Save(ctx context.Context) (*ChecklistItem, error) {
+	var (
+		err  error
+		node *ChecklistItem
+	)
+	if len(ciuo.hooks) == 0 {
+		if err = ciuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ciuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChecklistItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ciuo.check(); err != nil {
+				return nil, err
+			}
+			ciuo.mutation = mutation
+			node, err = ciuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ciuo.hooks) - 1; i >= 0; i-- {
+			if ciuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ciuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ciuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ciuo *ChecklistItemUpdateOne) SaveX(ctx context.Context) *ChecklistItem {
+	node, err := ciuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ciuo *ChecklistItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := ciuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ciuo *ChecklistItemUpdateOne) ExecX(ctx context.Context) {
+	if err := ciuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ciuo *ChecklistItemUpdateOne) check() error {
+	if v, ok := ciuo.mutation.Title(); ok {
+		if err := checklistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf("ent: validator failed for field \"title\": %w", err)}
+		}
+	}
+	if _, ok := ciuo.mutation.TodoID(); ciuo.mutation.TodoCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"todo\"")
+	}
+	return nil
+}
+
+func (ciuo *ChecklistItemUpdateOne) sqlSave(ctx context.Context) (_node *ChecklistItem, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   checklistitem.Table,
+			Columns: checklistitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: checklistitem.FieldID,
+			},
+		},
+	}
+	id, ok := ciuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ChecklistItem.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ciuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checklistitem.FieldID)
+		for _, f := range fields {
+			if !checklistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != checklistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ciuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ciuo.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: checklistitem.FieldTitle,
+		})
+	}
+	if value, ok := ciuo.mutation.IsChecked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: checklistitem.FieldIsChecked,
+		})
+	}
+	if value, ok := ciuo.mutation.Position(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: checklistitem.FieldPosition,
+		})
+	}
+	if value, ok := ciuo.mutation.AddedPosition(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: checklistitem.FieldPosition,
+		})
+	}
+	if ciuo.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TodoTable,
+			Columns: []string{checklistitem.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ciuo.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TodoTable,
+			Columns: []string{checklistitem.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ChecklistItem{config: ciuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ciuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checklistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
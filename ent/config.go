@@ -24,8 +24,15 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Todo []ent.Hook
-	User []ent.Hook
+	ChecklistItem      []ent.Hook
+	PasswordResetToken []ent.Hook
+	Project            []ent.Hook
+	RefreshToken       []ent.Hook
+	Reminder           []ent.Hook
+	RevokedToken       []ent.Hook
+	Tag                []ent.Hook
+	Todo               []ent.Hook
+	User               []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -5,6 +5,13 @@ package ent
 import (
 	"errors"
 	"fmt"
+	"halill/ent/checklistitem"
+	"halill/ent/passwordresettoken"
+	"halill/ent/project"
+	"halill/ent/refreshtoken"
+	"halill/ent/reminder"
+	"halill/ent/revokedtoken"
+	"halill/ent/tag"
 	"halill/ent/todo"
 	"halill/ent/user"
 
@@ -30,8 +37,15 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		todo.Table: todo.ValidColumn,
-		user.Table: user.ValidColumn,
+		checklistitem.Table:      checklistitem.ValidColumn,
+		passwordresettoken.Table: passwordresettoken.ValidColumn,
+		project.Table:            project.ValidColumn,
+		refreshtoken.Table:       refreshtoken.ValidColumn,
+		reminder.Table:           reminder.ValidColumn,
+		revokedtoken.Table:       revokedtoken.ValidColumn,
+		tag.Table:                tag.ValidColumn,
+		todo.Table:               todo.ValidColumn,
+		user.Table:               user.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -0,0 +1,1303 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"halill/ent/checklistitem"
+	"halill/ent/passwordresettoken"
+	"halill/ent/predicate"
+	"halill/ent/project"
+	"halill/ent/refreshtoken"
+	"halill/ent/reminder"
+	"halill/ent/revokedtoken"
+	"halill/ent/tag"
+	"halill/ent/todo"
+	"halill/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+)
+
+// schemaGraph holds a representation of ent/schema at runtime.
+var schemaGraph = func() *sqlgraph.Schema {
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 9)}
+	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   checklistitem.Table,
+			Columns: checklistitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: checklistitem.FieldID,
+			},
+		},
+		Type: "ChecklistItem",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			checklistitem.FieldTitle:     {Type: field.TypeString, Column: checklistitem.FieldTitle},
+			checklistitem.FieldIsChecked: {Type: field.TypeBool, Column: checklistitem.FieldIsChecked},
+			checklistitem.FieldPosition:  {Type: field.TypeInt, Column: checklistitem.FieldPosition},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   passwordresettoken.Table,
+			Columns: passwordresettoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: passwordresettoken.FieldID,
+			},
+		},
+		Type: "PasswordResetToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			passwordresettoken.FieldTokenHash: {Type: field.TypeString, Column: passwordresettoken.FieldTokenHash},
+			passwordresettoken.FieldExpiresAt: {Type: field.TypeTime, Column: passwordresettoken.FieldExpiresAt},
+			passwordresettoken.FieldUsedAt:    {Type: field.TypeTime, Column: passwordresettoken.FieldUsedAt},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   project.Table,
+			Columns: project.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: project.FieldID,
+			},
+		},
+		Type: "Project",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			project.FieldName:     {Type: field.TypeString, Column: project.FieldName},
+			project.FieldColor:    {Type: field.TypeString, Column: project.FieldColor},
+			project.FieldPosition: {Type: field.TypeInt, Column: project.FieldPosition},
+			project.FieldArchived: {Type: field.TypeBool, Column: project.FieldArchived},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   refreshtoken.Table,
+			Columns: refreshtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: refreshtoken.FieldID,
+			},
+		},
+		Type: "RefreshToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			refreshtoken.FieldFamilyID:  {Type: field.TypeString, Column: refreshtoken.FieldFamilyID},
+			refreshtoken.FieldTokenHash: {Type: field.TypeString, Column: refreshtoken.FieldTokenHash},
+			refreshtoken.FieldExpiresAt: {Type: field.TypeTime, Column: refreshtoken.FieldExpiresAt},
+			refreshtoken.FieldRevoked:   {Type: field.TypeBool, Column: refreshtoken.FieldRevoked},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   reminder.Table,
+			Columns: reminder.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reminder.FieldID,
+			},
+		},
+		Type: "Reminder",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			reminder.FieldOffsetMinutes: {Type: field.TypeInt, Column: reminder.FieldOffsetMinutes},
+			reminder.FieldRemindAt:      {Type: field.TypeTime, Column: reminder.FieldRemindAt},
+			reminder.FieldSentAt:        {Type: field.TypeTime, Column: reminder.FieldSentAt},
+			reminder.FieldLockedUntil:   {Type: field.TypeTime, Column: reminder.FieldLockedUntil},
+			reminder.FieldLockedBy:      {Type: field.TypeString, Column: reminder.FieldLockedBy},
+			reminder.FieldAttempts:      {Type: field.TypeInt, Column: reminder.FieldAttempts},
+			reminder.FieldLastError:     {Type: field.TypeString, Column: reminder.FieldLastError},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   revokedtoken.Table,
+			Columns: revokedtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: revokedtoken.FieldID,
+			},
+		},
+		Type: "RevokedToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			revokedtoken.FieldJti:       {Type: field.TypeString, Column: revokedtoken.FieldJti},
+			revokedtoken.FieldExpiresAt: {Type: field.TypeTime, Column: revokedtoken.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   tag.Table,
+			Columns: tag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: tag.FieldID,
+			},
+		},
+		Type: "Tag",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			tag.FieldName: {Type: field.TypeString, Column: tag.FieldName},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   todo.Table,
+			Columns: todo.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: todo.FieldID,
+			},
+		},
+		Type: "Todo",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			todo.FieldCreatedAt:    {Type: field.TypeTime, Column: todo.FieldCreatedAt},
+			todo.FieldUpdatedAt:    {Type: field.TypeTime, Column: todo.FieldUpdatedAt},
+			todo.FieldDeletedAt:    {Type: field.TypeTime, Column: todo.FieldDeletedAt},
+			todo.FieldTitle:        {Type: field.TypeString, Column: todo.FieldTitle},
+			todo.FieldContent:      {Type: field.TypeString, Column: todo.FieldContent},
+			todo.FieldDeadline:     {Type: field.TypeTime, Column: todo.FieldDeadline},
+			todo.FieldIsCompleted:  {Type: field.TypeBool, Column: todo.FieldIsCompleted},
+			todo.FieldCompletedAt:  {Type: field.TypeTime, Column: todo.FieldCompletedAt},
+			todo.FieldAutoComplete: {Type: field.TypeBool, Column: todo.FieldAutoComplete},
+			todo.FieldRecurrence:   {Type: field.TypeString, Column: todo.FieldRecurrence},
+			todo.FieldTimezone:     {Type: field.TypeString, Column: todo.FieldTimezone},
+			todo.FieldOccurrence:   {Type: field.TypeInt, Column: todo.FieldOccurrence},
+			todo.FieldPriority:     {Type: field.TypeEnum, Column: todo.FieldPriority},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   user.Table,
+			Columns: user.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: user.FieldID,
+			},
+		},
+		Type: "User",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			user.FieldCreatedAt:          {Type: field.TypeTime, Column: user.FieldCreatedAt},
+			user.FieldUpdatedAt:          {Type: field.TypeTime, Column: user.FieldUpdatedAt},
+			user.FieldEmail:              {Type: field.TypeString, Column: user.FieldEmail},
+			user.FieldPassword:           {Type: field.TypeString, Column: user.FieldPassword},
+			user.FieldName:               {Type: field.TypeString, Column: user.FieldName},
+			user.FieldTokenVersion:       {Type: field.TypeInt, Column: user.FieldTokenVersion},
+			user.FieldLocale:             {Type: field.TypeString, Column: user.FieldLocale},
+			user.FieldEmailVerifiedAt:    {Type: field.TypeTime, Column: user.FieldEmailVerifiedAt},
+			user.FieldVerificationSentAt: {Type: field.TypeTime, Column: user.FieldVerificationSentAt},
+			user.FieldVerificationSends:  {Type: field.TypeInt, Column: user.FieldVerificationSends},
+		},
+	}
+	graph.MustAddE(
+		"todo",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TodoTable,
+			Columns: []string{checklistitem.TodoColumn},
+			Bidi:    false,
+		},
+		"ChecklistItem",
+		"Todo",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.UserTable,
+			Columns: []string{passwordresettoken.UserColumn},
+			Bidi:    false,
+		},
+		"PasswordResetToken",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.UserTable,
+			Columns: []string{project.UserColumn},
+			Bidi:    false,
+		},
+		"Project",
+		"User",
+	)
+	graph.MustAddE(
+		"todos",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TodosTable,
+			Columns: []string{project.TodosColumn},
+			Bidi:    false,
+		},
+		"Project",
+		"Todo",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+		},
+		"RefreshToken",
+		"User",
+	)
+	graph.MustAddE(
+		"todo",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TodoTable,
+			Columns: []string{reminder.TodoColumn},
+			Bidi:    false,
+		},
+		"Reminder",
+		"Todo",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tag.UserTable,
+			Columns: []string{tag.UserColumn},
+			Bidi:    false,
+		},
+		"Tag",
+		"User",
+	)
+	graph.MustAddE(
+		"todos",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.TodosTable,
+			Columns: tag.TodosPrimaryKey,
+			Bidi:    false,
+		},
+		"Tag",
+		"Todo",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.UserTable,
+			Columns: []string{todo.UserColumn},
+			Bidi:    false,
+		},
+		"Todo",
+		"User",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+		},
+		"Todo",
+		"Tag",
+	)
+	graph.MustAddE(
+		"project",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+		},
+		"Todo",
+		"Project",
+	)
+	graph.MustAddE(
+		"origin",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OriginTable,
+			Columns: []string{todo.OriginColumn},
+			Bidi:    false,
+		},
+		"Todo",
+		"Todo",
+	)
+	graph.MustAddE(
+		"occurrences",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.OccurrencesTable,
+			Columns: []string{todo.OccurrencesColumn},
+			Bidi:    false,
+		},
+		"Todo",
+		"Todo",
+	)
+	graph.MustAddE(
+		"checklist_items",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChecklistItemsTable,
+			Columns: []string{todo.ChecklistItemsColumn},
+			Bidi:    false,
+		},
+		"Todo",
+		"ChecklistItem",
+	)
+	graph.MustAddE(
+		"reminders",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+		},
+		"Todo",
+		"Reminder",
+	)
+	graph.MustAddE(
+		"todos",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TodosTable,
+			Columns: []string{user.TodosColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Todo",
+	)
+	graph.MustAddE(
+		"refresh_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"RefreshToken",
+	)
+	graph.MustAddE(
+		"password_reset_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordResetTokensTable,
+			Columns: []string{user.PasswordResetTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"PasswordResetToken",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Tag",
+	)
+	graph.MustAddE(
+		"projects",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProjectsTable,
+			Columns: []string{user.ProjectsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Project",
+	)
+	return graph
+}()
+
+// predicateAdder wraps the addPredicate method.
+// All update, update-one and query builders implement this interface.
+type predicateAdder interface {
+	addPredicate(func(s *sql.Selector))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (ciq *ChecklistItemQuery) addPredicate(pred func(s *sql.Selector)) {
+	ciq.predicates = append(ciq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ChecklistItemQuery builder.
+func (ciq *ChecklistItemQuery) Filter() *ChecklistItemFilter {
+	return &ChecklistItemFilter{ciq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ChecklistItemMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ChecklistItemMutation builder.
+func (m *ChecklistItemMutation) Filter() *ChecklistItemFilter {
+	return &ChecklistItemFilter{m}
+}
+
+// ChecklistItemFilter provides a generic filtering capability at runtime for ChecklistItemQuery.
+type ChecklistItemFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ChecklistItemFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *ChecklistItemFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(checklistitem.FieldID))
+}
+
+// WhereTitle applies the entql string predicate on the title field.
+func (f *ChecklistItemFilter) WhereTitle(p entql.StringP) {
+	f.Where(p.Field(checklistitem.FieldTitle))
+}
+
+// WhereIsChecked applies the entql bool predicate on the is_checked field.
+func (f *ChecklistItemFilter) WhereIsChecked(p entql.BoolP) {
+	f.Where(p.Field(checklistitem.FieldIsChecked))
+}
+
+// WherePosition applies the entql int predicate on the position field.
+func (f *ChecklistItemFilter) WherePosition(p entql.IntP) {
+	f.Where(p.Field(checklistitem.FieldPosition))
+}
+
+// WhereHasTodo applies a predicate to check if query has an edge todo.
+func (f *ChecklistItemFilter) WhereHasTodo() {
+	f.Where(entql.HasEdge("todo"))
+}
+
+// WhereHasTodoWith applies a predicate to check if query has an edge todo with a given conditions (other predicates).
+func (f *ChecklistItemFilter) WhereHasTodoWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("todo", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (prtq *PasswordResetTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	prtq.predicates = append(prtq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PasswordResetTokenQuery builder.
+func (prtq *PasswordResetTokenQuery) Filter() *PasswordResetTokenFilter {
+	return &PasswordResetTokenFilter{prtq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PasswordResetTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PasswordResetTokenMutation builder.
+func (m *PasswordResetTokenMutation) Filter() *PasswordResetTokenFilter {
+	return &PasswordResetTokenFilter{m}
+}
+
+// PasswordResetTokenFilter provides a generic filtering capability at runtime for PasswordResetTokenQuery.
+type PasswordResetTokenFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PasswordResetTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *PasswordResetTokenFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(passwordresettoken.FieldID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *PasswordResetTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(passwordresettoken.FieldTokenHash))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *PasswordResetTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *PasswordResetTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldUsedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *PasswordResetTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *PasswordResetTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (pq *ProjectQuery) addPredicate(pred func(s *sql.Selector)) {
+	pq.predicates = append(pq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ProjectQuery builder.
+func (pq *ProjectQuery) Filter() *ProjectFilter {
+	return &ProjectFilter{pq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ProjectMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ProjectMutation builder.
+func (m *ProjectMutation) Filter() *ProjectFilter {
+	return &ProjectFilter{m}
+}
+
+// ProjectFilter provides a generic filtering capability at runtime for ProjectQuery.
+type ProjectFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ProjectFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *ProjectFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(project.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *ProjectFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(project.FieldName))
+}
+
+// WhereColor applies the entql string predicate on the color field.
+func (f *ProjectFilter) WhereColor(p entql.StringP) {
+	f.Where(p.Field(project.FieldColor))
+}
+
+// WherePosition applies the entql int predicate on the position field.
+func (f *ProjectFilter) WherePosition(p entql.IntP) {
+	f.Where(p.Field(project.FieldPosition))
+}
+
+// WhereArchived applies the entql bool predicate on the archived field.
+func (f *ProjectFilter) WhereArchived(p entql.BoolP) {
+	f.Where(p.Field(project.FieldArchived))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *ProjectFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTodos applies a predicate to check if query has an edge todos.
+func (f *ProjectFilter) WhereHasTodos() {
+	f.Where(entql.HasEdge("todos"))
+}
+
+// WhereHasTodosWith applies a predicate to check if query has an edge todos with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasTodosWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("todos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rtq *RefreshTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	rtq.predicates = append(rtq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RefreshTokenQuery builder.
+func (rtq *RefreshTokenQuery) Filter() *RefreshTokenFilter {
+	return &RefreshTokenFilter{rtq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RefreshTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Filter() *RefreshTokenFilter {
+	return &RefreshTokenFilter{m}
+}
+
+// RefreshTokenFilter provides a generic filtering capability at runtime for RefreshTokenQuery.
+type RefreshTokenFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RefreshTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *RefreshTokenFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(refreshtoken.FieldID))
+}
+
+// WhereFamilyID applies the entql string predicate on the family_id field.
+func (f *RefreshTokenFilter) WhereFamilyID(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldFamilyID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *RefreshTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldTokenHash))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *RefreshTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldExpiresAt))
+}
+
+// WhereRevoked applies the entql bool predicate on the revoked field.
+func (f *RefreshTokenFilter) WhereRevoked(p entql.BoolP) {
+	f.Where(p.Field(refreshtoken.FieldRevoked))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *RefreshTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *RefreshTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rq *ReminderQuery) addPredicate(pred func(s *sql.Selector)) {
+	rq.predicates = append(rq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ReminderQuery builder.
+func (rq *ReminderQuery) Filter() *ReminderFilter {
+	return &ReminderFilter{rq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ReminderMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ReminderMutation builder.
+func (m *ReminderMutation) Filter() *ReminderFilter {
+	return &ReminderFilter{m}
+}
+
+// ReminderFilter provides a generic filtering capability at runtime for ReminderQuery.
+type ReminderFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ReminderFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *ReminderFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(reminder.FieldID))
+}
+
+// WhereOffsetMinutes applies the entql int predicate on the offset_minutes field.
+func (f *ReminderFilter) WhereOffsetMinutes(p entql.IntP) {
+	f.Where(p.Field(reminder.FieldOffsetMinutes))
+}
+
+// WhereRemindAt applies the entql time.Time predicate on the remind_at field.
+func (f *ReminderFilter) WhereRemindAt(p entql.TimeP) {
+	f.Where(p.Field(reminder.FieldRemindAt))
+}
+
+// WhereSentAt applies the entql time.Time predicate on the sent_at field.
+func (f *ReminderFilter) WhereSentAt(p entql.TimeP) {
+	f.Where(p.Field(reminder.FieldSentAt))
+}
+
+// WhereLockedUntil applies the entql time.Time predicate on the locked_until field.
+func (f *ReminderFilter) WhereLockedUntil(p entql.TimeP) {
+	f.Where(p.Field(reminder.FieldLockedUntil))
+}
+
+// WhereLockedBy applies the entql string predicate on the locked_by field.
+func (f *ReminderFilter) WhereLockedBy(p entql.StringP) {
+	f.Where(p.Field(reminder.FieldLockedBy))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *ReminderFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(reminder.FieldAttempts))
+}
+
+// WhereLastError applies the entql string predicate on the last_error field.
+func (f *ReminderFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(reminder.FieldLastError))
+}
+
+// WhereHasTodo applies a predicate to check if query has an edge todo.
+func (f *ReminderFilter) WhereHasTodo() {
+	f.Where(entql.HasEdge("todo"))
+}
+
+// WhereHasTodoWith applies a predicate to check if query has an edge todo with a given conditions (other predicates).
+func (f *ReminderFilter) WhereHasTodoWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("todo", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rtq *RevokedTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	rtq.predicates = append(rtq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RevokedTokenQuery builder.
+func (rtq *RevokedTokenQuery) Filter() *RevokedTokenFilter {
+	return &RevokedTokenFilter{rtq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RevokedTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RevokedTokenMutation builder.
+func (m *RevokedTokenMutation) Filter() *RevokedTokenFilter {
+	return &RevokedTokenFilter{m}
+}
+
+// RevokedTokenFilter provides a generic filtering capability at runtime for RevokedTokenQuery.
+type RevokedTokenFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RevokedTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *RevokedTokenFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(revokedtoken.FieldID))
+}
+
+// WhereJti applies the entql string predicate on the jti field.
+func (f *RevokedTokenFilter) WhereJti(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldJti))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *RevokedTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(revokedtoken.FieldExpiresAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (tq *TagQuery) addPredicate(pred func(s *sql.Selector)) {
+	tq.predicates = append(tq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TagQuery builder.
+func (tq *TagQuery) Filter() *TagFilter {
+	return &TagFilter{tq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TagMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TagMutation builder.
+func (m *TagMutation) Filter() *TagFilter {
+	return &TagFilter{m}
+}
+
+// TagFilter provides a generic filtering capability at runtime for TagQuery.
+type TagFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TagFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *TagFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(tag.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TagFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(tag.FieldName))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *TagFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *TagFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTodos applies a predicate to check if query has an edge todos.
+func (f *TagFilter) WhereHasTodos() {
+	f.Where(entql.HasEdge("todos"))
+}
+
+// WhereHasTodosWith applies a predicate to check if query has an edge todos with a given conditions (other predicates).
+func (f *TagFilter) WhereHasTodosWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("todos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (tq *TodoQuery) addPredicate(pred func(s *sql.Selector)) {
+	tq.predicates = append(tq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TodoQuery builder.
+func (tq *TodoQuery) Filter() *TodoFilter {
+	return &TodoFilter{tq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TodoMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TodoMutation builder.
+func (m *TodoMutation) Filter() *TodoFilter {
+	return &TodoFilter{m}
+}
+
+// TodoFilter provides a generic filtering capability at runtime for TodoQuery.
+type TodoFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TodoFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *TodoFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(todo.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TodoFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TodoFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *TodoFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldDeletedAt))
+}
+
+// WhereTitle applies the entql string predicate on the title field.
+func (f *TodoFilter) WhereTitle(p entql.StringP) {
+	f.Where(p.Field(todo.FieldTitle))
+}
+
+// WhereContent applies the entql string predicate on the content field.
+func (f *TodoFilter) WhereContent(p entql.StringP) {
+	f.Where(p.Field(todo.FieldContent))
+}
+
+// WhereDeadline applies the entql time.Time predicate on the deadline field.
+func (f *TodoFilter) WhereDeadline(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldDeadline))
+}
+
+// WhereIsCompleted applies the entql bool predicate on the is_completed field.
+func (f *TodoFilter) WhereIsCompleted(p entql.BoolP) {
+	f.Where(p.Field(todo.FieldIsCompleted))
+}
+
+// WhereCompletedAt applies the entql time.Time predicate on the completed_at field.
+func (f *TodoFilter) WhereCompletedAt(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldCompletedAt))
+}
+
+// WhereAutoComplete applies the entql bool predicate on the auto_complete field.
+func (f *TodoFilter) WhereAutoComplete(p entql.BoolP) {
+	f.Where(p.Field(todo.FieldAutoComplete))
+}
+
+// WhereRecurrence applies the entql string predicate on the recurrence field.
+func (f *TodoFilter) WhereRecurrence(p entql.StringP) {
+	f.Where(p.Field(todo.FieldRecurrence))
+}
+
+// WhereTimezone applies the entql string predicate on the timezone field.
+func (f *TodoFilter) WhereTimezone(p entql.StringP) {
+	f.Where(p.Field(todo.FieldTimezone))
+}
+
+// WhereOccurrence applies the entql int predicate on the occurrence field.
+func (f *TodoFilter) WhereOccurrence(p entql.IntP) {
+	f.Where(p.Field(todo.FieldOccurrence))
+}
+
+// WherePriority applies the entql string predicate on the priority field.
+func (f *TodoFilter) WherePriority(p entql.StringP) {
+	f.Where(p.Field(todo.FieldPriority))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *TodoFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *TodoFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasTagsWith(preds ...predicate.Tag) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasProject applies a predicate to check if query has an edge project.
+func (f *TodoFilter) WhereHasProject() {
+	f.Where(entql.HasEdge("project"))
+}
+
+// WhereHasProjectWith applies a predicate to check if query has an edge project with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasProjectWith(preds ...predicate.Project) {
+	f.Where(entql.HasEdgeWith("project", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasOrigin applies a predicate to check if query has an edge origin.
+func (f *TodoFilter) WhereHasOrigin() {
+	f.Where(entql.HasEdge("origin"))
+}
+
+// WhereHasOriginWith applies a predicate to check if query has an edge origin with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasOriginWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("origin", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasOccurrences applies a predicate to check if query has an edge occurrences.
+func (f *TodoFilter) WhereHasOccurrences() {
+	f.Where(entql.HasEdge("occurrences"))
+}
+
+// WhereHasOccurrencesWith applies a predicate to check if query has an edge occurrences with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasOccurrencesWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("occurrences", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChecklistItems applies a predicate to check if query has an edge checklist_items.
+func (f *TodoFilter) WhereHasChecklistItems() {
+	f.Where(entql.HasEdge("checklist_items"))
+}
+
+// WhereHasChecklistItemsWith applies a predicate to check if query has an edge checklist_items with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasChecklistItemsWith(preds ...predicate.ChecklistItem) {
+	f.Where(entql.HasEdgeWith("checklist_items", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasReminders applies a predicate to check if query has an edge reminders.
+func (f *TodoFilter) WhereHasReminders() {
+	f.Where(entql.HasEdge("reminders"))
+}
+
+// WhereHasRemindersWith applies a predicate to check if query has an edge reminders with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasRemindersWith(preds ...predicate.Reminder) {
+	f.Where(entql.HasEdgeWith("reminders", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
+	uq.predicates = append(uq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UserQuery builder.
+func (uq *UserQuery) Filter() *UserFilter {
+	return &UserFilter{uq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
+func (m *UserMutation) Filter() *UserFilter {
+	return &UserFilter{m}
+}
+
+// UserFilter provides a generic filtering capability at runtime for UserQuery.
+type UserFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UserFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *UserFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(user.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *UserFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *UserFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldUpdatedAt))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *UserFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(user.FieldEmail))
+}
+
+// WherePassword applies the entql string predicate on the password field.
+func (f *UserFilter) WherePassword(p entql.StringP) {
+	f.Where(p.Field(user.FieldPassword))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *UserFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(user.FieldName))
+}
+
+// WhereTokenVersion applies the entql int predicate on the token_version field.
+func (f *UserFilter) WhereTokenVersion(p entql.IntP) {
+	f.Where(p.Field(user.FieldTokenVersion))
+}
+
+// WhereLocale applies the entql string predicate on the locale field.
+func (f *UserFilter) WhereLocale(p entql.StringP) {
+	f.Where(p.Field(user.FieldLocale))
+}
+
+// WhereEmailVerifiedAt applies the entql time.Time predicate on the email_verified_at field.
+func (f *UserFilter) WhereEmailVerifiedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldEmailVerifiedAt))
+}
+
+// WhereVerificationSentAt applies the entql time.Time predicate on the verification_sent_at field.
+func (f *UserFilter) WhereVerificationSentAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldVerificationSentAt))
+}
+
+// WhereVerificationSends applies the entql int predicate on the verification_sends field.
+func (f *UserFilter) WhereVerificationSends(p entql.IntP) {
+	f.Where(p.Field(user.FieldVerificationSends))
+}
+
+// WhereHasTodos applies a predicate to check if query has an edge todos.
+func (f *UserFilter) WhereHasTodos() {
+	f.Where(entql.HasEdge("todos"))
+}
+
+// WhereHasTodosWith applies a predicate to check if query has an edge todos with a given conditions (other predicates).
+func (f *UserFilter) WhereHasTodosWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("todos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRefreshTokens applies a predicate to check if query has an edge refresh_tokens.
+func (f *UserFilter) WhereHasRefreshTokens() {
+	f.Where(entql.HasEdge("refresh_tokens"))
+}
+
+// WhereHasRefreshTokensWith applies a predicate to check if query has an edge refresh_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasRefreshTokensWith(preds ...predicate.RefreshToken) {
+	f.Where(entql.HasEdgeWith("refresh_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPasswordResetTokens applies a predicate to check if query has an edge password_reset_tokens.
+func (f *UserFilter) WhereHasPasswordResetTokens() {
+	f.Where(entql.HasEdge("password_reset_tokens"))
+}
+
+// WhereHasPasswordResetTokensWith applies a predicate to check if query has an edge password_reset_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasPasswordResetTokensWith(preds ...predicate.PasswordResetToken) {
+	f.Where(entql.HasEdgeWith("password_reset_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *UserFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *UserFilter) WhereHasTagsWith(preds ...predicate.Tag) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasProjects applies a predicate to check if query has an edge projects.
+func (f *UserFilter) WhereHasProjects() {
+	f.Where(entql.HasEdge("projects"))
+}
+
+// WhereHasProjectsWith applies a predicate to check if query has an edge projects with a given conditions (other predicates).
+func (f *UserFilter) WhereHasProjectsWith(preds ...predicate.Project) {
+	f.Where(entql.HasEdgeWith("projects", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
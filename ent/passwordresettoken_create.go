@@ -0,0 +1,307 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"halill/ent/passwordresettoken"
+	"halill/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordResetTokenCreate is the builder for creating a PasswordResetToken entity.
+type PasswordResetTokenCreate struct {
+	config
+	mutation *PasswordResetTokenMutation
+	hooks    []Hook
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (prtc *PasswordResetTokenCreate) SetTokenHash(s string) *PasswordResetTokenCreate {
+	prtc.mutation.SetTokenHash(s)
+	return prtc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (prtc *PasswordResetTokenCreate) SetExpiresAt(t time.Time) *PasswordResetTokenCreate {
+	prtc.mutation.SetExpiresAt(t)
+	return prtc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (prtc *PasswordResetTokenCreate) SetUsedAt(t time.Time) *PasswordResetTokenCreate {
+	prtc.mutation.SetUsedAt(t)
+	return prtc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (prtc *PasswordResetTokenCreate) SetNillableUsedAt(t *time.Time) *PasswordResetTokenCreate {
+	if t != nil {
+		prtc.SetUsedAt(*t)
+	}
+	return prtc
+}
+
+// SetID sets the "id" field.
+func (prtc *PasswordResetTokenCreate) SetID(i int64) *PasswordResetTokenCreate {
+	prtc.mutation.SetID(i)
+	return prtc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (prtc *PasswordResetTokenCreate) SetUserID(id int64) *PasswordResetTokenCreate {
+	prtc.mutation.SetUserID(id)
+	return prtc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (prtc *PasswordResetTokenCreate) SetUser(u *User) *PasswordResetTokenCreate {
+	return prtc.SetUserID(u.ID)
+}
+
+// Mutation returns the PasswordResetTokenMutation object of the builder.
+func (prtc *PasswordResetTokenCreate) Mutation() *PasswordResetTokenMutation {
+	return prtc.mutation
+}
+
+// Save creates the PasswordResetToken in the database.
+func (prtc *PasswordResetTokenCreate) Save(ctx context.Context) (*PasswordResetToken, error) {
+	var (
+		err  error
+		node *PasswordResetToken
+	)
+	if len(prtc.hooks) == 0 {
+		if err = prtc.check(); err != nil {
+			return nil, err
+		}
+		node, err = prtc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasswordResetTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = prtc.check(); err != nil {
+				return nil, err
+			}
+			prtc.mutation = mutation
+			if node, err = prtc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(prtc.hooks) - 1; i >= 0; i-- {
+			if prtc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = prtc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, prtc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prtc *PasswordResetTokenCreate) SaveX(ctx context.Context) *PasswordResetToken {
+	v, err := prtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prtc *PasswordResetTokenCreate) Exec(ctx context.Context) error {
+	_, err := prtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prtc *PasswordResetTokenCreate) ExecX(ctx context.Context) {
+	if err := prtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prtc *PasswordResetTokenCreate) check() error {
+	if _, ok := prtc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "token_hash"`)}
+	}
+	if _, ok := prtc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "expires_at"`)}
+	}
+	if _, ok := prtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("ent: missing required edge \"user\"")}
+	}
+	return nil
+}
+
+func (prtc *PasswordResetTokenCreate) sqlSave(ctx context.Context) (*PasswordResetToken, error) {
+	_node, _spec := prtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (prtc *PasswordResetTokenCreate) createSpec() (*PasswordResetToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasswordResetToken{config: prtc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: passwordresettoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: passwordresettoken.FieldID,
+			},
+		}
+	)
+	if id, ok := prtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := prtc.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passwordresettoken.FieldTokenHash,
+		})
+		_node.TokenHash = value
+	}
+	if value, ok := prtc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passwordresettoken.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := prtc.mutation.UsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passwordresettoken.FieldUsedAt,
+		})
+		_node.UsedAt = &value
+	}
+	if nodes := prtc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.UserTable,
+			Columns: []string{passwordresettoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_password_reset_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PasswordResetTokenCreateBulk is the builder for creating many PasswordResetToken entities in bulk.
+type PasswordResetTokenCreateBulk struct {
+	config
+	builders []*PasswordResetTokenCreate
+}
+
+// Save creates the PasswordResetToken entities in the database.
+func (prtcb *PasswordResetTokenCreateBulk) Save(ctx context.Context) ([]*PasswordResetToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(prtcb.builders))
+	nodes := make([]*PasswordResetToken, len(prtcb.builders))
+	mutators := make([]Mutator, len(prtcb.builders))
+	for i := range prtcb.builders {
+		func(i int, root context.Context) {
+			builder := prtcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasswordResetTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prtcb *PasswordResetTokenCreateBulk) SaveX(ctx context.Context) []*PasswordResetToken {
+	v, err := prtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prtcb *PasswordResetTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := prtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prtcb *PasswordResetTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := prtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
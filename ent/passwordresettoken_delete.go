@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"halill/ent/passwordresettoken"
+	"halill/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordResetTokenDelete is the builder for deleting a PasswordResetToken entity.
+type PasswordResetTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *PasswordResetTokenMutation
+}
+
+// Where appends a list predicates to the PasswordResetTokenDelete builder.
+func (prtd *PasswordResetTokenDelete) Where(ps ...predicate.PasswordResetToken) *PasswordResetTokenDelete {
+	prtd.mutation.Where(ps...)
+	return prtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (prtd *PasswordResetTokenDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(prtd.hooks) == 0 {
+		affected, err = prtd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasswordResetTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			prtd.mutation = mutation
+			affected, err = prtd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(prtd.hooks) - 1; i >= 0; i-- {
+			if prtd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = prtd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, prtd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prtd *PasswordResetTokenDelete) ExecX(ctx context.Context) int {
+	n, err := prtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (prtd *PasswordResetTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: passwordresettoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: passwordresettoken.FieldID,
+			},
+		},
+	}
+	if ps := prtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, prtd.driver, _spec)
+}
+
+// PasswordResetTokenDeleteOne is the builder for deleting a single PasswordResetToken entity.
+type PasswordResetTokenDeleteOne struct {
+	prtd *PasswordResetTokenDelete
+}
+
+// Exec executes the deletion query.
+func (prtdo *PasswordResetTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := prtdo.prtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{passwordresettoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prtdo *PasswordResetTokenDeleteOne) ExecX(ctx context.Context) {
+	prtdo.prtd.ExecX(ctx)
+}
@@ -0,0 +1,992 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"halill/ent/passwordresettoken"
+	"halill/ent/predicate"
+	"halill/ent/user"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordResetTokenQuery is the builder for querying PasswordResetToken entities.
+type PasswordResetTokenQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PasswordResetToken
+	// eager-loading edges.
+	withUser *UserQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PasswordResetTokenQuery builder.
+func (prtq *PasswordResetTokenQuery) Where(ps ...predicate.PasswordResetToken) *PasswordResetTokenQuery {
+	prtq.predicates = append(prtq.predicates, ps...)
+	return prtq
+}
+
+// Limit adds a limit step to the query.
+func (prtq *PasswordResetTokenQuery) Limit(limit int) *PasswordResetTokenQuery {
+	prtq.limit = &limit
+	return prtq
+}
+
+// Offset adds an offset step to the query.
+func (prtq *PasswordResetTokenQuery) Offset(offset int) *PasswordResetTokenQuery {
+	prtq.offset = &offset
+	return prtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (prtq *PasswordResetTokenQuery) Unique(unique bool) *PasswordResetTokenQuery {
+	prtq.unique = &unique
+	return prtq
+}
+
+// Order adds an order step to the query.
+func (prtq *PasswordResetTokenQuery) Order(o ...OrderFunc) *PasswordResetTokenQuery {
+	prtq.order = append(prtq.order, o...)
+	return prtq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (prtq *PasswordResetTokenQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: prtq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passwordresettoken.Table, passwordresettoken.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, passwordresettoken.UserTable, passwordresettoken.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PasswordResetToken entity from the query.
+// Returns a *NotFoundError when no PasswordResetToken was found.
+func (prtq *PasswordResetTokenQuery) First(ctx context.Context) (*PasswordResetToken, error) {
+	nodes, err := prtq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{passwordresettoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (prtq *PasswordResetTokenQuery) FirstX(ctx context.Context) *PasswordResetToken {
+	node, err := prtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PasswordResetToken ID from the query.
+// Returns a *NotFoundError when no PasswordResetToken ID was found.
+func (prtq *PasswordResetTokenQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = prtq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{passwordresettoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (prtq *PasswordResetTokenQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := prtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PasswordResetToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one PasswordResetToken entity is not found.
+// Returns a *NotFoundError when no PasswordResetToken entities are found.
+func (prtq *PasswordResetTokenQuery) Only(ctx context.Context) (*PasswordResetToken, error) {
+	nodes, err := prtq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{passwordresettoken.Label}
+	default:
+		return nil, &NotSingularError{passwordresettoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (prtq *PasswordResetTokenQuery) OnlyX(ctx context.Context) *PasswordResetToken {
+	node, err := prtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PasswordResetToken ID in the query.
+// Returns a *NotSingularError when exactly one PasswordResetToken ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (prtq *PasswordResetTokenQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = prtq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = &NotSingularError{passwordresettoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (prtq *PasswordResetTokenQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := prtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PasswordResetTokens.
+func (prtq *PasswordResetTokenQuery) All(ctx context.Context) ([]*PasswordResetToken, error) {
+	if err := prtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return prtq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (prtq *PasswordResetTokenQuery) AllX(ctx context.Context) []*PasswordResetToken {
+	nodes, err := prtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PasswordResetToken IDs.
+func (prtq *PasswordResetTokenQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := prtq.Select(passwordresettoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (prtq *PasswordResetTokenQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := prtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (prtq *PasswordResetTokenQuery) Count(ctx context.Context) (int, error) {
+	if err := prtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return prtq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (prtq *PasswordResetTokenQuery) CountX(ctx context.Context) int {
+	count, err := prtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (prtq *PasswordResetTokenQuery) Exist(ctx context.Context) (bool, error) {
+	if err := prtq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return prtq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (prtq *PasswordResetTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := prtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PasswordResetTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (prtq *PasswordResetTokenQuery) Clone() *PasswordResetTokenQuery {
+	if prtq == nil {
+		return nil
+	}
+	return &PasswordResetTokenQuery{
+		config:     prtq.config,
+		limit:      prtq.limit,
+		offset:     prtq.offset,
+		order:      append([]OrderFunc{}, prtq.order...),
+		predicates: append([]predicate.PasswordResetToken{}, prtq.predicates...),
+		withUser:   prtq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  prtq.sql.Clone(),
+		path: prtq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (prtq *PasswordResetTokenQuery) WithUser(opts ...func(*UserQuery)) *PasswordResetTokenQuery {
+	query := &UserQuery{config: prtq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	prtq.withUser = query
+	return prtq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TokenHash string `json:"token_hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PasswordResetToken.Query().
+//		GroupBy(passwordresettoken.FieldTokenHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (prtq *PasswordResetTokenQuery) GroupBy(field string, fields ...string) *PasswordResetTokenGroupBy {
+	group := &PasswordResetTokenGroupBy{config: prtq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := prtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return prtq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TokenHash string `json:"token_hash,omitempty"`
+//	}
+//
+//	client.PasswordResetToken.Query().
+//		Select(passwordresettoken.FieldTokenHash).
+//		Scan(ctx, &v)
+func (prtq *PasswordResetTokenQuery) Select(fields ...string) *PasswordResetTokenSelect {
+	prtq.fields = append(prtq.fields, fields...)
+	return &PasswordResetTokenSelect{PasswordResetTokenQuery: prtq}
+}
+
+func (prtq *PasswordResetTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range prtq.fields {
+		if !passwordresettoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if prtq.path != nil {
+		prev, err := prtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		prtq.sql = prev
+	}
+	return nil
+}
+
+func (prtq *PasswordResetTokenQuery) sqlAll(ctx context.Context) ([]*PasswordResetToken, error) {
+	var (
+		nodes       = []*PasswordResetToken{}
+		withFKs     = prtq.withFKs
+		_spec       = prtq.querySpec()
+		loadedTypes = [1]bool{
+			prtq.withUser != nil,
+		}
+	)
+	if prtq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordresettoken.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &PasswordResetToken{config: prtq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, prtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := prtq.withUser; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*PasswordResetToken)
+		for i := range nodes {
+			if nodes[i].user_password_reset_tokens == nil {
+				continue
+			}
+			fk := *nodes[i].user_password_reset_tokens
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_password_reset_tokens" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (prtq *PasswordResetTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := prtq.querySpec()
+	return sqlgraph.CountNodes(ctx, prtq.driver, _spec)
+}
+
+func (prtq *PasswordResetTokenQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := prtq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (prtq *PasswordResetTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   passwordresettoken.Table,
+			Columns: passwordresettoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: passwordresettoken.FieldID,
+			},
+		},
+		From:   prtq.sql,
+		Unique: true,
+	}
+	if unique := prtq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := prtq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordresettoken.FieldID)
+		for i := range fields {
+			if fields[i] != passwordresettoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := prtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := prtq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := prtq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := prtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (prtq *PasswordResetTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(prtq.driver.Dialect())
+	t1 := builder.Table(passwordresettoken.Table)
+	columns := prtq.fields
+	if len(columns) == 0 {
+		columns = passwordresettoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if prtq.sql != nil {
+		selector = prtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range prtq.predicates {
+		p(selector)
+	}
+	for _, p := range prtq.order {
+		p(selector)
+	}
+	if offset := prtq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := prtq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PasswordResetTokenGroupBy is the group-by builder for PasswordResetToken entities.
+type PasswordResetTokenGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (prtgb *PasswordResetTokenGroupBy) Aggregate(fns ...AggregateFunc) *PasswordResetTokenGroupBy {
+	prtgb.fns = append(prtgb.fns, fns...)
+	return prtgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (prtgb *PasswordResetTokenGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := prtgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	prtgb.sql = query
+	return prtgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := prtgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prtgb *PasswordResetTokenGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(prtgb.fields) > 1 {
+		return nil, errors.New("ent: PasswordResetTokenGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := prtgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) StringsX(ctx context.Context) []string {
+	v, err := prtgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prtgb *PasswordResetTokenGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = prtgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = fmt.Errorf("ent: PasswordResetTokenGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) StringX(ctx context.Context) string {
+	v, err := prtgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prtgb *PasswordResetTokenGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(prtgb.fields) > 1 {
+		return nil, errors.New("ent: PasswordResetTokenGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := prtgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) IntsX(ctx context.Context) []int {
+	v, err := prtgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prtgb *PasswordResetTokenGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = prtgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = fmt.Errorf("ent: PasswordResetTokenGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) IntX(ctx context.Context) int {
+	v, err := prtgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prtgb *PasswordResetTokenGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(prtgb.fields) > 1 {
+		return nil, errors.New("ent: PasswordResetTokenGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := prtgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := prtgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prtgb *PasswordResetTokenGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = prtgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = fmt.Errorf("ent: PasswordResetTokenGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := prtgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prtgb *PasswordResetTokenGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(prtgb.fields) > 1 {
+		return nil, errors.New("ent: PasswordResetTokenGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := prtgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := prtgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prtgb *PasswordResetTokenGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = prtgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = fmt.Errorf("ent: PasswordResetTokenGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (prtgb *PasswordResetTokenGroupBy) BoolX(ctx context.Context) bool {
+	v, err := prtgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (prtgb *PasswordResetTokenGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range prtgb.fields {
+		if !passwordresettoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := prtgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prtgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (prtgb *PasswordResetTokenGroupBy) sqlQuery() *sql.Selector {
+	selector := prtgb.sql.Select()
+	aggregation := make([]string, 0, len(prtgb.fns))
+	for _, fn := range prtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(prtgb.fields)+len(prtgb.fns))
+		for _, f := range prtgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(prtgb.fields...)...)
+}
+
+// PasswordResetTokenSelect is the builder for selecting fields of PasswordResetToken entities.
+type PasswordResetTokenSelect struct {
+	*PasswordResetTokenQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prts *PasswordResetTokenSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := prts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	prts.sql = prts.PasswordResetTokenQuery.sqlQuery(ctx)
+	return prts.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := prts.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (prts *PasswordResetTokenSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(prts.fields) > 1 {
+		return nil, errors.New("ent: PasswordResetTokenSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := prts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) StringsX(ctx context.Context) []string {
+	v, err := prts.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (prts *PasswordResetTokenSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = prts.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = fmt.Errorf("ent: PasswordResetTokenSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) StringX(ctx context.Context) string {
+	v, err := prts.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (prts *PasswordResetTokenSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(prts.fields) > 1 {
+		return nil, errors.New("ent: PasswordResetTokenSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := prts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) IntsX(ctx context.Context) []int {
+	v, err := prts.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (prts *PasswordResetTokenSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = prts.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = fmt.Errorf("ent: PasswordResetTokenSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) IntX(ctx context.Context) int {
+	v, err := prts.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (prts *PasswordResetTokenSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(prts.fields) > 1 {
+		return nil, errors.New("ent: PasswordResetTokenSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := prts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := prts.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (prts *PasswordResetTokenSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = prts.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = fmt.Errorf("ent: PasswordResetTokenSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) Float64X(ctx context.Context) float64 {
+	v, err := prts.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (prts *PasswordResetTokenSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(prts.fields) > 1 {
+		return nil, errors.New("ent: PasswordResetTokenSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := prts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) BoolsX(ctx context.Context) []bool {
+	v, err := prts.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (prts *PasswordResetTokenSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = prts.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordresettoken.Label}
+	default:
+		err = fmt.Errorf("ent: PasswordResetTokenSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (prts *PasswordResetTokenSelect) BoolX(ctx context.Context) bool {
+	v, err := prts.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (prts *PasswordResetTokenSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := prts.sql.Query()
+	if err := prts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,213 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"halill/ent/reminder"
+	"halill/ent/todo"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Reminder is the model entity for the Reminder schema.
+type Reminder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// OffsetMinutes holds the value of the "offset_minutes" field.
+	OffsetMinutes int `json:"offset_minutes,omitempty"`
+	// RemindAt holds the value of the "remind_at" field.
+	RemindAt *time.Time `json:"remind_at,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt *time.Time `json:"sent_at,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// LockedBy holds the value of the "locked_by" field.
+	LockedBy string `json:"locked_by,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReminderQuery when eager-loading is set.
+	Edges          ReminderEdges `json:"edges"`
+	todo_reminders *int64
+}
+
+// ReminderEdges holds the relations/edges for other nodes in the graph.
+type ReminderEdges struct {
+	// Todo holds the value of the todo edge.
+	Todo *Todo `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReminderEdges) TodoOrErr() (*Todo, error) {
+	if e.loadedTypes[0] {
+		if e.Todo == nil {
+			// The edge todo was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todo.Label}
+		}
+		return e.Todo, nil
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reminder) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reminder.FieldID, reminder.FieldOffsetMinutes, reminder.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case reminder.FieldLockedBy, reminder.FieldLastError:
+			values[i] = new(sql.NullString)
+		case reminder.FieldRemindAt, reminder.FieldSentAt, reminder.FieldLockedUntil:
+			values[i] = new(sql.NullTime)
+		case reminder.ForeignKeys[0]: // todo_reminders
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Reminder", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reminder fields.
+func (r *Reminder) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reminder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int64(value.Int64)
+		case reminder.FieldOffsetMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset_minutes", values[i])
+			} else if value.Valid {
+				r.OffsetMinutes = int(value.Int64)
+			}
+		case reminder.FieldRemindAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field remind_at", values[i])
+			} else if value.Valid {
+				r.RemindAt = new(time.Time)
+				*r.RemindAt = value.Time
+			}
+		case reminder.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				r.SentAt = new(time.Time)
+				*r.SentAt = value.Time
+			}
+		case reminder.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				r.LockedUntil = new(time.Time)
+				*r.LockedUntil = value.Time
+			}
+		case reminder.FieldLockedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_by", values[i])
+			} else if value.Valid {
+				r.LockedBy = value.String
+			}
+		case reminder.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				r.Attempts = int(value.Int64)
+			}
+		case reminder.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				r.LastError = value.String
+			}
+		case reminder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_reminders", value)
+			} else if value.Valid {
+				r.todo_reminders = new(int64)
+				*r.todo_reminders = int64(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryTodo queries the "todo" edge of the Reminder entity.
+func (r *Reminder) QueryTodo() *TodoQuery {
+	return (&ReminderClient{config: r.config}).QueryTodo(r)
+}
+
+// Update returns a builder for updating this Reminder.
+// Note that you need to call Reminder.Unwrap() before calling this method if this Reminder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reminder) Update() *ReminderUpdateOne {
+	return (&ReminderClient{config: r.config}).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reminder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reminder) Unwrap() *Reminder {
+	tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reminder is not a transactional entity")
+	}
+	r.config.driver = tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reminder) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reminder(")
+	builder.WriteString(fmt.Sprintf("id=%v", r.ID))
+	builder.WriteString(", offset_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", r.OffsetMinutes))
+	if v := r.RemindAt; v != nil {
+		builder.WriteString(", remind_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := r.SentAt; v != nil {
+		builder.WriteString(", sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := r.LockedUntil; v != nil {
+		builder.WriteString(", locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", locked_by=")
+	builder.WriteString(r.LockedBy)
+	builder.WriteString(", attempts=")
+	builder.WriteString(fmt.Sprintf("%v", r.Attempts))
+	builder.WriteString(", last_error=")
+	builder.WriteString(r.LastError)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reminders is a parsable slice of Reminder.
+type Reminders []*Reminder
+
+func (r Reminders) config(cfg config) {
+	for _i := range r {
+		r[_i].config = cfg
+	}
+}
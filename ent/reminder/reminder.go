@@ -0,0 +1,90 @@
+// Code generated by entc, DO NOT EDIT.
+
+package reminder
+
+import (
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the reminder type in the database.
+	Label = "reminder"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOffsetMinutes holds the string denoting the offset_minutes field in the database.
+	FieldOffsetMinutes = "offset_minutes"
+	// FieldRemindAt holds the string denoting the remind_at field in the database.
+	FieldRemindAt = "remind_at"
+	// FieldSentAt holds the string denoting the sent_at field in the database.
+	FieldSentAt = "sent_at"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldLockedBy holds the string denoting the locked_by field in the database.
+	FieldLockedBy = "locked_by"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// EdgeTodo holds the string denoting the todo edge name in mutations.
+	EdgeTodo = "todo"
+	// Table holds the table name of the reminder in the database.
+	Table = "reminders"
+	// TodoTable is the table that holds the todo relation/edge.
+	TodoTable = "reminders"
+	// TodoInverseTable is the table name for the Todo entity.
+	// It exists in this package in order to avoid circular dependency with the "todo" package.
+	TodoInverseTable = "todos"
+	// TodoColumn is the table column denoting the todo relation/edge.
+	TodoColumn = "todo_reminders"
+)
+
+// Columns holds all SQL columns for reminder fields.
+var Columns = []string{
+	FieldID,
+	FieldOffsetMinutes,
+	FieldRemindAt,
+	FieldSentAt,
+	FieldLockedUntil,
+	FieldLockedBy,
+	FieldAttempts,
+	FieldLastError,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "reminders"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"todo_reminders",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "halill/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// OffsetMinutesValidator is a validator for the "offset_minutes" field. It is called by the builders before save.
+	OffsetMinutesValidator func(int) error
+	// DefaultLockedBy holds the default value on creation for the "locked_by" field.
+	DefaultLockedBy string
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultLastError holds the default value on creation for the "last_error" field.
+	DefaultLastError string
+)
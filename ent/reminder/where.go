@@ -0,0 +1,847 @@
+// Code generated by entc, DO NOT EDIT.
+
+package reminder
+
+import (
+	"halill/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// OffsetMinutes applies equality check predicate on the "offset_minutes" field. It's identical to OffsetMinutesEQ.
+func OffsetMinutes(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOffsetMinutes), v))
+	})
+}
+
+// RemindAt applies equality check predicate on the "remind_at" field. It's identical to RemindAtEQ.
+func RemindAt(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemindAt), v))
+	})
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSentAt), v))
+	})
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedBy applies equality check predicate on the "locked_by" field. It's identical to LockedByEQ.
+func LockedBy(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLockedBy), v))
+	})
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastError), v))
+	})
+}
+
+// OffsetMinutesEQ applies the EQ predicate on the "offset_minutes" field.
+func OffsetMinutesEQ(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOffsetMinutes), v))
+	})
+}
+
+// OffsetMinutesNEQ applies the NEQ predicate on the "offset_minutes" field.
+func OffsetMinutesNEQ(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOffsetMinutes), v))
+	})
+}
+
+// OffsetMinutesIn applies the In predicate on the "offset_minutes" field.
+func OffsetMinutesIn(vs ...int) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOffsetMinutes), v...))
+	})
+}
+
+// OffsetMinutesNotIn applies the NotIn predicate on the "offset_minutes" field.
+func OffsetMinutesNotIn(vs ...int) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOffsetMinutes), v...))
+	})
+}
+
+// OffsetMinutesGT applies the GT predicate on the "offset_minutes" field.
+func OffsetMinutesGT(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOffsetMinutes), v))
+	})
+}
+
+// OffsetMinutesGTE applies the GTE predicate on the "offset_minutes" field.
+func OffsetMinutesGTE(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOffsetMinutes), v))
+	})
+}
+
+// OffsetMinutesLT applies the LT predicate on the "offset_minutes" field.
+func OffsetMinutesLT(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOffsetMinutes), v))
+	})
+}
+
+// OffsetMinutesLTE applies the LTE predicate on the "offset_minutes" field.
+func OffsetMinutesLTE(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOffsetMinutes), v))
+	})
+}
+
+// RemindAtEQ applies the EQ predicate on the "remind_at" field.
+func RemindAtEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemindAt), v))
+	})
+}
+
+// RemindAtNEQ applies the NEQ predicate on the "remind_at" field.
+func RemindAtNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRemindAt), v))
+	})
+}
+
+// RemindAtIn applies the In predicate on the "remind_at" field.
+func RemindAtIn(vs ...time.Time) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRemindAt), v...))
+	})
+}
+
+// RemindAtNotIn applies the NotIn predicate on the "remind_at" field.
+func RemindAtNotIn(vs ...time.Time) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRemindAt), v...))
+	})
+}
+
+// RemindAtGT applies the GT predicate on the "remind_at" field.
+func RemindAtGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRemindAt), v))
+	})
+}
+
+// RemindAtGTE applies the GTE predicate on the "remind_at" field.
+func RemindAtGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRemindAt), v))
+	})
+}
+
+// RemindAtLT applies the LT predicate on the "remind_at" field.
+func RemindAtLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRemindAt), v))
+	})
+}
+
+// RemindAtLTE applies the LTE predicate on the "remind_at" field.
+func RemindAtLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRemindAt), v))
+	})
+}
+
+// RemindAtIsNil applies the IsNil predicate on the "remind_at" field.
+func RemindAtIsNil() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRemindAt)))
+	})
+}
+
+// RemindAtNotNil applies the NotNil predicate on the "remind_at" field.
+func RemindAtNotNil() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRemindAt)))
+	})
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSentAt), v...))
+	})
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSentAt), v...))
+	})
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSentAt), v))
+	})
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSentAt)))
+	})
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSentAt)))
+	})
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLockedUntil), v...))
+	})
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLockedUntil), v...))
+	})
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLockedUntil)))
+	})
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLockedUntil)))
+	})
+}
+
+// LockedByEQ applies the EQ predicate on the "locked_by" field.
+func LockedByEQ(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByNEQ applies the NEQ predicate on the "locked_by" field.
+func LockedByNEQ(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByIn applies the In predicate on the "locked_by" field.
+func LockedByIn(vs ...string) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLockedBy), v...))
+	})
+}
+
+// LockedByNotIn applies the NotIn predicate on the "locked_by" field.
+func LockedByNotIn(vs ...string) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLockedBy), v...))
+	})
+}
+
+// LockedByGT applies the GT predicate on the "locked_by" field.
+func LockedByGT(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByGTE applies the GTE predicate on the "locked_by" field.
+func LockedByGTE(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByLT applies the LT predicate on the "locked_by" field.
+func LockedByLT(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByLTE applies the LTE predicate on the "locked_by" field.
+func LockedByLTE(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByContains applies the Contains predicate on the "locked_by" field.
+func LockedByContains(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByHasPrefix applies the HasPrefix predicate on the "locked_by" field.
+func LockedByHasPrefix(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByHasSuffix applies the HasSuffix predicate on the "locked_by" field.
+func LockedByHasSuffix(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByEqualFold applies the EqualFold predicate on the "locked_by" field.
+func LockedByEqualFold(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLockedBy), v))
+	})
+}
+
+// LockedByContainsFold applies the ContainsFold predicate on the "locked_by" field.
+func LockedByContainsFold(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLockedBy), v))
+	})
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttempts), v))
+	})
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastError), v...))
+	})
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Reminder {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Reminder(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastError), v...))
+	})
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLastError), v))
+	})
+}
+
+// HasTodo applies the HasEdge predicate on the "todo" edge.
+func HasTodo() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TodoTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TodoTable, TodoColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTodoWith applies the HasEdge predicate on the "todo" edge with a given conditions (other predicates).
+func HasTodoWith(preds ...predicate.Todo) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TodoInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TodoTable, TodoColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,435 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"halill/ent/reminder"
+	"halill/ent/todo"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReminderCreate is the builder for creating a Reminder entity.
+type ReminderCreate struct {
+	config
+	mutation *ReminderMutation
+	hooks    []Hook
+}
+
+// SetOffsetMinutes sets the "offset_minutes" field.
+func (rc *ReminderCreate) SetOffsetMinutes(i int) *ReminderCreate {
+	rc.mutation.SetOffsetMinutes(i)
+	return rc
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (rc *ReminderCreate) SetRemindAt(t time.Time) *ReminderCreate {
+	rc.mutation.SetRemindAt(t)
+	return rc
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (rc *ReminderCreate) SetNillableRemindAt(t *time.Time) *ReminderCreate {
+	if t != nil {
+		rc.SetRemindAt(*t)
+	}
+	return rc
+}
+
+// SetSentAt sets the "sent_at" field.
+func (rc *ReminderCreate) SetSentAt(t time.Time) *ReminderCreate {
+	rc.mutation.SetSentAt(t)
+	return rc
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (rc *ReminderCreate) SetNillableSentAt(t *time.Time) *ReminderCreate {
+	if t != nil {
+		rc.SetSentAt(*t)
+	}
+	return rc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (rc *ReminderCreate) SetLockedUntil(t time.Time) *ReminderCreate {
+	rc.mutation.SetLockedUntil(t)
+	return rc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (rc *ReminderCreate) SetNillableLockedUntil(t *time.Time) *ReminderCreate {
+	if t != nil {
+		rc.SetLockedUntil(*t)
+	}
+	return rc
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (rc *ReminderCreate) SetLockedBy(s string) *ReminderCreate {
+	rc.mutation.SetLockedBy(s)
+	return rc
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (rc *ReminderCreate) SetNillableLockedBy(s *string) *ReminderCreate {
+	if s != nil {
+		rc.SetLockedBy(*s)
+	}
+	return rc
+}
+
+// SetAttempts sets the "attempts" field.
+func (rc *ReminderCreate) SetAttempts(i int) *ReminderCreate {
+	rc.mutation.SetAttempts(i)
+	return rc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (rc *ReminderCreate) SetNillableAttempts(i *int) *ReminderCreate {
+	if i != nil {
+		rc.SetAttempts(*i)
+	}
+	return rc
+}
+
+// SetLastError sets the "last_error" field.
+func (rc *ReminderCreate) SetLastError(s string) *ReminderCreate {
+	rc.mutation.SetLastError(s)
+	return rc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (rc *ReminderCreate) SetNillableLastError(s *string) *ReminderCreate {
+	if s != nil {
+		rc.SetLastError(*s)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReminderCreate) SetID(i int64) *ReminderCreate {
+	rc.mutation.SetID(i)
+	return rc
+}
+
+// SetTodoID sets the "todo" edge to the Todo entity by ID.
+func (rc *ReminderCreate) SetTodoID(id int64) *ReminderCreate {
+	rc.mutation.SetTodoID(id)
+	return rc
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (rc *ReminderCreate) SetTodo(t *Todo) *ReminderCreate {
+	return rc.SetTodoID(t.ID)
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (rc *ReminderCreate) Mutation() *ReminderMutation {
+	return rc.mutation
+}
+
+// Save creates the Reminder in the database.
+func (rc *ReminderCreate) Save(ctx context.Context) (*Reminder, error) {
+	var (
+		err  error
+		node *Reminder
+	)
+	if err := rc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rc.hooks) == 0 {
+		if err = rc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReminderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rc.check(); err != nil {
+				return nil, err
+			}
+			rc.mutation = mutation
+			if node, err = rc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rc.hooks) - 1; i >= 0; i-- {
+			if rc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReminderCreate) SaveX(ctx context.Context) *Reminder {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReminderCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReminderCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReminderCreate) defaults() error {
+	if _, ok := rc.mutation.LockedBy(); !ok {
+		v := reminder.DefaultLockedBy
+		rc.mutation.SetLockedBy(v)
+	}
+	if _, ok := rc.mutation.Attempts(); !ok {
+		v := reminder.DefaultAttempts
+		rc.mutation.SetAttempts(v)
+	}
+	if _, ok := rc.mutation.LastError(); !ok {
+		v := reminder.DefaultLastError
+		rc.mutation.SetLastError(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReminderCreate) check() error {
+	if _, ok := rc.mutation.OffsetMinutes(); !ok {
+		return &ValidationError{Name: "offset_minutes", err: errors.New(`ent: missing required field "offset_minutes"`)}
+	}
+	if v, ok := rc.mutation.OffsetMinutes(); ok {
+		if err := reminder.OffsetMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "offset_minutes", err: fmt.Errorf(`ent: validator failed for field "offset_minutes": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.LockedBy(); !ok {
+		return &ValidationError{Name: "locked_by", err: errors.New(`ent: missing required field "locked_by"`)}
+	}
+	if _, ok := rc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "attempts"`)}
+	}
+	if _, ok := rc.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`ent: missing required field "last_error"`)}
+	}
+	if _, ok := rc.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo", err: errors.New("ent: missing required edge \"todo\"")}
+	}
+	return nil
+}
+
+func (rc *ReminderCreate) sqlSave(ctx context.Context) (*Reminder, error) {
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (rc *ReminderCreate) createSpec() (*Reminder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reminder{config: rc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: reminder.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reminder.FieldID,
+			},
+		}
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.OffsetMinutes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldOffsetMinutes,
+		})
+		_node.OffsetMinutes = value
+	}
+	if value, ok := rc.mutation.RemindAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldRemindAt,
+		})
+		_node.RemindAt = &value
+	}
+	if value, ok := rc.mutation.SentAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldSentAt,
+		})
+		_node.SentAt = &value
+	}
+	if value, ok := rc.mutation.LockedUntil(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldLockedUntil,
+		})
+		_node.LockedUntil = &value
+	}
+	if value, ok := rc.mutation.LockedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reminder.FieldLockedBy,
+		})
+		_node.LockedBy = value
+	}
+	if value, ok := rc.mutation.Attempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldAttempts,
+		})
+		_node.Attempts = value
+	}
+	if value, ok := rc.mutation.LastError(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reminder.FieldLastError,
+		})
+		_node.LastError = value
+	}
+	if nodes := rc.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TodoTable,
+			Columns: []string{reminder.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.todo_reminders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReminderCreateBulk is the builder for creating many Reminder entities in bulk.
+type ReminderCreateBulk struct {
+	config
+	builders []*ReminderCreate
+}
+
+// Save creates the Reminder entities in the database.
+func (rcb *ReminderCreateBulk) Save(ctx context.Context) ([]*Reminder, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reminder, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReminderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReminderCreateBulk) SaveX(ctx context.Context) []*Reminder {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReminderCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReminderCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
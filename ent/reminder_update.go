@@ -0,0 +1,789 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"halill/ent/predicate"
+	"halill/ent/reminder"
+	"halill/ent/todo"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReminderUpdate is the builder for updating Reminder entities.
+type ReminderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReminderMutation
+}
+
+// Where appends a list predicates to the ReminderUpdate builder.
+func (ru *ReminderUpdate) Where(ps ...predicate.Reminder) *ReminderUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetOffsetMinutes sets the "offset_minutes" field.
+func (ru *ReminderUpdate) SetOffsetMinutes(i int) *ReminderUpdate {
+	ru.mutation.ResetOffsetMinutes()
+	ru.mutation.SetOffsetMinutes(i)
+	return ru
+}
+
+// AddOffsetMinutes adds i to the "offset_minutes" field.
+func (ru *ReminderUpdate) AddOffsetMinutes(i int) *ReminderUpdate {
+	ru.mutation.AddOffsetMinutes(i)
+	return ru
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (ru *ReminderUpdate) SetRemindAt(t time.Time) *ReminderUpdate {
+	ru.mutation.SetRemindAt(t)
+	return ru
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableRemindAt(t *time.Time) *ReminderUpdate {
+	if t != nil {
+		ru.SetRemindAt(*t)
+	}
+	return ru
+}
+
+// ClearRemindAt clears the value of the "remind_at" field.
+func (ru *ReminderUpdate) ClearRemindAt() *ReminderUpdate {
+	ru.mutation.ClearRemindAt()
+	return ru
+}
+
+// SetSentAt sets the "sent_at" field.
+func (ru *ReminderUpdate) SetSentAt(t time.Time) *ReminderUpdate {
+	ru.mutation.SetSentAt(t)
+	return ru
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableSentAt(t *time.Time) *ReminderUpdate {
+	if t != nil {
+		ru.SetSentAt(*t)
+	}
+	return ru
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (ru *ReminderUpdate) ClearSentAt() *ReminderUpdate {
+	ru.mutation.ClearSentAt()
+	return ru
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ru *ReminderUpdate) SetLockedUntil(t time.Time) *ReminderUpdate {
+	ru.mutation.SetLockedUntil(t)
+	return ru
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableLockedUntil(t *time.Time) *ReminderUpdate {
+	if t != nil {
+		ru.SetLockedUntil(*t)
+	}
+	return ru
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (ru *ReminderUpdate) ClearLockedUntil() *ReminderUpdate {
+	ru.mutation.ClearLockedUntil()
+	return ru
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (ru *ReminderUpdate) SetLockedBy(s string) *ReminderUpdate {
+	ru.mutation.SetLockedBy(s)
+	return ru
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableLockedBy(s *string) *ReminderUpdate {
+	if s != nil {
+		ru.SetLockedBy(*s)
+	}
+	return ru
+}
+
+// SetAttempts sets the "attempts" field.
+func (ru *ReminderUpdate) SetAttempts(i int) *ReminderUpdate {
+	ru.mutation.ResetAttempts()
+	ru.mutation.SetAttempts(i)
+	return ru
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableAttempts(i *int) *ReminderUpdate {
+	if i != nil {
+		ru.SetAttempts(*i)
+	}
+	return ru
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ru *ReminderUpdate) AddAttempts(i int) *ReminderUpdate {
+	ru.mutation.AddAttempts(i)
+	return ru
+}
+
+// SetLastError sets the "last_error" field.
+func (ru *ReminderUpdate) SetLastError(s string) *ReminderUpdate {
+	ru.mutation.SetLastError(s)
+	return ru
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableLastError(s *string) *ReminderUpdate {
+	if s != nil {
+		ru.SetLastError(*s)
+	}
+	return ru
+}
+
+// SetTodoID sets the "todo" edge to the Todo entity by ID.
+func (ru *ReminderUpdate) SetTodoID(id int64) *ReminderUpdate {
+	ru.mutation.SetTodoID(id)
+	return ru
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (ru *ReminderUpdate) SetTodo(t *Todo) *ReminderUpdate {
+	return ru.SetTodoID(t.ID)
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (ru *ReminderUpdate) Mutation() *ReminderMutation {
+	return ru.mutation
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (ru *ReminderUpdate) ClearTodo() *ReminderUpdate {
+	ru.mutation.ClearTodo()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReminderUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ru.hooks) == 0 {
+		if err = ru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReminderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ru.check(); err != nil {
+				return 0, err
+			}
+			ru.mutation = mutation
+			affected, err = ru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ru.hooks) - 1; i >= 0; i-- {
+			if ru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReminderUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReminderUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReminderUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReminderUpdate) check() error {
+	if v, ok := ru.mutation.OffsetMinutes(); ok {
+		if err := reminder.OffsetMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "offset_minutes", err: fmt.Errorf("ent: validator failed for field \"offset_minutes\": %w", err)}
+		}
+	}
+	if _, ok := ru.mutation.TodoID(); ru.mutation.TodoCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"todo\"")
+	}
+	return nil
+}
+
+func (ru *ReminderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reminder.Table,
+			Columns: reminder.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reminder.FieldID,
+			},
+		},
+	}
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.OffsetMinutes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldOffsetMinutes,
+		})
+	}
+	if value, ok := ru.mutation.AddedOffsetMinutes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldOffsetMinutes,
+		})
+	}
+	if value, ok := ru.mutation.RemindAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldRemindAt,
+		})
+	}
+	if ru.mutation.RemindAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reminder.FieldRemindAt,
+		})
+	}
+	if value, ok := ru.mutation.SentAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldSentAt,
+		})
+	}
+	if ru.mutation.SentAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reminder.FieldSentAt,
+		})
+	}
+	if value, ok := ru.mutation.LockedUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldLockedUntil,
+		})
+	}
+	if ru.mutation.LockedUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reminder.FieldLockedUntil,
+		})
+	}
+	if value, ok := ru.mutation.LockedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reminder.FieldLockedBy,
+		})
+	}
+	if value, ok := ru.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldAttempts,
+		})
+	}
+	if value, ok := ru.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldAttempts,
+		})
+	}
+	if value, ok := ru.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reminder.FieldLastError,
+		})
+	}
+	if ru.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TodoTable,
+			Columns: []string{reminder.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TodoTable,
+			Columns: []string{reminder.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReminderUpdateOne is the builder for updating a single Reminder entity.
+type ReminderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReminderMutation
+}
+
+// SetOffsetMinutes sets the "offset_minutes" field.
+func (ruo *ReminderUpdateOne) SetOffsetMinutes(i int) *ReminderUpdateOne {
+	ruo.mutation.ResetOffsetMinutes()
+	ruo.mutation.SetOffsetMinutes(i)
+	return ruo
+}
+
+// AddOffsetMinutes adds i to the "offset_minutes" field.
+func (ruo *ReminderUpdateOne) AddOffsetMinutes(i int) *ReminderUpdateOne {
+	ruo.mutation.AddOffsetMinutes(i)
+	return ruo
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (ruo *ReminderUpdateOne) SetRemindAt(t time.Time) *ReminderUpdateOne {
+	ruo.mutation.SetRemindAt(t)
+	return ruo
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableRemindAt(t *time.Time) *ReminderUpdateOne {
+	if t != nil {
+		ruo.SetRemindAt(*t)
+	}
+	return ruo
+}
+
+// ClearRemindAt clears the value of the "remind_at" field.
+func (ruo *ReminderUpdateOne) ClearRemindAt() *ReminderUpdateOne {
+	ruo.mutation.ClearRemindAt()
+	return ruo
+}
+
+// SetSentAt sets the "sent_at" field.
+func (ruo *ReminderUpdateOne) SetSentAt(t time.Time) *ReminderUpdateOne {
+	ruo.mutation.SetSentAt(t)
+	return ruo
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableSentAt(t *time.Time) *ReminderUpdateOne {
+	if t != nil {
+		ruo.SetSentAt(*t)
+	}
+	return ruo
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (ruo *ReminderUpdateOne) ClearSentAt() *ReminderUpdateOne {
+	ruo.mutation.ClearSentAt()
+	return ruo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ruo *ReminderUpdateOne) SetLockedUntil(t time.Time) *ReminderUpdateOne {
+	ruo.mutation.SetLockedUntil(t)
+	return ruo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableLockedUntil(t *time.Time) *ReminderUpdateOne {
+	if t != nil {
+		ruo.SetLockedUntil(*t)
+	}
+	return ruo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (ruo *ReminderUpdateOne) ClearLockedUntil() *ReminderUpdateOne {
+	ruo.mutation.ClearLockedUntil()
+	return ruo
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (ruo *ReminderUpdateOne) SetLockedBy(s string) *ReminderUpdateOne {
+	ruo.mutation.SetLockedBy(s)
+	return ruo
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableLockedBy(s *string) *ReminderUpdateOne {
+	if s != nil {
+		ruo.SetLockedBy(*s)
+	}
+	return ruo
+}
+
+// SetAttempts sets the "attempts" field.
+func (ruo *ReminderUpdateOne) SetAttempts(i int) *ReminderUpdateOne {
+	ruo.mutation.ResetAttempts()
+	ruo.mutation.SetAttempts(i)
+	return ruo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableAttempts(i *int) *ReminderUpdateOne {
+	if i != nil {
+		ruo.SetAttempts(*i)
+	}
+	return ruo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ruo *ReminderUpdateOne) AddAttempts(i int) *ReminderUpdateOne {
+	ruo.mutation.AddAttempts(i)
+	return ruo
+}
+
+// SetLastError sets the "last_error" field.
+func (ruo *ReminderUpdateOne) SetLastError(s string) *ReminderUpdateOne {
+	ruo.mutation.SetLastError(s)
+	return ruo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableLastError(s *string) *ReminderUpdateOne {
+	if s != nil {
+		ruo.SetLastError(*s)
+	}
+	return ruo
+}
+
+// SetTodoID sets the "todo" edge to the Todo entity by ID.
+func (ruo *ReminderUpdateOne) SetTodoID(id int64) *ReminderUpdateOne {
+	ruo.mutation.SetTodoID(id)
+	return ruo
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (ruo *ReminderUpdateOne) SetTodo(t *Todo) *ReminderUpdateOne {
+	return ruo.SetTodoID(t.ID)
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (ruo *ReminderUpdateOne) Mutation() *ReminderMutation {
+	return ruo.mutation
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (ruo *ReminderUpdateOne) ClearTodo() *ReminderUpdateOne {
+	ruo.mutation.ClearTodo()
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReminderUpdateOne) Select(field string, fields ...string) *ReminderUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reminder entity.
+func (ruo *ReminderUpdateOne) Save(ctx context.Context) (*Reminder, error) {
+	var (
+		err  error
+		node *Reminder
+	)
+	if len(ruo.hooks) == 0 {
+		if err = ruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReminderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ruo.check(); err != nil {
+				return nil, err
+			}
+			ruo.mutation = mutation
+			node, err = ruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruo.hooks) - 1; i >= 0; i-- {
+			if ruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReminderUpdateOne) SaveX(ctx context.Context) *Reminder {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReminderUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReminderUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReminderUpdateOne) check() error {
+	if v, ok := ruo.mutation.OffsetMinutes(); ok {
+		if err := reminder.OffsetMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "offset_minutes", err: fmt.Errorf("ent: validator failed for field \"offset_minutes\": %w", err)}
+		}
+	}
+	if _, ok := ruo.mutation.TodoID(); ruo.mutation.TodoCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"todo\"")
+	}
+	return nil
+}
+
+func (ruo *ReminderUpdateOne) sqlSave(ctx context.Context) (_node *Reminder, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reminder.Table,
+			Columns: reminder.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reminder.FieldID,
+			},
+		},
+	}
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Reminder.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reminder.FieldID)
+		for _, f := range fields {
+			if !reminder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reminder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.OffsetMinutes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldOffsetMinutes,
+		})
+	}
+	if value, ok := ruo.mutation.AddedOffsetMinutes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldOffsetMinutes,
+		})
+	}
+	if value, ok := ruo.mutation.RemindAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldRemindAt,
+		})
+	}
+	if ruo.mutation.RemindAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reminder.FieldRemindAt,
+		})
+	}
+	if value, ok := ruo.mutation.SentAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldSentAt,
+		})
+	}
+	if ruo.mutation.SentAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reminder.FieldSentAt,
+		})
+	}
+	if value, ok := ruo.mutation.LockedUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reminder.FieldLockedUntil,
+		})
+	}
+	if ruo.mutation.LockedUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reminder.FieldLockedUntil,
+		})
+	}
+	if value, ok := ruo.mutation.LockedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reminder.FieldLockedBy,
+		})
+	}
+	if value, ok := ruo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldAttempts,
+		})
+	}
+	if value, ok := ruo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reminder.FieldAttempts,
+		})
+	}
+	if value, ok := ruo.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reminder.FieldLastError,
+		})
+	}
+	if ruo.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TodoTable,
+			Columns: []string{reminder.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TodoTable,
+			Columns: []string{reminder.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Reminder{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
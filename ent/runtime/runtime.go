@@ -2,7 +2,209 @@
 
 package runtime
 
-// The schema-stitching logic is generated in halill/ent/runtime.go
+import (
+	"context"
+	"halill/ent/checklistitem"
+	"halill/ent/project"
+	"halill/ent/refreshtoken"
+	"halill/ent/reminder"
+	"halill/ent/schema"
+	"halill/ent/tag"
+	"halill/ent/todo"
+	"halill/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	checklistitem.Policy = privacy.NewPolicies(schema.ChecklistItem{})
+	checklistitem.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := checklistitem.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	checklistitemFields := schema.ChecklistItem{}.Fields()
+	_ = checklistitemFields
+	// checklistitemDescTitle is the schema descriptor for title field.
+	checklistitemDescTitle := checklistitemFields[1].Descriptor()
+	// checklistitem.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	checklistitem.TitleValidator = checklistitemDescTitle.Validators[0].(func(string) error)
+	// checklistitemDescIsChecked is the schema descriptor for is_checked field.
+	checklistitemDescIsChecked := checklistitemFields[2].Descriptor()
+	// checklistitem.DefaultIsChecked holds the default value on creation for the is_checked field.
+	checklistitem.DefaultIsChecked = checklistitemDescIsChecked.Default.(bool)
+	// checklistitemDescPosition is the schema descriptor for position field.
+	checklistitemDescPosition := checklistitemFields[3].Descriptor()
+	// checklistitem.DefaultPosition holds the default value on creation for the position field.
+	checklistitem.DefaultPosition = checklistitemDescPosition.Default.(int)
+	project.Policy = privacy.NewPolicies(schema.Project{})
+	project.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := project.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[1].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = projectDescName.Validators[0].(func(string) error)
+	// projectDescColor is the schema descriptor for color field.
+	projectDescColor := projectFields[2].Descriptor()
+	// project.DefaultColor holds the default value on creation for the color field.
+	project.DefaultColor = projectDescColor.Default.(string)
+	// projectDescPosition is the schema descriptor for position field.
+	projectDescPosition := projectFields[3].Descriptor()
+	// project.DefaultPosition holds the default value on creation for the position field.
+	project.DefaultPosition = projectDescPosition.Default.(int)
+	// projectDescArchived is the schema descriptor for archived field.
+	projectDescArchived := projectFields[4].Descriptor()
+	// project.DefaultArchived holds the default value on creation for the archived field.
+	project.DefaultArchived = projectDescArchived.Default.(bool)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescRevoked is the schema descriptor for revoked field.
+	refreshtokenDescRevoked := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultRevoked holds the default value on creation for the revoked field.
+	refreshtoken.DefaultRevoked = refreshtokenDescRevoked.Default.(bool)
+	reminder.Policy = privacy.NewPolicies(schema.Reminder{})
+	reminder.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := reminder.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	reminderFields := schema.Reminder{}.Fields()
+	_ = reminderFields
+	// reminderDescOffsetMinutes is the schema descriptor for offset_minutes field.
+	reminderDescOffsetMinutes := reminderFields[1].Descriptor()
+	// reminder.OffsetMinutesValidator is a validator for the "offset_minutes" field. It is called by the builders before save.
+	reminder.OffsetMinutesValidator = reminderDescOffsetMinutes.Validators[0].(func(int) error)
+	// reminderDescLockedBy is the schema descriptor for locked_by field.
+	reminderDescLockedBy := reminderFields[5].Descriptor()
+	// reminder.DefaultLockedBy holds the default value on creation for the locked_by field.
+	reminder.DefaultLockedBy = reminderDescLockedBy.Default.(string)
+	// reminderDescAttempts is the schema descriptor for attempts field.
+	reminderDescAttempts := reminderFields[6].Descriptor()
+	// reminder.DefaultAttempts holds the default value on creation for the attempts field.
+	reminder.DefaultAttempts = reminderDescAttempts.Default.(int)
+	// reminderDescLastError is the schema descriptor for last_error field.
+	reminderDescLastError := reminderFields[7].Descriptor()
+	// reminder.DefaultLastError holds the default value on creation for the last_error field.
+	reminder.DefaultLastError = reminderDescLastError.Default.(string)
+	tag.Policy = privacy.NewPolicies(schema.Tag{})
+	tag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	todoMixin := schema.Todo{}.Mixin()
+	todo.Policy = privacy.NewPolicies(todoMixin[1], schema.Todo{})
+	todo.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := todo.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	todoMixinFields0 := todoMixin[0].Fields()
+	_ = todoMixinFields0
+	todoFields := schema.Todo{}.Fields()
+	_ = todoFields
+	// todoDescCreatedAt is the schema descriptor for created_at field.
+	todoDescCreatedAt := todoMixinFields0[0].Descriptor()
+	// todo.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todo.DefaultCreatedAt = todoDescCreatedAt.Default.(func() time.Time)
+	// todoDescUpdatedAt is the schema descriptor for updated_at field.
+	todoDescUpdatedAt := todoMixinFields0[1].Descriptor()
+	// todo.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todo.DefaultUpdatedAt = todoDescUpdatedAt.Default.(func() time.Time)
+	// todo.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todo.UpdateDefaultUpdatedAt = todoDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todoDescAutoComplete is the schema descriptor for auto_complete field.
+	todoDescAutoComplete := todoFields[6].Descriptor()
+	// todo.DefaultAutoComplete holds the default value on creation for the auto_complete field.
+	todo.DefaultAutoComplete = todoDescAutoComplete.Default.(bool)
+	// todoDescRecurrence is the schema descriptor for recurrence field.
+	todoDescRecurrence := todoFields[7].Descriptor()
+	// todo.DefaultRecurrence holds the default value on creation for the recurrence field.
+	todo.DefaultRecurrence = todoDescRecurrence.Default.(string)
+	// todoDescTimezone is the schema descriptor for timezone field.
+	todoDescTimezone := todoFields[8].Descriptor()
+	// todo.DefaultTimezone holds the default value on creation for the timezone field.
+	todo.DefaultTimezone = todoDescTimezone.Default.(string)
+	// todoDescOccurrence is the schema descriptor for occurrence field.
+	todoDescOccurrence := todoFields[9].Descriptor()
+	// todo.DefaultOccurrence holds the default value on creation for the occurrence field.
+	todo.DefaultOccurrence = todoDescOccurrence.Default.(int)
+	userMixin := schema.User{}.Mixin()
+	user.Policy = privacy.NewPolicies(schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userMixinFields0[0].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userMixinFields0[1].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[1].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[2].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[3].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescTokenVersion is the schema descriptor for token_version field.
+	userDescTokenVersion := userFields[4].Descriptor()
+	// user.DefaultTokenVersion holds the default value on creation for the token_version field.
+	user.DefaultTokenVersion = userDescTokenVersion.Default.(int)
+	// userDescVerificationSends is the schema descriptor for verification_sends field.
+	userDescVerificationSends := userFields[8].Descriptor()
+	// user.DefaultVerificationSends holds the default value on creation for the verification_sends field.
+	user.DefaultVerificationSends = userDescVerificationSends.Default.(int)
+}
 
 const (
 	Version = "v0.9.1"                                          // Version of ent codegen.
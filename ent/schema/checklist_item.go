@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"halill/ent/privacy"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ChecklistItem holds the schema definition for the ChecklistItem entity.
+type ChecklistItem struct {
+	ent.Schema
+}
+
+// Fields of the ChecklistItem.
+func (ChecklistItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("title").NotEmpty(),
+		field.Bool("is_checked").Default(false),
+		field.Int("position").Default(0),
+	}
+}
+
+// Policy of the ChecklistItem. 사용자는 자신의 Todo 에 달린 항목만 조회하고 수정할 수 있습니다.
+func (ChecklistItem) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			filterViewer(ownedByTodoUser),
+		},
+		Mutation: privacy.MutationPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			allowCreateIfTodoOwned(),
+			filterViewer(ownedByTodoUser),
+		},
+	}
+}
+
+// Edges of the ChecklistItem.
+func (ChecklistItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).Ref("checklist_items").Unique().Required(),
+	}
+}
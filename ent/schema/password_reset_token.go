@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordResetToken holds the schema definition for the PasswordResetToken entity.
+type PasswordResetToken struct {
+	ent.Schema
+}
+
+// Fields of the PasswordResetToken.
+func (PasswordResetToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("token_hash").Unique(),
+		field.Time("expires_at"),
+		// used_at 은 토큰으로 비밀번호를 바꾼 시각입니다. 값이 있으면 다시 사용할 수 없습니다.
+		field.Time("used_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the PasswordResetToken.
+func (PasswordResetToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("password_reset_tokens").Unique().Required(),
+	}
+}
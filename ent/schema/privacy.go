@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"context"
+	gen "halill/ent"
+	"halill/ent/privacy"
+	"halill/ent/todo"
+	"halill/ent/user"
+	"halill/viewer"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entql"
+)
+
+// denyIfNoViewer 는 context 에 viewer 가 없는 조회와 수정을 거부합니다.
+func denyIfNoViewer() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx) == nil {
+			return privacy.Denyf("viewer is missing from context")
+		}
+		return privacy.Skip
+	})
+}
+
+func allowIfSystem() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx).System {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// filterViewer 는 조회와 수정 대상을 p 가 viewer 의 사용자 ID 로 만든 조건에 맞는 행으로 좁힙니다.
+func filterViewer(p func(userID int64) entql.P) privacy.QueryMutationRule {
+	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		f.Where(p(viewer.FromContext(ctx).UserID))
+		return privacy.Skip
+	})
+}
+
+// ownedByUser 는 user edge 가 userID 사용자를 가리키는 행을 고르는 조건입니다.
+func ownedByUser(userID int64) entql.P {
+	return entql.HasEdgeWith("user", entql.FieldEQ(user.FieldID, userID))
+}
+
+// ownedByTodoUser 는 todo edge 의 Todo 가 userID 사용자의 것인 행을 고르는 조건입니다.
+func ownedByTodoUser(userID int64) entql.P {
+	return entql.HasEdgeWith("todo", ownedByUser(userID))
+}
+
+// allowCreateIfOwner 는 viewer 를 user edge 로 연결해 만드는 경우에만 생성을 허용합니다.
+func allowCreateIfOwner() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if !m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+		owned, ok := m.(interface{ UserID() (int64, bool) })
+		if !ok {
+			return privacy.Denyf("%s has no owner", m.Type())
+		}
+		if userID, exists := owned.UserID(); !exists || userID != viewer.FromContext(ctx).UserID {
+			return privacy.Denyf("viewer does not own the new %s", m.Type())
+		}
+		return privacy.Allow
+	})
+}
+
+// allowCreateIfTodoOwned 는 viewer 가 조회할 수 있는 Todo 에 연결해 만드는 경우에만 생성을 허용합니다.
+// Todo 의 Policy 가 다른 사용자의 Todo 를 걸러내므로 조회되는 Todo 는 viewer 의 것입니다.
+func allowCreateIfTodoOwned() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if !m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+		owned, ok := m.(interface {
+			TodoID() (int64, bool)
+			Client() *gen.Client
+		})
+		if !ok {
+			return privacy.Denyf("%s has no todo", m.Type())
+		}
+		todoID, exists := owned.TodoID()
+		if !exists {
+			return privacy.Denyf("%s has no todo", m.Type())
+		}
+		visible, err := owned.Client().Todo.Query().Where(todo.ID(todoID)).Exist(ctx)
+		if err != nil {
+			return privacy.Denyf("checking todo %d: %v", todoID, err)
+		}
+		if !visible {
+			return privacy.Denyf("viewer does not own todo %d", todoID)
+		}
+		return privacy.Allow
+	})
+}
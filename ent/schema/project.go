@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"halill/ent/privacy"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Project holds the schema definition for the Project entity.
+type Project struct {
+	ent.Schema
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name").NotEmpty(),
+		field.String("color").Default(""),
+		field.Int("position").Default(0),
+		field.Bool("archived").Default(false),
+	}
+}
+
+// Policy of the Project. 사용자는 자신의 프로젝트만 조회하고 수정할 수 있습니다.
+func (Project) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			filterViewer(ownedByUser),
+		},
+		Mutation: privacy.MutationPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			allowCreateIfOwner(),
+			filterViewer(ownedByUser),
+		},
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("projects").Unique().Required(),
+		edge.To("todos", Todo.Type),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"halill/ent/privacy"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reminder holds the schema definition for the Reminder entity.
+type Reminder struct {
+	ent.Schema
+}
+
+// Fields of the Reminder.
+func (Reminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int("offset_minutes").NonNegative(),
+		field.Time("remind_at").Nillable().Optional(),
+		field.Time("sent_at").Nillable().Optional(),
+		field.Time("locked_until").Nillable().Optional(),
+		field.String("locked_by").Default(""),
+		field.Int("attempts").Default(0),
+		field.String("last_error").Default(""),
+	}
+}
+
+// Policy of the Reminder. 사용자는 자신의 Todo 에 달린 알림만 조회하고 수정할 수 있습니다.
+func (Reminder) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			filterViewer(ownedByTodoUser),
+		},
+		Mutation: privacy.MutationPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			allowCreateIfTodoOwned(),
+			filterViewer(ownedByTodoUser),
+		},
+	}
+}
+
+// Edges of the Reminder.
+func (Reminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).Ref("reminders").Unique().Required(),
+	}
+}
+
+// Indexes of the Reminder.
+func (Reminder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sent_at", "remind_at"),
+	}
+}
@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RevokedToken holds the schema definition for the RevokedToken entity.
+type RevokedToken struct {
+	ent.Schema
+}
+
+// Fields of the RevokedToken.
+func (RevokedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("jti").Unique(),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the RevokedToken.
+func (RevokedToken) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"context"
+	"halill/ent/privacy"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SoftDeleteMixin 은 삭제할 때 행을 지우는 대신 deleted_at 을 남기는 mixin 입니다.
+// deleted_at 이 있는 행은 SkipSoftDelete 로 만든 context 가 아니면 조회되지 않습니다.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").Nillable().Optional(),
+	}
+}
+
+// Indexes of the SoftDeleteMixin.
+func (SoftDeleteMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+	}
+}
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete 는 휴지통에 있는 행도 함께 조회하는 context 를 반환합니다.
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+// Policy of the SoftDeleteMixin.
+func (SoftDeleteMixin) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+				if skip, _ := ctx.Value(softDeleteKey{}).(bool); skip {
+					return privacy.Skip
+				}
+				f.Where(entql.FieldNil("deleted_at"))
+				return privacy.Skip
+			}),
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"halill/ent/privacy"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Tag holds the schema definition for the Tag entity.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name").NotEmpty(),
+	}
+}
+
+// Policy of the Tag. 사용자는 자신의 태그만 조회하고 수정할 수 있습니다.
+func (Tag) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			filterViewer(ownedByUser),
+		},
+		Mutation: privacy.MutationPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			allowCreateIfOwner(),
+			filterViewer(ownedByUser),
+		},
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("tags").Unique().Required(),
+		edge.To("todos", Todo.Type),
+	}
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("user").Unique(),
+	}
+}
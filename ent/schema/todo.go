@@ -1,16 +1,29 @@
 package schema
 
 import (
+	"halill/ent/privacy"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
 // Todo holds the schema definition for the Todo entity.
+// title, content 의 검색용 FULLTEXT(ngram) 인덱스 todo_title_content 는 ent 로 선언할 수 없어
+// migration/sql/20261018000300_todo_fulltext 에서만 관리하고, migration.Generate 의 diff 에서 제외합니다.
 type Todo struct {
 	ent.Schema
 }
 
+// Mixin of the Todo.
+func (Todo) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Todo.
 func (Todo) Fields() []ent.Field {
 	return []ent.Field{
@@ -19,6 +32,30 @@ func (Todo) Fields() []ent.Field {
 		field.Text("content"),
 		field.Time("deadline").Nillable().Optional(),
 		field.Bool("is_completed"),
+		field.Time("completed_at").Nillable().Optional(),
+		field.Bool("auto_complete").Default(false),
+		field.String("recurrence").Default(""),
+		// timezone 은 반복 규칙의 요일, 날짜, 월말을 계산할 IANA 시간대 이름입니다.
+		field.String("timezone").Default("UTC"),
+		field.Int("occurrence").Default(1),
+		field.Enum("priority").Values("none", "low", "medium", "high", "urgent").Default("none"),
+	}
+}
+
+// Policy of the Todo. 사용자는 자신의 Todo 만 조회하고 수정할 수 있습니다.
+func (Todo) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			filterViewer(ownedByUser),
+		},
+		Mutation: privacy.MutationPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			allowCreateIfOwner(),
+			filterViewer(ownedByUser),
+		},
 	}
 }
 
@@ -26,5 +63,13 @@ func (Todo) Fields() []ent.Field {
 func (Todo) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).Ref("todos").Unique(),
+		edge.From("tags", Tag.Type).Ref("todos"),
+		edge.From("project", Project.Type).Ref("todos").Unique(),
+		edge.To("occurrences", Todo.Type).
+			From("origin").Unique(),
+		edge.To("checklist_items", ChecklistItem.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("reminders", Reminder.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
 	}
 }
@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"halill/ent/privacy"
+	"halill/ent/user"
 	"net/mail"
 
 	"entgo.io/ent"
+	"entgo.io/ent/entql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -13,15 +16,53 @@ type User struct {
 	ent.Schema
 }
 
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("id").Validate(func(email string) error {
+		// id 는 바뀌지 않는 사용자 식별자입니다. 다른 테이블과 JWT 는 email 대신 id 로 사용자를 가리킵니다.
+		field.Int64("id"),
+		field.String("email").Unique().Validate(func(email string) error {
 			_, err := mail.ParseAddress(email)
 			return err
-		}).StorageKey("email"),
+		}),
 		field.String("password").NotEmpty(),
 		field.String("name").NotEmpty(),
+		// token_version 은 전체 로그아웃이나 비밀번호 변경 때마다 1 씩 올라갑니다. JWT 의 ver 가 이 값과 다르면 무효화된 토큰입니다.
+		field.Int("token_version").Default(0),
+		// locale 은 사용자가 고른 응답 언어입니다. 비어 있으면 Accept-Language 를 따릅니다.
+		field.String("locale").Optional(),
+		// email_verified_at 이 비어 있으면 메일 인증을 마치지 않은 사용자입니다.
+		field.Time("email_verified_at").Optional().Nillable(),
+		// 인증 메일 재전송 제한에 사용합니다. verification_sends 는 마지막 전송 이후 한동안 보내지 않으면 다시 0 부터 셉니다.
+		field.Time("verification_sent_at").Optional().Nillable(),
+		field.Int("verification_sends").Default(0),
+	}
+}
+
+// Policy of the User. 사용자는 자신의 정보만 조회하고 수정할 수 있고, 가입은 system viewer 로만 합니다.
+func (User) Policy() ent.Policy {
+	isViewer := func(userID int64) entql.P {
+		return entql.FieldEQ(user.FieldID, userID)
+	}
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			filterViewer(isViewer),
+		},
+		Mutation: privacy.MutationPolicy{
+			denyIfNoViewer(),
+			allowIfSystem(),
+			privacy.DenyMutationOperationRule(ent.OpCreate),
+			filterViewer(isViewer),
+		},
 	}
 }
 
@@ -29,5 +70,9 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("todos", Todo.Type),
+		edge.To("refresh_tokens", RefreshToken.Type),
+		edge.To("password_reset_tokens", PasswordResetToken.Type),
+		edge.To("tags", Tag.Type),
+		edge.To("projects", Project.Type),
 	}
 }
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"halill/ent/project"
 	"halill/ent/todo"
 	"halill/ent/user"
 	"strings"
@@ -17,6 +18,12 @@ type Todo struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Content holds the value of the "content" field.
@@ -25,19 +32,45 @@ type Todo struct {
 	Deadline *time.Time `json:"deadline,omitempty"`
 	// IsCompleted holds the value of the "is_completed" field.
 	IsCompleted bool `json:"is_completed,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// AutoComplete holds the value of the "auto_complete" field.
+	AutoComplete bool `json:"auto_complete,omitempty"`
+	// Recurrence holds the value of the "recurrence" field.
+	Recurrence string `json:"recurrence,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// Occurrence holds the value of the "occurrence" field.
+	Occurrence int `json:"occurrence,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority todo.Priority `json:"priority,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TodoQuery when eager-loading is set.
-	Edges      TodoEdges `json:"edges"`
-	user_todos *string
+	Edges            TodoEdges `json:"edges"`
+	project_todos    *int64
+	todo_occurrences *int64
+	user_todos       *int64
 }
 
 // TodoEdges holds the relations/edges for other nodes in the graph.
 type TodoEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// Origin holds the value of the origin edge.
+	Origin *Todo `json:"origin,omitempty"`
+	// Occurrences holds the value of the occurrences edge.
+	Occurrences []*Todo `json:"occurrences,omitempty"`
+	// ChecklistItems holds the value of the checklist_items edge.
+	ChecklistItems []*ChecklistItem `json:"checklist_items,omitempty"`
+	// Reminders holds the value of the reminders edge.
+	Reminders []*Reminder `json:"reminders,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [7]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -54,21 +87,89 @@ func (e TodoEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[1] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ProjectOrErr() (*Project, error) {
+	if e.loadedTypes[2] {
+		if e.Project == nil {
+			// The edge project was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: project.Label}
+		}
+		return e.Project, nil
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// OriginOrErr returns the Origin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) OriginOrErr() (*Todo, error) {
+	if e.loadedTypes[3] {
+		if e.Origin == nil {
+			// The edge origin was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todo.Label}
+		}
+		return e.Origin, nil
+	}
+	return nil, &NotLoadedError{edge: "origin"}
+}
+
+// OccurrencesOrErr returns the Occurrences value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) OccurrencesOrErr() ([]*Todo, error) {
+	if e.loadedTypes[4] {
+		return e.Occurrences, nil
+	}
+	return nil, &NotLoadedError{edge: "occurrences"}
+}
+
+// ChecklistItemsOrErr returns the ChecklistItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) ChecklistItemsOrErr() ([]*ChecklistItem, error) {
+	if e.loadedTypes[5] {
+		return e.ChecklistItems, nil
+	}
+	return nil, &NotLoadedError{edge: "checklist_items"}
+}
+
+// RemindersOrErr returns the Reminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) RemindersOrErr() ([]*Reminder, error) {
+	if e.loadedTypes[6] {
+		return e.Reminders, nil
+	}
+	return nil, &NotLoadedError{edge: "reminders"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Todo) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case todo.FieldIsCompleted:
+		case todo.FieldIsCompleted, todo.FieldAutoComplete:
 			values[i] = new(sql.NullBool)
-		case todo.FieldID:
+		case todo.FieldID, todo.FieldOccurrence:
 			values[i] = new(sql.NullInt64)
-		case todo.FieldTitle, todo.FieldContent:
+		case todo.FieldTitle, todo.FieldContent, todo.FieldRecurrence, todo.FieldTimezone, todo.FieldPriority:
 			values[i] = new(sql.NullString)
-		case todo.FieldDeadline:
+		case todo.FieldCreatedAt, todo.FieldUpdatedAt, todo.FieldDeletedAt, todo.FieldDeadline, todo.FieldCompletedAt:
 			values[i] = new(sql.NullTime)
-		case todo.ForeignKeys[0]: // user_todos
-			values[i] = new(sql.NullString)
+		case todo.ForeignKeys[0]: // project_todos
+			values[i] = new(sql.NullInt64)
+		case todo.ForeignKeys[1]: // todo_occurrences
+			values[i] = new(sql.NullInt64)
+		case todo.ForeignKeys[2]: // user_todos
+			values[i] = new(sql.NullInt64)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Todo", columns[i])
 		}
@@ -90,6 +191,25 @@ func (t *Todo) assignValues(columns []string, values []interface{}) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			t.ID = int64(value.Int64)
+		case todo.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		case todo.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				t.UpdatedAt = value.Time
+			}
+		case todo.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				t.DeletedAt = new(time.Time)
+				*t.DeletedAt = value.Time
+			}
 		case todo.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -115,12 +235,63 @@ func (t *Todo) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.IsCompleted = value.Bool
 			}
-		case todo.ForeignKeys[0]:
+		case todo.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				t.CompletedAt = new(time.Time)
+				*t.CompletedAt = value.Time
+			}
+		case todo.FieldAutoComplete:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_complete", values[i])
+			} else if value.Valid {
+				t.AutoComplete = value.Bool
+			}
+		case todo.FieldRecurrence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence", values[i])
+			} else if value.Valid {
+				t.Recurrence = value.String
+			}
+		case todo.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				t.Timezone = value.String
+			}
+		case todo.FieldOccurrence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field occurrence", values[i])
+			} else if value.Valid {
+				t.Occurrence = int(value.Int64)
+			}
+		case todo.FieldPriority:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field user_todos", values[i])
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
 			} else if value.Valid {
-				t.user_todos = new(string)
-				*t.user_todos = value.String
+				t.Priority = todo.Priority(value.String)
+			}
+		case todo.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field project_todos", value)
+			} else if value.Valid {
+				t.project_todos = new(int64)
+				*t.project_todos = int64(value.Int64)
+			}
+		case todo.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_occurrences", value)
+			} else if value.Valid {
+				t.todo_occurrences = new(int64)
+				*t.todo_occurrences = int64(value.Int64)
+			}
+		case todo.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_todos", value)
+			} else if value.Valid {
+				t.user_todos = new(int64)
+				*t.user_todos = int64(value.Int64)
 			}
 		}
 	}
@@ -132,6 +303,36 @@ func (t *Todo) QueryUser() *UserQuery {
 	return (&TodoClient{config: t.config}).QueryUser(t)
 }
 
+// QueryTags queries the "tags" edge of the Todo entity.
+func (t *Todo) QueryTags() *TagQuery {
+	return (&TodoClient{config: t.config}).QueryTags(t)
+}
+
+// QueryProject queries the "project" edge of the Todo entity.
+func (t *Todo) QueryProject() *ProjectQuery {
+	return (&TodoClient{config: t.config}).QueryProject(t)
+}
+
+// QueryOrigin queries the "origin" edge of the Todo entity.
+func (t *Todo) QueryOrigin() *TodoQuery {
+	return (&TodoClient{config: t.config}).QueryOrigin(t)
+}
+
+// QueryOccurrences queries the "occurrences" edge of the Todo entity.
+func (t *Todo) QueryOccurrences() *TodoQuery {
+	return (&TodoClient{config: t.config}).QueryOccurrences(t)
+}
+
+// QueryChecklistItems queries the "checklist_items" edge of the Todo entity.
+func (t *Todo) QueryChecklistItems() *ChecklistItemQuery {
+	return (&TodoClient{config: t.config}).QueryChecklistItems(t)
+}
+
+// QueryReminders queries the "reminders" edge of the Todo entity.
+func (t *Todo) QueryReminders() *ReminderQuery {
+	return (&TodoClient{config: t.config}).QueryReminders(t)
+}
+
 // Update returns a builder for updating this Todo.
 // Note that you need to call Todo.Unwrap() before calling this method if this Todo
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -155,6 +356,14 @@ func (t *Todo) String() string {
 	var builder strings.Builder
 	builder.WriteString("Todo(")
 	builder.WriteString(fmt.Sprintf("id=%v", t.ID))
+	builder.WriteString(", created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(t.UpdatedAt.Format(time.ANSIC))
+	if v := t.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", title=")
 	builder.WriteString(t.Title)
 	builder.WriteString(", content=")
@@ -165,6 +374,20 @@ func (t *Todo) String() string {
 	}
 	builder.WriteString(", is_completed=")
 	builder.WriteString(fmt.Sprintf("%v", t.IsCompleted))
+	if v := t.CompletedAt; v != nil {
+		builder.WriteString(", completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", auto_complete=")
+	builder.WriteString(fmt.Sprintf("%v", t.AutoComplete))
+	builder.WriteString(", recurrence=")
+	builder.WriteString(t.Recurrence)
+	builder.WriteString(", timezone=")
+	builder.WriteString(t.Timezone)
+	builder.WriteString(", occurrence=")
+	builder.WriteString(fmt.Sprintf("%v", t.Occurrence))
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", t.Priority))
 	builder.WriteByte(')')
 	return builder.String()
 }
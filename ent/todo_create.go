@@ -6,6 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"halill/ent/checklistitem"
+	"halill/ent/project"
+	"halill/ent/reminder"
+	"halill/ent/tag"
 	"halill/ent/todo"
 	"halill/ent/user"
 	"time"
@@ -21,6 +25,48 @@ type TodoCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (tc *TodoCreate) SetCreatedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableCreatedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tc *TodoCreate) SetUpdatedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetUpdatedAt(t)
+	return tc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableUpdatedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetUpdatedAt(*t)
+	}
+	return tc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tc *TodoCreate) SetDeletedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetDeletedAt(t)
+	return tc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableDeletedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetDeletedAt(*t)
+	}
+	return tc
+}
+
 // SetTitle sets the "title" field.
 func (tc *TodoCreate) SetTitle(s string) *TodoCreate {
 	tc.mutation.SetTitle(s)
@@ -53,6 +99,90 @@ func (tc *TodoCreate) SetIsCompleted(b bool) *TodoCreate {
 	return tc
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (tc *TodoCreate) SetCompletedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetCompletedAt(t)
+	return tc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableCompletedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetCompletedAt(*t)
+	}
+	return tc
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tc *TodoCreate) SetAutoComplete(b bool) *TodoCreate {
+	tc.mutation.SetAutoComplete(b)
+	return tc
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableAutoComplete(b *bool) *TodoCreate {
+	if b != nil {
+		tc.SetAutoComplete(*b)
+	}
+	return tc
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (tc *TodoCreate) SetRecurrence(s string) *TodoCreate {
+	tc.mutation.SetRecurrence(s)
+	return tc
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableRecurrence(s *string) *TodoCreate {
+	if s != nil {
+		tc.SetRecurrence(*s)
+	}
+	return tc
+}
+
+// SetTimezone sets the "timezone" field.
+func (tc *TodoCreate) SetTimezone(s string) *TodoCreate {
+	tc.mutation.SetTimezone(s)
+	return tc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableTimezone(s *string) *TodoCreate {
+	if s != nil {
+		tc.SetTimezone(*s)
+	}
+	return tc
+}
+
+// SetOccurrence sets the "occurrence" field.
+func (tc *TodoCreate) SetOccurrence(i int) *TodoCreate {
+	tc.mutation.SetOccurrence(i)
+	return tc
+}
+
+// SetNillableOccurrence sets the "occurrence" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableOccurrence(i *int) *TodoCreate {
+	if i != nil {
+		tc.SetOccurrence(*i)
+	}
+	return tc
+}
+
+// SetPriority sets the "priority" field.
+func (tc *TodoCreate) SetPriority(t todo.Priority) *TodoCreate {
+	tc.mutation.SetPriority(t)
+	return tc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tc *TodoCreate) SetNillablePriority(t *todo.Priority) *TodoCreate {
+	if t != nil {
+		tc.SetPriority(*t)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TodoCreate) SetID(i int64) *TodoCreate {
 	tc.mutation.SetID(i)
@@ -60,13 +190,13 @@ func (tc *TodoCreate) SetID(i int64) *TodoCreate {
 }
 
 // SetUserID sets the "user" edge to the User entity by ID.
-func (tc *TodoCreate) SetUserID(id string) *TodoCreate {
+func (tc *TodoCreate) SetUserID(id int64) *TodoCreate {
 	tc.mutation.SetUserID(id)
 	return tc
 }
 
 // SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (tc *TodoCreate) SetNillableUserID(id *string) *TodoCreate {
+func (tc *TodoCreate) SetNillableUserID(id *int64) *TodoCreate {
 	if id != nil {
 		tc = tc.SetUserID(*id)
 	}
@@ -78,6 +208,104 @@ func (tc *TodoCreate) SetUser(u *User) *TodoCreate {
 	return tc.SetUserID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tc *TodoCreate) AddTagIDs(ids ...int64) *TodoCreate {
+	tc.mutation.AddTagIDs(ids...)
+	return tc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tc *TodoCreate) AddTags(t ...*Tag) *TodoCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTagIDs(ids...)
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (tc *TodoCreate) SetProjectID(id int64) *TodoCreate {
+	tc.mutation.SetProjectID(id)
+	return tc
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (tc *TodoCreate) SetNillableProjectID(id *int64) *TodoCreate {
+	if id != nil {
+		tc = tc.SetProjectID(*id)
+	}
+	return tc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tc *TodoCreate) SetProject(p *Project) *TodoCreate {
+	return tc.SetProjectID(p.ID)
+}
+
+// SetOriginID sets the "origin" edge to the Todo entity by ID.
+func (tc *TodoCreate) SetOriginID(id int64) *TodoCreate {
+	tc.mutation.SetOriginID(id)
+	return tc
+}
+
+// SetNillableOriginID sets the "origin" edge to the Todo entity by ID if the given value is not nil.
+func (tc *TodoCreate) SetNillableOriginID(id *int64) *TodoCreate {
+	if id != nil {
+		tc = tc.SetOriginID(*id)
+	}
+	return tc
+}
+
+// SetOrigin sets the "origin" edge to the Todo entity.
+func (tc *TodoCreate) SetOrigin(t *Todo) *TodoCreate {
+	return tc.SetOriginID(t.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the Todo entity by IDs.
+func (tc *TodoCreate) AddOccurrenceIDs(ids ...int64) *TodoCreate {
+	tc.mutation.AddOccurrenceIDs(ids...)
+	return tc
+}
+
+// AddOccurrences adds the "occurrences" edges to the Todo entity.
+func (tc *TodoCreate) AddOccurrences(t ...*Todo) *TodoCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddOccurrenceIDs(ids...)
+}
+
+// AddChecklistItemIDs adds the "checklist_items" edge to the ChecklistItem entity by IDs.
+func (tc *TodoCreate) AddChecklistItemIDs(ids ...int64) *TodoCreate {
+	tc.mutation.AddChecklistItemIDs(ids...)
+	return tc
+}
+
+// AddChecklistItems adds the "checklist_items" edges to the ChecklistItem entity.
+func (tc *TodoCreate) AddChecklistItems(c ...*ChecklistItem) *TodoCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tc.AddChecklistItemIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (tc *TodoCreate) AddReminderIDs(ids ...int64) *TodoCreate {
+	tc.mutation.AddReminderIDs(ids...)
+	return tc
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (tc *TodoCreate) AddReminders(r ...*Reminder) *TodoCreate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return tc.AddReminderIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (tc *TodoCreate) Mutation() *TodoMutation {
 	return tc.mutation
@@ -89,6 +317,9 @@ func (tc *TodoCreate) Save(ctx context.Context) (*Todo, error) {
 		err  error
 		node *Todo
 	)
+	if err := tc.defaults(); err != nil {
+		return nil, err
+	}
 	if len(tc.hooks) == 0 {
 		if err = tc.check(); err != nil {
 			return nil, err
@@ -146,8 +377,53 @@ func (tc *TodoCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tc *TodoCreate) defaults() error {
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		if todo.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized todo.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := todo.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tc.mutation.UpdatedAt(); !ok {
+		if todo.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized todo.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := todo.DefaultUpdatedAt()
+		tc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tc.mutation.AutoComplete(); !ok {
+		v := todo.DefaultAutoComplete
+		tc.mutation.SetAutoComplete(v)
+	}
+	if _, ok := tc.mutation.Recurrence(); !ok {
+		v := todo.DefaultRecurrence
+		tc.mutation.SetRecurrence(v)
+	}
+	if _, ok := tc.mutation.Timezone(); !ok {
+		v := todo.DefaultTimezone
+		tc.mutation.SetTimezone(v)
+	}
+	if _, ok := tc.mutation.Occurrence(); !ok {
+		v := todo.DefaultOccurrence
+		tc.mutation.SetOccurrence(v)
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		v := todo.DefaultPriority
+		tc.mutation.SetPriority(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (tc *TodoCreate) check() error {
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	if _, ok := tc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "updated_at"`)}
+	}
 	if _, ok := tc.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "title"`)}
 	}
@@ -157,6 +433,26 @@ func (tc *TodoCreate) check() error {
 	if _, ok := tc.mutation.IsCompleted(); !ok {
 		return &ValidationError{Name: "is_completed", err: errors.New(`ent: missing required field "is_completed"`)}
 	}
+	if _, ok := tc.mutation.AutoComplete(); !ok {
+		return &ValidationError{Name: "auto_complete", err: errors.New(`ent: missing required field "auto_complete"`)}
+	}
+	if _, ok := tc.mutation.Recurrence(); !ok {
+		return &ValidationError{Name: "recurrence", err: errors.New(`ent: missing required field "recurrence"`)}
+	}
+	if _, ok := tc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "timezone"`)}
+	}
+	if _, ok := tc.mutation.Occurrence(); !ok {
+		return &ValidationError{Name: "occurrence", err: errors.New(`ent: missing required field "occurrence"`)}
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "priority"`)}
+	}
+	if v, ok := tc.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -190,6 +486,30 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := tc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := tc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
 	if value, ok := tc.mutation.Title(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -222,6 +542,54 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		})
 		_node.IsCompleted = value
 	}
+	if value, ok := tc.mutation.CompletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldCompletedAt,
+		})
+		_node.CompletedAt = &value
+	}
+	if value, ok := tc.mutation.AutoComplete(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todo.FieldAutoComplete,
+		})
+		_node.AutoComplete = value
+	}
+	if value, ok := tc.mutation.Recurrence(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldRecurrence,
+		})
+		_node.Recurrence = value
+	}
+	if value, ok := tc.mutation.Timezone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldTimezone,
+		})
+		_node.Timezone = value
+	}
+	if value, ok := tc.mutation.Occurrence(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldOccurrence,
+		})
+		_node.Occurrence = value
+	}
+	if value, ok := tc.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: todo.FieldPriority,
+		})
+		_node.Priority = value
+	}
 	if nodes := tc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -231,7 +599,7 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeString,
+					Type:   field.TypeInt64,
 					Column: user.FieldID,
 				},
 			},
@@ -242,6 +610,122 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_node.user_todos = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: project.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_todos = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.OriginIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OriginTable,
+			Columns: []string{todo.OriginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.todo_occurrences = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.OccurrencesTable,
+			Columns: []string{todo.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ChecklistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChecklistItemsTable,
+			Columns: []string{todo.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: checklistitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reminder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -259,6 +743,7 @@ func (tcb *TodoCreateBulk) Save(ctx context.Context) ([]*Todo, error) {
 	for i := range tcb.builders {
 		func(i int, root context.Context) {
 			builder := tcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*TodoMutation)
 				if !ok {
@@ -5,7 +5,11 @@ package ent
 import (
 	"context"
 	"fmt"
+	"halill/ent/checklistitem"
 	"halill/ent/predicate"
+	"halill/ent/project"
+	"halill/ent/reminder"
+	"halill/ent/tag"
 	"halill/ent/todo"
 	"halill/ent/user"
 	"time"
@@ -28,6 +32,32 @@ func (tu *TodoUpdate) Where(ps ...predicate.Todo) *TodoUpdate {
 	return tu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (tu *TodoUpdate) SetUpdatedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetUpdatedAt(t)
+	return tu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tu *TodoUpdate) SetDeletedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetDeletedAt(t)
+	return tu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableDeletedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetDeletedAt(*t)
+	}
+	return tu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tu *TodoUpdate) ClearDeletedAt() *TodoUpdate {
+	tu.mutation.ClearDeletedAt()
+	return tu
+}
+
 // SetTitle sets the "title" field.
 func (tu *TodoUpdate) SetTitle(s string) *TodoUpdate {
 	tu.mutation.SetTitle(s)
@@ -66,14 +96,111 @@ func (tu *TodoUpdate) SetIsCompleted(b bool) *TodoUpdate {
 	return tu
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (tu *TodoUpdate) SetCompletedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetCompletedAt(t)
+	return tu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableCompletedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetCompletedAt(*t)
+	}
+	return tu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tu *TodoUpdate) ClearCompletedAt() *TodoUpdate {
+	tu.mutation.ClearCompletedAt()
+	return tu
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tu *TodoUpdate) SetAutoComplete(b bool) *TodoUpdate {
+	tu.mutation.SetAutoComplete(b)
+	return tu
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableAutoComplete(b *bool) *TodoUpdate {
+	if b != nil {
+		tu.SetAutoComplete(*b)
+	}
+	return tu
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (tu *TodoUpdate) SetRecurrence(s string) *TodoUpdate {
+	tu.mutation.SetRecurrence(s)
+	return tu
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableRecurrence(s *string) *TodoUpdate {
+	if s != nil {
+		tu.SetRecurrence(*s)
+	}
+	return tu
+}
+
+// SetTimezone sets the "timezone" field.
+func (tu *TodoUpdate) SetTimezone(s string) *TodoUpdate {
+	tu.mutation.SetTimezone(s)
+	return tu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableTimezone(s *string) *TodoUpdate {
+	if s != nil {
+		tu.SetTimezone(*s)
+	}
+	return tu
+}
+
+// SetOccurrence sets the "occurrence" field.
+func (tu *TodoUpdate) SetOccurrence(i int) *TodoUpdate {
+	tu.mutation.ResetOccurrence()
+	tu.mutation.SetOccurrence(i)
+	return tu
+}
+
+// SetNillableOccurrence sets the "occurrence" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableOccurrence(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetOccurrence(*i)
+	}
+	return tu
+}
+
+// AddOccurrence adds i to the "occurrence" field.
+func (tu *TodoUpdate) AddOccurrence(i int) *TodoUpdate {
+	tu.mutation.AddOccurrence(i)
+	return tu
+}
+
+// SetPriority sets the "priority" field.
+func (tu *TodoUpdate) SetPriority(t todo.Priority) *TodoUpdate {
+	tu.mutation.SetPriority(t)
+	return tu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillablePriority(t *todo.Priority) *TodoUpdate {
+	if t != nil {
+		tu.SetPriority(*t)
+	}
+	return tu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
-func (tu *TodoUpdate) SetUserID(id string) *TodoUpdate {
+func (tu *TodoUpdate) SetUserID(id int64) *TodoUpdate {
 	tu.mutation.SetUserID(id)
 	return tu
 }
 
 // SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (tu *TodoUpdate) SetNillableUserID(id *string) *TodoUpdate {
+func (tu *TodoUpdate) SetNillableUserID(id *int64) *TodoUpdate {
 	if id != nil {
 		tu = tu.SetUserID(*id)
 	}
@@ -85,6 +212,104 @@ func (tu *TodoUpdate) SetUser(u *User) *TodoUpdate {
 	return tu.SetUserID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tu *TodoUpdate) AddTagIDs(ids ...int64) *TodoUpdate {
+	tu.mutation.AddTagIDs(ids...)
+	return tu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tu *TodoUpdate) AddTags(t ...*Tag) *TodoUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTagIDs(ids...)
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (tu *TodoUpdate) SetProjectID(id int64) *TodoUpdate {
+	tu.mutation.SetProjectID(id)
+	return tu
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (tu *TodoUpdate) SetNillableProjectID(id *int64) *TodoUpdate {
+	if id != nil {
+		tu = tu.SetProjectID(*id)
+	}
+	return tu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tu *TodoUpdate) SetProject(p *Project) *TodoUpdate {
+	return tu.SetProjectID(p.ID)
+}
+
+// SetOriginID sets the "origin" edge to the Todo entity by ID.
+func (tu *TodoUpdate) SetOriginID(id int64) *TodoUpdate {
+	tu.mutation.SetOriginID(id)
+	return tu
+}
+
+// SetNillableOriginID sets the "origin" edge to the Todo entity by ID if the given value is not nil.
+func (tu *TodoUpdate) SetNillableOriginID(id *int64) *TodoUpdate {
+	if id != nil {
+		tu = tu.SetOriginID(*id)
+	}
+	return tu
+}
+
+// SetOrigin sets the "origin" edge to the Todo entity.
+func (tu *TodoUpdate) SetOrigin(t *Todo) *TodoUpdate {
+	return tu.SetOriginID(t.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the Todo entity by IDs.
+func (tu *TodoUpdate) AddOccurrenceIDs(ids ...int64) *TodoUpdate {
+	tu.mutation.AddOccurrenceIDs(ids...)
+	return tu
+}
+
+// AddOccurrences adds the "occurrences" edges to the Todo entity.
+func (tu *TodoUpdate) AddOccurrences(t ...*Todo) *TodoUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddOccurrenceIDs(ids...)
+}
+
+// AddChecklistItemIDs adds the "checklist_items" edge to the ChecklistItem entity by IDs.
+func (tu *TodoUpdate) AddChecklistItemIDs(ids ...int64) *TodoUpdate {
+	tu.mutation.AddChecklistItemIDs(ids...)
+	return tu
+}
+
+// AddChecklistItems adds the "checklist_items" edges to the ChecklistItem entity.
+func (tu *TodoUpdate) AddChecklistItems(c ...*ChecklistItem) *TodoUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.AddChecklistItemIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (tu *TodoUpdate) AddReminderIDs(ids ...int64) *TodoUpdate {
+	tu.mutation.AddReminderIDs(ids...)
+	return tu
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (tu *TodoUpdate) AddReminders(r ...*Reminder) *TodoUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return tu.AddReminderIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (tu *TodoUpdate) Mutation() *TodoMutation {
 	return tu.mutation
@@ -96,13 +321,115 @@ func (tu *TodoUpdate) ClearUser() *TodoUpdate {
 	return tu
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tu *TodoUpdate) ClearTags() *TodoUpdate {
+	tu.mutation.ClearTags()
+	return tu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tu *TodoUpdate) RemoveTagIDs(ids ...int64) *TodoUpdate {
+	tu.mutation.RemoveTagIDs(ids...)
+	return tu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tu *TodoUpdate) RemoveTags(t ...*Tag) *TodoUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTagIDs(ids...)
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tu *TodoUpdate) ClearProject() *TodoUpdate {
+	tu.mutation.ClearProject()
+	return tu
+}
+
+// ClearOrigin clears the "origin" edge to the Todo entity.
+func (tu *TodoUpdate) ClearOrigin() *TodoUpdate {
+	tu.mutation.ClearOrigin()
+	return tu
+}
+
+// ClearOccurrences clears all "occurrences" edges to the Todo entity.
+func (tu *TodoUpdate) ClearOccurrences() *TodoUpdate {
+	tu.mutation.ClearOccurrences()
+	return tu
+}
+
+// RemoveOccurrenceIDs removes the "occurrences" edge to Todo entities by IDs.
+func (tu *TodoUpdate) RemoveOccurrenceIDs(ids ...int64) *TodoUpdate {
+	tu.mutation.RemoveOccurrenceIDs(ids...)
+	return tu
+}
+
+// RemoveOccurrences removes "occurrences" edges to Todo entities.
+func (tu *TodoUpdate) RemoveOccurrences(t ...*Todo) *TodoUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveOccurrenceIDs(ids...)
+}
+
+// ClearChecklistItems clears all "checklist_items" edges to the ChecklistItem entity.
+func (tu *TodoUpdate) ClearChecklistItems() *TodoUpdate {
+	tu.mutation.ClearChecklistItems()
+	return tu
+}
+
+// RemoveChecklistItemIDs removes the "checklist_items" edge to ChecklistItem entities by IDs.
+func (tu *TodoUpdate) RemoveChecklistItemIDs(ids ...int64) *TodoUpdate {
+	tu.mutation.RemoveChecklistItemIDs(ids...)
+	return tu
+}
+
+// RemoveChecklistItems removes "checklist_items" edges to ChecklistItem entities.
+func (tu *TodoUpdate) RemoveChecklistItems(c ...*ChecklistItem) *TodoUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.RemoveChecklistItemIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the Reminder entity.
+func (tu *TodoUpdate) ClearReminders() *TodoUpdate {
+	tu.mutation.ClearReminders()
+	return tu
+}
+
+// RemoveReminderIDs removes the "reminders" edge to Reminder entities by IDs.
+func (tu *TodoUpdate) RemoveReminderIDs(ids ...int64) *TodoUpdate {
+	tu.mutation.RemoveReminderIDs(ids...)
+	return tu
+}
+
+// RemoveReminders removes "reminders" edges to Reminder entities.
+func (tu *TodoUpdate) RemoveReminders(r ...*Reminder) *TodoUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return tu.RemoveReminderIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TodoUpdate) Save(ctx context.Context) (int, error) {
 	var (
 		err      error
 		affected int
 	)
+	if err := tu.defaults(); err != nil {
+		return 0, err
+	}
 	if len(tu.hooks) == 0 {
+		if err = tu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = tu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -110,6 +437,9 @@ func (tu *TodoUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = tu.check(); err != nil {
+				return 0, err
+			}
 			tu.mutation = mutation
 			affected, err = tu.sqlSave(ctx)
 			mutation.done = true
@@ -150,6 +480,28 @@ func (tu *TodoUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tu *TodoUpdate) defaults() error {
+	if _, ok := tu.mutation.UpdatedAt(); !ok {
+		if todo.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized todo.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := todo.UpdateDefaultUpdatedAt()
+		tu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TodoUpdate) check() error {
+	if v, ok := tu.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf("ent: validator failed for field \"priority\": %w", err)}
+		}
+	}
+	return nil
+}
+
 func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -168,6 +520,26 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := tu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldUpdatedAt,
+		})
+	}
+	if value, ok := tu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldDeletedAt,
+		})
+	}
+	if tu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todo.FieldDeletedAt,
+		})
+	}
 	if value, ok := tu.mutation.Title(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -202,6 +574,61 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: todo.FieldIsCompleted,
 		})
 	}
+	if value, ok := tu.mutation.CompletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldCompletedAt,
+		})
+	}
+	if tu.mutation.CompletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todo.FieldCompletedAt,
+		})
+	}
+	if value, ok := tu.mutation.AutoComplete(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todo.FieldAutoComplete,
+		})
+	}
+	if value, ok := tu.mutation.Recurrence(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldRecurrence,
+		})
+	}
+	if value, ok := tu.mutation.Timezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldTimezone,
+		})
+	}
+	if value, ok := tu.mutation.Occurrence(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldOccurrence,
+		})
+	}
+	if value, ok := tu.mutation.AddedOccurrence(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldOccurrence,
+		})
+	}
+	if value, ok := tu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: todo.FieldPriority,
+		})
+	}
 	if tu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -211,7 +638,7 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeString,
+					Type:   field.TypeInt64,
 					Column: user.FieldID,
 				},
 			},
@@ -227,7 +654,7 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeString,
+					Type:   field.TypeInt64,
 					Column: user.FieldID,
 				},
 			},
@@ -237,44 +664,356 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{todo.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{err.Error(), err}
+	if tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return n, nil
-}
-
-// TodoUpdateOne is the builder for updating a single Todo entity.
-type TodoUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TodoMutation
-}
-
-// SetTitle sets the "title" field.
-func (tuo *TodoUpdateOne) SetTitle(s string) *TodoUpdateOne {
-	tuo.mutation.SetTitle(s)
-	return tuo
-}
-
-// SetContent sets the "content" field.
-func (tuo *TodoUpdateOne) SetContent(s string) *TodoUpdateOne {
-	tuo.mutation.SetContent(s)
-	return tuo
-}
-
-// SetDeadline sets the "deadline" field.
-func (tuo *TodoUpdateOne) SetDeadline(t time.Time) *TodoUpdateOne {
-	tuo.mutation.SetDeadline(t)
-	return tuo
-}
-
-// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+	if nodes := tu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: project.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: project.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.OriginCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OriginTable,
+			Columns: []string{todo.OriginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.OriginIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OriginTable,
+			Columns: []string{todo.OriginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.OccurrencesTable,
+			Columns: []string{todo.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedOccurrencesIDs(); len(nodes) > 0 && !tu.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.OccurrencesTable,
+			Columns: []string{todo.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.OccurrencesTable,
+			Columns: []string{todo.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ChecklistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChecklistItemsTable,
+			Columns: []string{todo.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: checklistitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedChecklistItemsIDs(); len(nodes) > 0 && !tu.mutation.ChecklistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChecklistItemsTable,
+			Columns: []string{todo.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: checklistitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ChecklistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChecklistItemsTable,
+			Columns: []string{todo.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: checklistitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reminder.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !tu.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reminder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reminder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todo.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TodoUpdateOne is the builder for updating a single Todo entity.
+type TodoUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TodoUpdateOne) SetUpdatedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetUpdatedAt(t)
+	return tuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tuo *TodoUpdateOne) SetDeletedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetDeletedAt(t)
+	return tuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDeletedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetDeletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tuo *TodoUpdateOne) ClearDeletedAt() *TodoUpdateOne {
+	tuo.mutation.ClearDeletedAt()
+	return tuo
+}
+
+// SetTitle sets the "title" field.
+func (tuo *TodoUpdateOne) SetTitle(s string) *TodoUpdateOne {
+	tuo.mutation.SetTitle(s)
+	return tuo
+}
+
+// SetContent sets the "content" field.
+func (tuo *TodoUpdateOne) SetContent(s string) *TodoUpdateOne {
+	tuo.mutation.SetContent(s)
+	return tuo
+}
+
+// SetDeadline sets the "deadline" field.
+func (tuo *TodoUpdateOne) SetDeadline(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetDeadline(t)
+	return tuo
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
 func (tuo *TodoUpdateOne) SetNillableDeadline(t *time.Time) *TodoUpdateOne {
 	if t != nil {
 		tuo.SetDeadline(*t)
@@ -294,14 +1033,111 @@ func (tuo *TodoUpdateOne) SetIsCompleted(b bool) *TodoUpdateOne {
 	return tuo
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (tuo *TodoUpdateOne) SetCompletedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetCompletedAt(t)
+	return tuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableCompletedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetCompletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tuo *TodoUpdateOne) ClearCompletedAt() *TodoUpdateOne {
+	tuo.mutation.ClearCompletedAt()
+	return tuo
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tuo *TodoUpdateOne) SetAutoComplete(b bool) *TodoUpdateOne {
+	tuo.mutation.SetAutoComplete(b)
+	return tuo
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableAutoComplete(b *bool) *TodoUpdateOne {
+	if b != nil {
+		tuo.SetAutoComplete(*b)
+	}
+	return tuo
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (tuo *TodoUpdateOne) SetRecurrence(s string) *TodoUpdateOne {
+	tuo.mutation.SetRecurrence(s)
+	return tuo
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableRecurrence(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetRecurrence(*s)
+	}
+	return tuo
+}
+
+// SetTimezone sets the "timezone" field.
+func (tuo *TodoUpdateOne) SetTimezone(s string) *TodoUpdateOne {
+	tuo.mutation.SetTimezone(s)
+	return tuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableTimezone(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetTimezone(*s)
+	}
+	return tuo
+}
+
+// SetOccurrence sets the "occurrence" field.
+func (tuo *TodoUpdateOne) SetOccurrence(i int) *TodoUpdateOne {
+	tuo.mutation.ResetOccurrence()
+	tuo.mutation.SetOccurrence(i)
+	return tuo
+}
+
+// SetNillableOccurrence sets the "occurrence" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableOccurrence(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetOccurrence(*i)
+	}
+	return tuo
+}
+
+// AddOccurrence adds i to the "occurrence" field.
+func (tuo *TodoUpdateOne) AddOccurrence(i int) *TodoUpdateOne {
+	tuo.mutation.AddOccurrence(i)
+	return tuo
+}
+
+// SetPriority sets the "priority" field.
+func (tuo *TodoUpdateOne) SetPriority(t todo.Priority) *TodoUpdateOne {
+	tuo.mutation.SetPriority(t)
+	return tuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillablePriority(t *todo.Priority) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetPriority(*t)
+	}
+	return tuo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
-func (tuo *TodoUpdateOne) SetUserID(id string) *TodoUpdateOne {
+func (tuo *TodoUpdateOne) SetUserID(id int64) *TodoUpdateOne {
 	tuo.mutation.SetUserID(id)
 	return tuo
 }
 
 // SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (tuo *TodoUpdateOne) SetNillableUserID(id *string) *TodoUpdateOne {
+func (tuo *TodoUpdateOne) SetNillableUserID(id *int64) *TodoUpdateOne {
 	if id != nil {
 		tuo = tuo.SetUserID(*id)
 	}
@@ -313,15 +1149,209 @@ func (tuo *TodoUpdateOne) SetUser(u *User) *TodoUpdateOne {
 	return tuo.SetUserID(u.ID)
 }
 
-// Mutation returns the TodoMutation object of the builder.
-func (tuo *TodoUpdateOne) Mutation() *TodoMutation {
-	return tuo.mutation
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tuo *TodoUpdateOne) AddTagIDs(ids ...int64) *TodoUpdateOne {
+	tuo.mutation.AddTagIDs(ids...)
+	return tuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tuo *TodoUpdateOne) AddTags(t ...*Tag) *TodoUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTagIDs(ids...)
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (tuo *TodoUpdateOne) SetProjectID(id int64) *TodoUpdateOne {
+	tuo.mutation.SetProjectID(id)
+	return tuo
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableProjectID(id *int64) *TodoUpdateOne {
+	if id != nil {
+		tuo = tuo.SetProjectID(*id)
+	}
+	return tuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tuo *TodoUpdateOne) SetProject(p *Project) *TodoUpdateOne {
+	return tuo.SetProjectID(p.ID)
+}
+
+// SetOriginID sets the "origin" edge to the Todo entity by ID.
+func (tuo *TodoUpdateOne) SetOriginID(id int64) *TodoUpdateOne {
+	tuo.mutation.SetOriginID(id)
+	return tuo
+}
+
+// SetNillableOriginID sets the "origin" edge to the Todo entity by ID if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableOriginID(id *int64) *TodoUpdateOne {
+	if id != nil {
+		tuo = tuo.SetOriginID(*id)
+	}
+	return tuo
+}
+
+// SetOrigin sets the "origin" edge to the Todo entity.
+func (tuo *TodoUpdateOne) SetOrigin(t *Todo) *TodoUpdateOne {
+	return tuo.SetOriginID(t.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the Todo entity by IDs.
+func (tuo *TodoUpdateOne) AddOccurrenceIDs(ids ...int64) *TodoUpdateOne {
+	tuo.mutation.AddOccurrenceIDs(ids...)
+	return tuo
+}
+
+// AddOccurrences adds the "occurrences" edges to the Todo entity.
+func (tuo *TodoUpdateOne) AddOccurrences(t ...*Todo) *TodoUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddOccurrenceIDs(ids...)
+}
+
+// AddChecklistItemIDs adds the "checklist_items" edge to the ChecklistItem entity by IDs.
+func (tuo *TodoUpdateOne) AddChecklistItemIDs(ids ...int64) *TodoUpdateOne {
+	tuo.mutation.AddChecklistItemIDs(ids...)
+	return tuo
+}
+
+// AddChecklistItems adds the "checklist_items" edges to the ChecklistItem entity.
+func (tuo *TodoUpdateOne) AddChecklistItems(c ...*ChecklistItem) *TodoUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.AddChecklistItemIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (tuo *TodoUpdateOne) AddReminderIDs(ids ...int64) *TodoUpdateOne {
+	tuo.mutation.AddReminderIDs(ids...)
+	return tuo
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (tuo *TodoUpdateOne) AddReminders(r ...*Reminder) *TodoUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return tuo.AddReminderIDs(ids...)
+}
+
+// Mutation returns the TodoMutation object of the builder.
+func (tuo *TodoUpdateOne) Mutation() *TodoMutation {
+	return tuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tuo *TodoUpdateOne) ClearUser() *TodoUpdateOne {
+	tuo.mutation.ClearUser()
+	return tuo
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tuo *TodoUpdateOne) ClearTags() *TodoUpdateOne {
+	tuo.mutation.ClearTags()
+	return tuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tuo *TodoUpdateOne) RemoveTagIDs(ids ...int64) *TodoUpdateOne {
+	tuo.mutation.RemoveTagIDs(ids...)
+	return tuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tuo *TodoUpdateOne) RemoveTags(t ...*Tag) *TodoUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTagIDs(ids...)
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tuo *TodoUpdateOne) ClearProject() *TodoUpdateOne {
+	tuo.mutation.ClearProject()
+	return tuo
+}
+
+// ClearOrigin clears the "origin" edge to the Todo entity.
+func (tuo *TodoUpdateOne) ClearOrigin() *TodoUpdateOne {
+	tuo.mutation.ClearOrigin()
+	return tuo
+}
+
+// ClearOccurrences clears all "occurrences" edges to the Todo entity.
+func (tuo *TodoUpdateOne) ClearOccurrences() *TodoUpdateOne {
+	tuo.mutation.ClearOccurrences()
+	return tuo
+}
+
+// RemoveOccurrenceIDs removes the "occurrences" edge to Todo entities by IDs.
+func (tuo *TodoUpdateOne) RemoveOccurrenceIDs(ids ...int64) *TodoUpdateOne {
+	tuo.mutation.RemoveOccurrenceIDs(ids...)
+	return tuo
+}
+
+// RemoveOccurrences removes "occurrences" edges to Todo entities.
+func (tuo *TodoUpdateOne) RemoveOccurrences(t ...*Todo) *TodoUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveOccurrenceIDs(ids...)
+}
+
+// ClearChecklistItems clears all "checklist_items" edges to the ChecklistItem entity.
+func (tuo *TodoUpdateOne) ClearChecklistItems() *TodoUpdateOne {
+	tuo.mutation.ClearChecklistItems()
+	return tuo
+}
+
+// RemoveChecklistItemIDs removes the "checklist_items" edge to ChecklistItem entities by IDs.
+func (tuo *TodoUpdateOne) RemoveChecklistItemIDs(ids ...int64) *TodoUpdateOne {
+	tuo.mutation.RemoveChecklistItemIDs(ids...)
+	return tuo
+}
+
+// RemoveChecklistItems removes "checklist_items" edges to ChecklistItem entities.
+func (tuo *TodoUpdateOne) RemoveChecklistItems(c ...*ChecklistItem) *TodoUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.RemoveChecklistItemIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the Reminder entity.
+func (tuo *TodoUpdateOne) ClearReminders() *TodoUpdateOne {
+	tuo.mutation.ClearReminders()
+	return tuo
+}
+
+// RemoveReminderIDs removes the "reminders" edge to Reminder entities by IDs.
+func (tuo *TodoUpdateOne) RemoveReminderIDs(ids ...int64) *TodoUpdateOne {
+	tuo.mutation.RemoveReminderIDs(ids...)
+	return tuo
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (tuo *TodoUpdateOne) ClearUser() *TodoUpdateOne {
-	tuo.mutation.ClearUser()
-	return tuo
+// RemoveReminders removes "reminders" edges to Reminder entities.
+func (tuo *TodoUpdateOne) RemoveReminders(r ...*Reminder) *TodoUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return tuo.RemoveReminderIDs(ids...)
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
@@ -337,7 +1367,13 @@ func (tuo *TodoUpdateOne) Save(ctx context.Context) (*Todo, error) {
 		err  error
 		node *Todo
 	)
+	if err := tuo.defaults(); err != nil {
+		return nil, err
+	}
 	if len(tuo.hooks) == 0 {
+		if err = tuo.check(); err != nil {
+			return nil, err
+		}
 		node, err = tuo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -345,6 +1381,9 @@ func (tuo *TodoUpdateOne) Save(ctx context.Context) (*Todo, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = tuo.check(); err != nil {
+				return nil, err
+			}
 			tuo.mutation = mutation
 			node, err = tuo.sqlSave(ctx)
 			mutation.done = true
@@ -385,6 +1424,28 @@ func (tuo *TodoUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tuo *TodoUpdateOne) defaults() error {
+	if _, ok := tuo.mutation.UpdatedAt(); !ok {
+		if todo.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized todo.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := todo.UpdateDefaultUpdatedAt()
+		tuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TodoUpdateOne) check() error {
+	if v, ok := tuo.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf("ent: validator failed for field \"priority\": %w", err)}
+		}
+	}
+	return nil
+}
+
 func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -420,6 +1481,26 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 			}
 		}
 	}
+	if value, ok := tuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldUpdatedAt,
+		})
+	}
+	if value, ok := tuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldDeletedAt,
+		})
+	}
+	if tuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todo.FieldDeletedAt,
+		})
+	}
 	if value, ok := tuo.mutation.Title(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -454,6 +1535,61 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 			Column: todo.FieldIsCompleted,
 		})
 	}
+	if value, ok := tuo.mutation.CompletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldCompletedAt,
+		})
+	}
+	if tuo.mutation.CompletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todo.FieldCompletedAt,
+		})
+	}
+	if value, ok := tuo.mutation.AutoComplete(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todo.FieldAutoComplete,
+		})
+	}
+	if value, ok := tuo.mutation.Recurrence(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldRecurrence,
+		})
+	}
+	if value, ok := tuo.mutation.Timezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldTimezone,
+		})
+	}
+	if value, ok := tuo.mutation.Occurrence(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldOccurrence,
+		})
+	}
+	if value, ok := tuo.mutation.AddedOccurrence(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldOccurrence,
+		})
+	}
+	if value, ok := tuo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: todo.FieldPriority,
+		})
+	}
 	if tuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -463,7 +1599,7 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeString,
+					Type:   field.TypeInt64,
 					Column: user.FieldID,
 				},
 			},
@@ -479,7 +1615,7 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeString,
+					Type:   field.TypeInt64,
 					Column: user.FieldID,
 				},
 			},
@@ -489,6 +1625,292 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: project.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: project.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.OriginCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OriginTable,
+			Columns: []string{todo.OriginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.OriginIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OriginTable,
+			Columns: []string{todo.OriginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.OccurrencesTable,
+			Columns: []string{todo.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedOccurrencesIDs(); len(nodes) > 0 && !tuo.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.OccurrencesTable,
+			Columns: []string{todo.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.OccurrencesTable,
+			Columns: []string{todo.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: todo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ChecklistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChecklistItemsTable,
+			Columns: []string{todo.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: checklistitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedChecklistItemsIDs(); len(nodes) > 0 && !tuo.mutation.ChecklistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChecklistItemsTable,
+			Columns: []string{todo.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: checklistitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ChecklistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChecklistItemsTable,
+			Columns: []string{todo.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: checklistitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reminder.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !tuo.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reminder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reminder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Todo{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
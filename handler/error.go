@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"halill/apperror"
+	"halill/dto"
+	"halill/ent"
+	"halill/ent/privacy"
+	"halill/i18n"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// ErrorHandler 는 모든 에러를 RFC 7807 application/problem+json 으로 응답합니다.
+// 도메인 에러는 Code 를 그대로 내려주고, 알 수 없는 에러는 내용을 숨기고 로그만 남깁니다.
+// detail 은 요청의 Locale 로 번역합니다.
+func ErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	locale := i18n.FromContext(c.Request().Context())
+	problem := newProblem(err, locale)
+	problem.Instance = c.Request().URL.Path
+	if problem.Status >= http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+	c.Response().Header().Set("Content-Language", string(locale))
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(problem.Status)
+	} else {
+		err = c.JSON(problem.Status, problem)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+func newProblem(err error, locale i18n.Locale) *dto.ProblemResponse {
+	if ae, ok := apperror.As(err); ok {
+		p := problem(ae.Kind.Status(), ae.Code, ae.Message(locale))
+		for _, fe := range ae.Fields {
+			message := i18n.Message(locale, "validation."+fe.Rule)
+			p.Errors = append(p.Errors, &dto.FieldProblem{
+				Field:   fe.Field,
+				Code:    fe.Rule,
+				Message: strings.ReplaceAll(message, "{param}", fe.Param),
+			})
+		}
+		return p
+	}
+	// privacy 규칙에 막힌 요청은 다른 사용자의 데이터에 접근하려던 것
+	if errors.Is(err, privacy.Deny) {
+		return newProblem(apperror.ErrForbidden, locale)
+	}
+	if ent.IsNotFound(err) {
+		return problem(http.StatusNotFound, "not_found", i18n.Message(locale, "not_found"))
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		code := statusCode(he.Code)
+		// echo 가 만든 메시지는 영어뿐이므로 카탈로그에 있는 코드면 번역한 메시지를 씀
+		detail, ok := i18n.Lookup(locale, code)
+		if !ok {
+			detail, _ = he.Message.(string)
+		}
+		return problem(he.Code, code, detail)
+	}
+
+	return problem(http.StatusInternalServerError, "internal_error", i18n.Message(locale, "internal_error"))
+}
+
+func problem(status int, code, detail string) *dto.ProblemResponse {
+	return &dto.ProblemResponse{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// statusCode 는 echo 가 만든 에러처럼 도메인 에러가 아닌 경우 상태 코드로 에러 코드를 만듭니다. 예) 404 -> not_found
+func statusCode(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return fmt.Sprintf("status_%d", status)
+	}
+	return strings.ReplaceAll(strings.ToLower(strings.ReplaceAll(text, "-", " ")), " ", "_")
+}
+
+// paramID 는 경로 파라미터 name 을 ID 로 읽습니다.
+func paramID(c echo.Context, name string) (int64, error) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 64)
+	if err != nil {
+		return 0, apperror.ErrInvalidPathParam.Wrap(err)
+	}
+	return id, nil
+}
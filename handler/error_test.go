@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"halill/apperror"
+	"halill/dto"
+	"halill/ent/privacy"
+	"halill/i18n"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorHandler(t *testing.T) {
+	renderIn := func(locale i18n.Locale, err error) (*httptest.ResponseRecorder, *dto.ProblemResponse) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/todo/1", nil)
+		c := e.NewContext(req.WithContext(i18n.NewContext(req.Context(), locale)), rec)
+
+		ErrorHandler(err, c)
+		problem := &dto.ProblemResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), problem))
+		return rec, problem
+	}
+	render := func(err error) (*httptest.ResponseRecorder, *dto.ProblemResponse) {
+		return renderIn(i18n.Korean, err)
+	}
+
+	t.Run("도메인 에러는 code 와 함께 응답", func(t *testing.T) {
+		rec, problem := render(apperror.ErrTodoNotFound)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, &dto.ProblemResponse{
+			Type:     "about:blank",
+			Title:    "Not Found",
+			Status:   http.StatusNotFound,
+			Detail:   "존재하지 않는 Todo 입니다.",
+			Instance: "/todo/1",
+			Code:     "todo_not_found",
+		}, problem)
+	})
+	t.Run("원인을 감싼 도메인 에러", func(t *testing.T) {
+		rec, problem := render(fmt.Errorf("get todo: %w", apperror.ErrInvalidPathParam.Wrap(errors.New("strconv"))))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "invalid_path_param", problem.Code)
+		assert.Equal(t, "잘못된 경로 파라미터입니다.", problem.Detail)
+	})
+	t.Run("privacy 규칙에 막힌 요청은 403", func(t *testing.T) {
+		rec, problem := render(fmt.Errorf("ent: %w", privacy.Deny))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, "forbidden", problem.Code)
+	})
+	t.Run("echo 에러는 상태 코드로 code 생성", func(t *testing.T) {
+		rec, problem := render(echo.ErrUnsupportedMediaType)
+		assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+		assert.Equal(t, "unsupported_media_type", problem.Code)
+	})
+	t.Run("알 수 없는 에러는 내용을 숨김", func(t *testing.T) {
+		rec, problem := render(errors.New("dial tcp 127.0.0.1:3306: connection refused"))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, "internal_error", problem.Code)
+		assert.Equal(t, "서버 오류가 발생했습니다.", problem.Detail)
+	})
+	t.Run("요청 언어로 번역", func(t *testing.T) {
+		rec, problem := renderIn(i18n.English, apperror.ErrTodoNotFound)
+		assert.Equal(t, "en", rec.Header().Get("Content-Language"))
+		assert.Equal(t, "Todo does not exist.", problem.Detail)
+
+		_, problem = renderIn(i18n.English, echo.ErrUnsupportedMediaType)
+		assert.Equal(t, "Content-Type is not supported.", problem.Detail)
+	})
+	t.Run("검증 실패는 필드 목록과 함께 422", func(t *testing.T) {
+		rec, problem := renderIn(i18n.English, apperror.ErrValidationFailed.WithFields(
+			apperror.FieldError{Field: "title", Rule: "required"},
+			apperror.FieldError{Field: "content", Rule: "max_length", Param: "10000"},
+		))
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Equal(t, "validation_failed", problem.Code)
+		assert.Equal(t, []*dto.FieldProblem{
+			{Field: "title", Code: "required", Message: "This field is required."},
+			{Field: "content", Code: "max_length", Message: "Must be at most 10000 characters."},
+		}, problem.Errors)
+	})
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"halill/security"
+
+	"github.com/labstack/echo/v4"
+)
+
+type JWKSHandler struct {
+	jp security.JWTProvider
+}
+
+func NewJWKSHandler(e *echo.Group, jp security.JWTProvider) *JWKSHandler {
+	handler := &JWKSHandler{
+		jp: jp,
+	}
+	e.GET("/.well-known/jwks.json", handler.GetJWKS)
+	return handler
+}
+
+func (h *JWKSHandler) GetJWKS(c echo.Context) error {
+	// 다른 서비스가 키를 캐시하되 교체는 빠르게 반영되도록 짧게 캐시
+	c.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return c.JSON(200, h.jp.JWKS())
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"encoding/json"
+	"halill/mocks"
+	"halill/security"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetJWKS(t *testing.T) {
+	e := echo.New()
+	g := e.Group("")
+	jp := new(mocks.JWTProvider)
+	expectedResponse := &security.JWKSet{
+		Keys: []security.JWK{
+			{Kty: "OKP", Kid: "ed-1", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "asdf"},
+		},
+	}
+	jp.On("JWKS").Return(expectedResponse)
+
+	t.Run("JWKS 요청 성공", func(t *testing.T) {
+		jh := NewJWKSHandler(g, jp)
+		req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := jh.GetJWKS(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		resp := &security.JWKSet{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		assert.Equal(t, expectedResponse, resp)
+	})
+}
@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"halill/i18n"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NewLocaleMiddleware 는 Accept-Language 헤더로 응답 메시지의 언어를 정해 요청 context 에 담습니다.
+// 로그인한 사용자가 언어를 설정해 두었다면 NewAuthMiddleware 에서 그 설정으로 덮어씁니다.
+func NewLocaleMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			locale := i18n.Match(c.Request().Header.Get("Accept-Language"))
+			c.SetRequest(c.Request().WithContext(i18n.NewContext(c.Request().Context(), locale)))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"halill/apperror"
+	"halill/i18n"
+	"halill/security"
+	"halill/service"
+	"halill/viewer"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// accountKey 는 NewAuthMiddleware 가 확인한 사용자(*dto.UserResponse)를 echo.Context 에 담는 키입니다.
+const accountKey = "account"
+
+// NewAuthMiddleware 는 JWT 서명을 검증한 뒤 로그아웃 등으로 폐기된 토큰인지 확인합니다.
+// 검증한 사용자는 viewer 로 요청 context 에 담아 데이터 계층의 권한 검사에 사용합니다.
+func NewAuthMiddleware(jp security.JWTProvider, us service.UserService) echo.MiddlewareFunc {
+	jwtMiddleware := middleware.JWTWithConfig(middleware.JWTConfig{
+		ParseTokenFunc: func(auth string, c echo.Context) (interface{}, error) {
+			token, err := jp.ParseToken(auth)
+			if err != nil {
+				return nil, err
+			}
+			// refresh token 은 bearer 토큰으로 받지 않음
+			if token.Claims.(*security.JwtCustomClaims).TokenType != security.AccessTokenType {
+				return nil, apperror.ErrInvalidToken
+			}
+			return token, nil
+		},
+	})
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return jwtMiddleware(func(c echo.Context) error {
+			claims := c.Get("user").(*jwt.Token).
+				Claims.(*security.JwtCustomClaims)
+			ctx := viewer.NewContext(c.Request().Context(), &viewer.Viewer{UserID: claims.UserID})
+			user, err := us.VerifyAccessToken(ctx, claims)
+			if err != nil {
+				return err
+			}
+			// 사용자가 고른 언어가 Accept-Language 보다 우선
+			if locale, ok := i18n.Parse(user.Locale); ok {
+				ctx = i18n.NewContext(ctx, locale)
+			}
+			c.SetRequest(c.Request().WithContext(ctx))
+			c.Set(accountKey, user)
+
+			return next(c)
+		})
+	}
+}
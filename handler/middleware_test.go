@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"halill/apperror"
+	"halill/dto"
+	"halill/ent"
+	"halill/i18n"
+	"halill/mocks"
+	"halill/security"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// jwtMiddleware 는 폐기 여부 확인 없이 토큰만 검증해 핸들러 테스트에서 claims 를 채웁니다.
+func jwtMiddleware(jp security.JWTProvider) echo.MiddlewareFunc {
+	return middleware.JWTWithConfig(middleware.JWTConfig{
+		ParseTokenFunc: func(auth string, c echo.Context) (interface{}, error) {
+			return jp.ParseToken(auth)
+		},
+	})
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	jp := security.NewJWTProvider("test_secret")
+	accessToken, err := jp.GenerateAccessToken(user)
+	assert.NoError(t, err)
+	next := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+
+	t.Run("유효한 토큰 통과", func(t *testing.T) {
+		e := echo.New()
+		us := new(mocks.UserService)
+		us.On("VerifyAccessToken", mock.Anything, mock.AnythingOfType("*security.JwtCustomClaims")).Return(&dto.UserResponse{ID: user.ID, Email: user.Email}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/todo", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := NewAuthMiddleware(jp, us)(next)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+	t.Run("로그아웃된 토큰 거부", func(t *testing.T) {
+		e := echo.New()
+		us := new(mocks.UserService)
+		us.On("VerifyAccessToken", mock.Anything, mock.AnythingOfType("*security.JwtCustomClaims")).
+			Return(nil, apperror.ErrRevokedToken)
+
+		req := httptest.NewRequest(http.MethodGet, "/todo", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := NewAuthMiddleware(jp, us)(next)(c)
+		assert.Equal(t, apperror.ErrRevokedToken, err)
+	})
+	t.Run("refresh token 은 bearer 토큰으로 거부", func(t *testing.T) {
+		refreshToken, err := jp.GenerateRefreshToken(user)
+		assert.NoError(t, err)
+		e := echo.New()
+		us := new(mocks.UserService)
+
+		req := httptest.NewRequest(http.MethodGet, "/todo", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+refreshToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err = NewAuthMiddleware(jp, us)(next)(c)
+		he, ok := err.(*echo.HTTPError)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, he.Code)
+		us.AssertNotCalled(t, "VerifyAccessToken", mock.Anything, mock.Anything)
+	})
+	t.Run("사용자 언어 설정이 Accept-Language 보다 우선", func(t *testing.T) {
+		e := echo.New()
+		us := new(mocks.UserService)
+		us.On("VerifyAccessToken", mock.Anything, mock.AnythingOfType("*security.JwtCustomClaims")).Return(&dto.UserResponse{ID: user.ID, Email: user.Email, Locale: "en"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/todo", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set("Accept-Language", "ko-KR,ko;q=0.9")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := NewLocaleMiddleware()(NewAuthMiddleware(jp, us)(func(c echo.Context) error {
+			assert.Equal(t, i18n.English, i18n.FromContext(c.Request().Context()))
+			return c.NoContent(http.StatusOK)
+		}))(c)
+		assert.NoError(t, err)
+	})
+}
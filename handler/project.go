@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"halill/dto"
+	"halill/security"
+	"halill/service"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type ProjectHandler struct {
+	ps service.ProjectService
+}
+
+func NewProjectHandler(e *echo.Group, ps service.ProjectService, auth echo.MiddlewareFunc) *ProjectHandler {
+	handler := &ProjectHandler{
+		ps: ps,
+	}
+	e.Use(auth)
+	e.GET("", handler.GetAllProjects)
+	e.POST("", handler.CreateProject)
+	e.PUT("/:project_id", handler.UpdateProject)
+	e.POST("/:project_id/archive", handler.ArchiveProject)
+	e.POST("/:project_id/unarchive", handler.UnarchiveProject)
+	e.DELETE("/:project_id", handler.DeleteProject)
+
+	return handler
+}
+
+func (h *ProjectHandler) GetAllProjects(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.ProjectListRequest{}
+	if err := c.Bind(request); err != nil {
+		return err
+	}
+
+	projects, err := h.ps.GetAllProjects(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, projects)
+}
+
+func (h *ProjectHandler) CreateProject(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.CreateProjectRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	project, err := h.ps.CreateProject(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, project)
+}
+
+func (h *ProjectHandler) UpdateProject(c echo.Context) error {
+	projectID, err := paramID(c, "project_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.UpdateProjectRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	project, err := h.ps.UpdateProject(c.Request().Context(), projectID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, project)
+}
+
+func (h *ProjectHandler) ArchiveProject(c echo.Context) error {
+	return h.setArchived(c, true)
+}
+
+func (h *ProjectHandler) UnarchiveProject(c echo.Context) error {
+	return h.setArchived(c, false)
+}
+
+func (h *ProjectHandler) setArchived(c echo.Context, archived bool) error {
+	projectID, err := paramID(c, "project_id")
+	if err != nil {
+		return err
+	}
+
+	project, err := h.ps.ArchiveProject(c.Request().Context(), projectID, archived)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, project)
+}
+
+func (h *ProjectHandler) DeleteProject(c echo.Context) error {
+	projectID, err := paramID(c, "project_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.DeleteProjectRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	project, err := h.ps.DeleteProject(c.Request().Context(), projectID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, project)
+}
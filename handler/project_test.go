@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"halill/dto"
+	"halill/ent"
+	"halill/mocks"
+	"halill/security"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAllProjects(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/project")
+	ps := new(mocks.ProjectService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	expectedResponse := []*dto.ProjectResponse{
+		{ID: 1, Name: "회사", Color: "#ff0000"},
+	}
+	ps.On("GetAllProjects", mock.Anything, mock.AnythingOfType("*dto.ProjectListRequest"), int64(1)).Return(expectedResponse, nil)
+
+	t.Run("보관되지 않은 프로젝트 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/project?archived=false", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		ph := NewProjectHandler(g, ps, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(ph.GetAllProjects)(c)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"id":1,"name":"회사","color":"#ff0000","position":0,"archived":false}]`, rec.Body.String())
+
+		request := ps.Calls[0].Arguments.Get(1).(*dto.ProjectListRequest)
+		assert.False(t, *request.Archived)
+	})
+}
+
+func TestCreateProject(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/project")
+	ps := new(mocks.ProjectService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	ps.On("CreateProject", mock.Anything, &dto.CreateProjectRequest{Name: "회사", Color: "#ff0000"}, int64(1)).Return(&dto.ProjectResponse{ID: 1, Name: "회사", Color: "#ff0000"}, nil)
+
+	t.Run("프로젝트 생성 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/project", strings.NewReader(`{"name":"회사","color":"#ff0000"}`))
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		ph := NewProjectHandler(g, ps, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(ph.CreateProject)(c)
+		assert.NoError(t, err)
+	})
+}
+
+func TestArchiveProject(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/project")
+	ps := new(mocks.ProjectService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	ps.On("ArchiveProject", mock.Anything, int64(1), true).Return(&dto.ProjectResponse{ID: 1, Name: "회사", Archived: true}, nil)
+	ps.On("ArchiveProject", mock.Anything, int64(1), false).Return(&dto.ProjectResponse{ID: 1, Name: "회사"}, nil)
+
+	for _, tc := range []struct {
+		name     string
+		path     string
+		archived bool
+	}{
+		{"프로젝트 보관 요청 성공", "/:project_id/archive", true},
+		{"프로젝트 보관 해제 요청 성공", "/:project_id/unarchive", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+			accessToken, err := jwtProvider.GenerateAccessToken(user)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/project"+strings.Replace(tc.path, ":project_id", "1", 1), nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath(tc.path)
+			c.SetParamNames("project_id")
+			c.SetParamValues("1")
+
+			ph := NewProjectHandler(g, ps, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+			handler := ph.UnarchiveProject
+			if tc.archived {
+				handler = ph.ArchiveProject
+			}
+			err = jwtMiddleware(jwtProvider)(handler)(c)
+			assert.NoError(t, err)
+			ps.AssertCalled(t, "ArchiveProject", mock.Anything, int64(1), tc.archived)
+		})
+	}
+}
+
+func TestDeleteProject(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/project")
+	ps := new(mocks.ProjectService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	ps.On("DeleteProject", mock.Anything, int64(1), &dto.DeleteProjectRequest{Mode: "cascade"}).Return(&dto.ProjectResponse{ID: 1, Name: "회사"}, nil)
+
+	t.Run("프로젝트 삭제 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodDelete, "/project/1?mode=cascade", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:project_id")
+		c.SetParamNames("project_id")
+		c.SetParamValues("1")
+
+		ph := NewProjectHandler(g, ps, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(ph.DeleteProject)(c)
+		assert.NoError(t, err)
+	})
+}
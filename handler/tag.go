@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"halill/dto"
+	"halill/repository"
+	"halill/security"
+	"halill/service"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/wire"
+	"github.com/labstack/echo/v4"
+)
+
+var TagSet = wire.NewSet(NewTagHandler, service.NewTagService, repository.NewTagRepository)
+
+type TagHandler struct {
+	ts service.TagService
+}
+
+func NewTagHandler(e *echo.Group, ts service.TagService, auth echo.MiddlewareFunc) *TagHandler {
+	handler := &TagHandler{
+		ts: ts,
+	}
+	e.Use(auth)
+	e.GET("", handler.GetAllTags)
+	e.POST("", handler.CreateTag)
+	e.PUT("/:tag_id", handler.UpdateTag)
+	e.DELETE("/:tag_id", handler.DeleteTag)
+
+	return handler
+}
+
+func (h *TagHandler) GetAllTags(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+
+	tags, err := h.ts.GetAllTags(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, tags)
+}
+
+func (h *TagHandler) CreateTag(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.TagRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	tag, err := h.ts.CreateTag(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, tag)
+}
+
+func (h *TagHandler) UpdateTag(c echo.Context) error {
+	tagID, err := paramID(c, "tag_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.TagRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	tag, err := h.ts.UpdateTag(c.Request().Context(), tagID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, tag)
+}
+
+func (h *TagHandler) DeleteTag(c echo.Context) error {
+	tagID, err := paramID(c, "tag_id")
+	if err != nil {
+		return err
+	}
+
+	tag, err := h.ts.DeleteTag(c.Request().Context(), tagID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, tag)
+}
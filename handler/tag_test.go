@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"halill/dto"
+	"halill/ent"
+	"halill/mocks"
+	"halill/security"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAllTags(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/tag")
+	ts := new(mocks.TagService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	expectedResponse := []*dto.TagResponse{
+		{ID: 1, Name: "개인"},
+		{ID: 2, Name: "업무"},
+	}
+	ts.On("GetAllTags", mock.Anything, int64(1)).Return(expectedResponse, nil)
+
+	t.Run("모든 태그 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/tag", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		th := NewTagHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.GetAllTags)(c)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"id":1,"name":"개인"},{"id":2,"name":"업무"}]`, rec.Body.String())
+	})
+}
+
+func TestCreateTag(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/tag")
+	ts := new(mocks.TagService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	ts.On("CreateTag", mock.Anything, &dto.TagRequest{Name: "업무"}, int64(1)).Return(&dto.TagResponse{ID: 1, Name: "업무"}, nil)
+
+	t.Run("태그 생성 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		request := &bytes.Buffer{}
+		json.NewEncoder(request).Encode(&dto.TagRequest{Name: "업무"})
+
+		req := httptest.NewRequest(http.MethodPost, "/tag", request)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		th := NewTagHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.CreateTag)(c)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":1,"name":"업무"}`, rec.Body.String())
+	})
+}
+
+func TestUpdateTag(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/tag")
+	ts := new(mocks.TagService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	ts.On("UpdateTag", mock.Anything, int64(1), &dto.TagRequest{Name: "회사"}).Return(&dto.TagResponse{ID: 1, Name: "회사"}, nil)
+
+	t.Run("태그 수정 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		request := &bytes.Buffer{}
+		json.NewEncoder(request).Encode(&dto.TagRequest{Name: "회사"})
+
+		req := httptest.NewRequest(http.MethodPut, "/tag/1", request)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:tag_id")
+		c.SetParamNames("tag_id")
+		c.SetParamValues("1")
+
+		th := NewTagHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.UpdateTag)(c)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":1,"name":"회사"}`, rec.Body.String())
+	})
+}
+
+func TestDeleteTag(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/tag")
+	ts := new(mocks.TagService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	ts.On("DeleteTag", mock.Anything, mock.AnythingOfType("int64")).Return(&dto.TagResponse{ID: 1, Name: "업무"}, nil)
+
+	t.Run("태그 삭제 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodDelete, "/tag/1", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:tag_id")
+		c.SetParamNames("tag_id")
+		c.SetParamValues("1")
+
+		th := NewTagHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.DeleteTag)(c)
+		assert.NoError(t, err)
+	})
+}
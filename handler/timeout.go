@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"halill/apperror"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NewTimeoutMiddleware 는 요청 context 에 timeout 만큼의 기한을 걸어 느린 쿼리가 끝없이 붙잡히지 않도록 합니다.
+// 기한이 지나 실패한 요청은 503 으로 응답합니다. timeout 이 0 이하이면 기한을 걸지 않습니다.
+func NewTimeoutMiddleware(timeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if timeout <= 0 {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			err := next(c)
+			if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return apperror.ErrTimeout
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"halill/apperror"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutMiddleware(t *testing.T) {
+	t.Run("요청 context 에 기한 설정", func(t *testing.T) {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/todo", nil), httptest.NewRecorder())
+		next := func(c echo.Context) error {
+			_, ok := c.Request().Context().Deadline()
+			assert.True(t, ok)
+			return nil
+		}
+
+		err := NewTimeoutMiddleware(time.Second)(next)(c)
+		assert.NoError(t, err)
+	})
+	t.Run("기한을 넘기면 503", func(t *testing.T) {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/todo", nil), httptest.NewRecorder())
+		next := func(c echo.Context) error {
+			<-c.Request().Context().Done()
+			return c.Request().Context().Err()
+		}
+
+		err := NewTimeoutMiddleware(time.Millisecond)(next)(c)
+		assert.Equal(t, apperror.ErrTimeout, err)
+	})
+	t.Run("0 이면 기한 없음", func(t *testing.T) {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/todo", nil), httptest.NewRecorder())
+		next := func(c echo.Context) error {
+			_, ok := c.Request().Context().Deadline()
+			assert.False(t, ok)
+			return nil
+		}
+
+		err := NewTimeoutMiddleware(0)(next)(c)
+		assert.NoError(t, err)
+	})
+}
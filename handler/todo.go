@@ -1,48 +1,93 @@
 package handler
 
 import (
+	"encoding/json"
+	"halill/apperror"
 	"halill/dto"
-	"halill/repository"
 	"halill/security"
 	"halill/service"
-	"log"
-	"strconv"
+	"strings"
 
 	"github.com/golang-jwt/jwt"
-	"github.com/google/wire"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 )
 
-var TodoSet = wire.NewSet(NewTodoHandler, service.NewTodoService, repository.NewUserRepository, repository.NewTodoRepository)
+const MIMEApplicationMergePatchJSON = "application/merge-patch+json"
 
 type TodoHandler struct {
 	ts service.TodoService
 }
 
-func NewTodoHandler(e *echo.Group, ts service.TodoService, jwtSecret string) *TodoHandler {
+func NewTodoHandler(e *echo.Group, ts service.TodoService, auth echo.MiddlewareFunc) *TodoHandler {
 	handler := &TodoHandler{
 		ts: ts,
 	}
-	config := middleware.JWTConfig{
-		Claims:     &security.JwtCustomClaims{},
-		SigningKey: []byte(jwtSecret),
-	}
-	e.Use(middleware.JWTWithConfig(config))
+	e.Use(auth)
 	e.GET("", handler.GetAllTodos)
+	e.GET("/search", handler.SearchTodos)
+	e.GET("/next", handler.GetNextTodos)
+	e.GET("/trash", handler.GetTrash)
 	e.GET("/:todo_id", handler.GetTodo)
 	e.POST("", handler.CreateTodo)
-	e.PATCH("/:todo_id", handler.CompleteTodo)
+	e.PUT("/:todo_id", handler.UpdateTodo)
+	e.PATCH("/:todo_id", handler.PatchTodo)
+	e.POST("/:todo_id/complete", handler.CompleteTodo)
+	e.GET("/:todo_id/history", handler.GetTodoHistory)
+	e.POST("/:todo_id/tags", handler.AddTags)
+	e.DELETE("/:todo_id/tags/:tag_id", handler.RemoveTag)
+	e.POST("/:todo_id/checklist", handler.AddChecklistItem)
+	e.PUT("/:todo_id/checklist/order", handler.ReorderChecklist)
+	e.PUT("/:todo_id/checklist/:item_id", handler.UpdateChecklistItem)
+	e.DELETE("/:todo_id/checklist/:item_id", handler.DeleteChecklistItem)
+	e.POST("/:todo_id/reminders", handler.AddReminder)
+	e.DELETE("/:todo_id/reminders/:reminder_id", handler.DeleteReminder)
 	e.DELETE("/:todo_id", handler.DeleteTodo)
+	e.POST("/:todo_id/restore", handler.RestoreTodo)
 
 	return handler
 }
 
 func (h *TodoHandler) GetAllTodos(c echo.Context) error {
-	email := c.Get("user").(*jwt.Token).
-		Claims.(*security.JwtCustomClaims).Email
-	log.Println(email)
-	todos, err := h.ts.GetAllTodos(email)
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.TodoListRequest{}
+	if err := c.Bind(request); err != nil {
+		return err
+	}
+
+	page, err := h.ts.GetAllTodos(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, page)
+}
+
+func (h *TodoHandler) SearchTodos(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.TodoSearchRequest{}
+	if err := c.Bind(request); err != nil {
+		return err
+	}
+
+	results, err := h.ts.SearchTodos(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, results)
+}
+
+func (h *TodoHandler) GetNextTodos(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.NextTodosRequest{}
+	if err := c.Bind(request); err != nil {
+		return err
+	}
+
+	todos, err := h.ts.GetNextTodos(c.Request().Context(), request, userID)
 	if err != nil {
 		return err
 	}
@@ -51,14 +96,12 @@ func (h *TodoHandler) GetAllTodos(c echo.Context) error {
 }
 
 func (h *TodoHandler) GetTodo(c echo.Context) error {
-	email := c.Get("user").(*jwt.Token).
-		Claims.(*security.JwtCustomClaims).Email
-	todoID, err := strconv.ParseInt(c.Param("todo_id"), 10, 64)
+	todoID, err := paramID(c, "todo_id")
 	if err != nil {
 		return err
 	}
 
-	todo, err := h.ts.GetTodo(todoID, email)
+	todo, err := h.ts.GetTodo(c.Request().Context(), todoID)
 	if err != nil {
 		return err
 	}
@@ -67,15 +110,62 @@ func (h *TodoHandler) GetTodo(c echo.Context) error {
 }
 
 func (h *TodoHandler) CreateTodo(c echo.Context) error {
-	email := c.Get("user").(*jwt.Token).
-		Claims.(*security.JwtCustomClaims).Email
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
 	request := &dto.CreateTodoRequest{}
 	err := c.Bind(request)
 	if err != nil {
 		return err
 	}
 
-	todo, err := h.ts.CreateTodo(request, email)
+	todo, err := h.ts.CreateTodo(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) UpdateTodo(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.UpdateTodoRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.UpdateTodo(c.Request().Context(), todoID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) PatchTodo(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	// echo 의 Bind 는 application/merge-patch+json 을 지원하지 않으므로 직접 디코딩
+	ctype := c.Request().Header.Get(echo.HeaderContentType)
+	if !strings.HasPrefix(ctype, echo.MIMEApplicationJSON) && !strings.HasPrefix(ctype, MIMEApplicationMergePatchJSON) {
+		return echo.ErrUnsupportedMediaType
+	}
+	request := &dto.PatchTodoRequest{}
+	err = json.NewDecoder(c.Request().Body).Decode(request)
+	if err != nil {
+		return apperror.ErrInvalidBody.Wrap(err)
+	}
+	// 직접 디코딩했으므로 Binder 대신 여기서 검사
+	if err := validate(request); err != nil {
+		return err
+	}
+
+	todo, err := h.ts.PatchTodo(c.Request().Context(), todoID, request)
 	if err != nil {
 		return err
 	}
@@ -84,14 +174,179 @@ func (h *TodoHandler) CreateTodo(c echo.Context) error {
 }
 
 func (h *TodoHandler) CompleteTodo(c echo.Context) error {
-	email := c.Get("user").(*jwt.Token).
-		Claims.(*security.JwtCustomClaims).Email
-	todoID, err := strconv.ParseInt(c.Param("todo_id"), 10, 64)
+	todoID, err := paramID(c, "todo_id")
 	if err != nil {
 		return err
 	}
 
-	todo, err := h.ts.CompleteTodo(todoID, email)
+	todo, err := h.ts.CompleteTodo(c.Request().Context(), todoID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) GetTodoHistory(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+
+	todos, err := h.ts.GetTodoHistory(c.Request().Context(), todoID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todos)
+}
+
+func (h *TodoHandler) AddTags(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.TodoTagsRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.AddTags(c.Request().Context(), todoID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) RemoveTag(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	tagID, err := paramID(c, "tag_id")
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.RemoveTag(c.Request().Context(), todoID, tagID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) AddChecklistItem(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.CreateChecklistItemRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.AddChecklistItem(c.Request().Context(), todoID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) UpdateChecklistItem(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	itemID, err := paramID(c, "item_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.UpdateChecklistItemRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.UpdateChecklistItem(c.Request().Context(), todoID, itemID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) ReorderChecklist(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.ReorderChecklistRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.ReorderChecklist(c.Request().Context(), todoID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) DeleteChecklistItem(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	itemID, err := paramID(c, "item_id")
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.DeleteChecklistItem(c.Request().Context(), todoID, itemID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) AddReminder(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	request := &dto.CreateReminderRequest{}
+	err = c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.AddReminder(c.Request().Context(), todoID, request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) DeleteReminder(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+	reminderID, err := paramID(c, "reminder_id")
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.DeleteReminder(c.Request().Context(), todoID, reminderID)
 	if err != nil {
 		return err
 	}
@@ -100,14 +355,38 @@ func (h *TodoHandler) CompleteTodo(c echo.Context) error {
 }
 
 func (h *TodoHandler) DeleteTodo(c echo.Context) error {
-	email := c.Get("user").(*jwt.Token).
-		Claims.(*security.JwtCustomClaims).Email
-	todoID, err := strconv.ParseInt(c.Param("todo_id"), 10, 64)
+	todoID, err := paramID(c, "todo_id")
+	if err != nil {
+		return err
+	}
+
+	todo, err := h.ts.DeleteTodo(c.Request().Context(), todoID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todo)
+}
+
+func (h *TodoHandler) GetTrash(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+
+	todos, err := h.ts.GetTrash(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, todos)
+}
+
+func (h *TodoHandler) RestoreTodo(c echo.Context) error {
+	todoID, err := paramID(c, "todo_id")
 	if err != nil {
 		return err
 	}
 
-	todo, err := h.ts.DeleteTodo(todoID, email)
+	todo, err := h.ts.RestoreTodo(c.Request().Context(), todoID)
 	if err != nil {
 		return err
 	}
@@ -9,11 +9,11 @@ import (
 	"halill/security"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,26 +29,30 @@ func TestGetAllTodos(t *testing.T) {
 	g := e.Group("/todo")
 	ts := new(mocks.TodoService)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
 		Password: "password",
 		Name:     "조호원",
 	}
 	deadline := time.Now().Add(24 * 3 * time.Hour)
-	expectedResponse := []*dto.TodoResponse{
-		{
-			ID:          1,
-			Title:       "Go 언어 공부하기",
-			Content:     "장재휴의 Go 웹 프로그래밍 철저 입문",
-			Deadline:    &deadline,
-			IsCompleted: false,
-		}, {
-			ID:          2,
-			Title:       "CEO가 해야하는 일",
-			Content:     "역대 CEO가 공부하는 것들 찾아보기",
-			IsCompleted: false,
+	expectedResponse := &dto.TodoPageResponse{
+		Items: []*dto.TodoResponse{
+			{
+				ID:          1,
+				Title:       "Go 언어 공부하기",
+				Content:     "장재휴의 Go 웹 프로그래밍 철저 입문",
+				Deadline:    &deadline,
+				IsCompleted: false,
+			}, {
+				ID:          2,
+				Title:       "CEO가 해야하는 일",
+				Content:     "역대 CEO가 공부하는 것들 찾아보기",
+				IsCompleted: false,
+			},
 		},
+		TotalCount: 2,
 	}
-	ts.On("GetAllTodos", mock.AnythingOfType("string")).Return(expectedResponse, nil)
+	ts.On("GetAllTodos", mock.Anything, mock.AnythingOfType("*dto.TodoListRequest"), mock.AnythingOfType("int64")).Return(expectedResponse, nil)
 
 	t.Run("모든 Todo 요청 성공", func(t *testing.T) {
 		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
@@ -61,12 +65,124 @@ func TestGetAllTodos(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		th := NewTodoHandler(g, ts, jwtProvider.JwtSecret())
-		err = middleware.JWTWithConfig(middleware.JWTConfig{
-			Claims:     &security.JwtCustomClaims{},
-			SigningKey: []byte(jwtProvider.JwtSecret()),
-		})(th.GetAllTodos)(c)
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.GetAllTodos)(c)
+		assert.NoError(t, err)
+	})
+	t.Run("query parameter 바인딩", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/todo?is_completed=false&deadline_to=2026-10-25T00:00:00Z&sort=-deadline&limit=10&tag=1&tag=2&tag_mode=or", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.GetAllTodos)(c)
 		assert.NoError(t, err)
+
+		request := ts.Calls[len(ts.Calls)-1].Arguments.Get(1).(*dto.TodoListRequest)
+		assert.False(t, *request.IsCompleted)
+		assert.True(t, time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC).Equal(*request.DeadlineTo))
+		assert.Equal(t, "-deadline", request.Sort)
+		assert.Equal(t, 10, request.Limit)
+		assert.Equal(t, []int64{1, 2}, request.Tags)
+		assert.Equal(t, "or", request.TagMode)
+	})
+}
+
+func TestSearchTodos(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	expectedResponse := []*dto.TodoSearchResponse{
+		{
+			TodoResponse: &dto.TodoResponse{
+				ID:      1,
+				Title:   "인보이스 발행",
+				Content: "3월 인보이스를 거래처에 보내기",
+			},
+			Score:   3,
+			Snippet: "3월 <mark>인보이스</mark>를 거래처에 보내기",
+		},
+	}
+	ts.On("SearchTodos", mock.Anything, mock.AnythingOfType("*dto.TodoSearchRequest"), mock.AnythingOfType("int64")).Return(expectedResponse, nil)
+
+	t.Run("Todo 검색 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/todo/search?q=%EC%9D%B8%EB%B3%B4%EC%9D%B4%EC%8A%A4&limit=5", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.SearchTodos)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		request := ts.Calls[len(ts.Calls)-1].Arguments.Get(1).(*dto.TodoSearchRequest)
+		assert.Equal(t, "인보이스", request.Query)
+		assert.Equal(t, 5, request.Limit)
+
+		var body []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "인보이스 발행", body[0]["title"])
+		assert.Equal(t, expectedResponse[0].Snippet, body[0]["snippet"])
+	})
+}
+
+func TestGetNextTodos(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	expectedResponse := []*dto.NextTodoResponse{
+		{
+			TodoResponse: &dto.TodoResponse{
+				ID:       1,
+				Title:    "보고서 제출",
+				Priority: "urgent",
+			},
+			Score: 1.5,
+		},
+	}
+	ts.On("GetNextTodos", mock.Anything, &dto.NextTodosRequest{Limit: 5}, int64(1)).Return(expectedResponse, nil)
+
+	t.Run("다음에 할 Todo 조회 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/todo/next?limit=5", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.GetNextTodos)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var body []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "urgent", body[0]["priority"])
+		assert.Equal(t, 1.5, body[0]["score"])
 	})
 }
 
@@ -76,7 +192,8 @@ func TestGetTodo(t *testing.T) {
 	ts := new(mocks.TodoService)
 	deadline := time.Now().Add(24 * 3 * time.Hour)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
 		Password: "password",
 		Name:     "조호원",
 	}
@@ -87,7 +204,7 @@ func TestGetTodo(t *testing.T) {
 		Deadline:    &deadline,
 		IsCompleted: false,
 	}
-	ts.On("GetTodo", mock.AnythingOfType("int64"), mock.AnythingOfType("string")).Return(expectedResponse, nil)
+	ts.On("GetTodo", mock.Anything, mock.AnythingOfType("int64")).Return(expectedResponse, nil)
 
 	t.Run("Todo 요청 성공", func(t *testing.T) {
 		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
@@ -103,11 +220,8 @@ func TestGetTodo(t *testing.T) {
 		c.SetParamNames("todo_id")
 		c.SetParamValues("1")
 
-		th := NewTodoHandler(g, ts, jwtProvider.JwtSecret())
-		err = middleware.JWTWithConfig(middleware.JWTConfig{
-			Claims:     &security.JwtCustomClaims{},
-			SigningKey: []byte(jwtProvider.JwtSecret()),
-		})(th.GetTodo)(c)
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.GetTodo)(c)
 		assert.NoError(t, err)
 	})
 }
@@ -118,7 +232,8 @@ func TestCreateTodo(t *testing.T) {
 	ts := new(mocks.TodoService)
 	deadline := time.Now().Add(24 * 3 * time.Hour)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
 		Password: "password",
 		Name:     "조호원",
 	}
@@ -129,7 +244,7 @@ func TestCreateTodo(t *testing.T) {
 		Deadline:    &deadline,
 		IsCompleted: false,
 	}
-	ts.On("CreateTodo", mock.AnythingOfType("*dto.CreateTodoRequest"), mock.AnythingOfType("string")).Return(expectedResponse, nil)
+	ts.On("CreateTodo", mock.Anything, mock.AnythingOfType("*dto.CreateTodoRequest"), mock.AnythingOfType("int64")).Return(expectedResponse, nil)
 
 	t.Run("Todo 생성 요청 성공", func(t *testing.T) {
 		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
@@ -150,22 +265,120 @@ func TestCreateTodo(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		th := NewTodoHandler(g, ts, jwtProvider.JwtSecret())
-		err = middleware.JWTWithConfig(middleware.JWTConfig{
-			Claims:     &security.JwtCustomClaims{},
-			SigningKey: []byte(jwtProvider.JwtSecret()),
-		})(th.CreateTodo)(c)
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.CreateTodo)(c)
+		assert.NoError(t, err)
+	})
+}
+
+func TestUpdateTodo(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	expectedResponse := &dto.TodoResponse{
+		ID:      1,
+		Title:   "Rust 공부하기",
+		Content: "The Rust Programming Language",
+	}
+	ts.On("UpdateTodo", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("*dto.UpdateTodoRequest")).Return(expectedResponse, nil)
+
+	t.Run("Todo 수정 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		request := &bytes.Buffer{}
+		json.NewEncoder(request).Encode(&dto.UpdateTodoRequest{
+			Title:   "Rust 공부하기",
+			Content: "The Rust Programming Language",
+		})
+		req := httptest.NewRequest(http.MethodPut, "/todo/1", request)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:todo_id")
+		c.SetParamNames("todo_id")
+		c.SetParamValues("1")
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.UpdateTodo)(c)
 		assert.NoError(t, err)
 	})
 }
 
+func TestPatchTodo(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	expectedResponse := &dto.TodoResponse{
+		ID:      1,
+		Title:   "Go 언어 공부하기",
+		Content: "장재휴의 Go 웹 프로그래밍 철저 입문",
+	}
+	ts.On("PatchTodo", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("*dto.PatchTodoRequest")).Return(expectedResponse, nil)
+
+	t.Run("Todo 부분 수정 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, "/todo/1", strings.NewReader(`{"deadline": null}`))
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, MIMEApplicationMergePatchJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:todo_id")
+		c.SetParamNames("todo_id")
+		c.SetParamValues("1")
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.PatchTodo)(c)
+		assert.NoError(t, err)
+		ts.AssertCalled(t, "PatchTodo", mock.Anything, int64(1), mock.MatchedBy(func(r *dto.PatchTodoRequest) bool {
+			return r.DeadlineSet && r.Deadline == nil && r.Title == nil
+		}))
+	})
+	t.Run("지원하지 않는 Content-Type", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, "/todo/1", strings.NewReader(`title=Rust`))
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:todo_id")
+		c.SetParamNames("todo_id")
+		c.SetParamValues("1")
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.PatchTodo)(c)
+		assert.Equal(t, echo.ErrUnsupportedMediaType, err)
+	})
+}
+
 func TestCompleteTodo(t *testing.T) {
 	e := echo.New()
 	g := e.Group("/todo")
 	ts := new(mocks.TodoService)
 	deadline := time.Now().Add(24 * 3 * time.Hour)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
 		Password: "password",
 		Name:     "조호원",
 	}
@@ -176,14 +389,14 @@ func TestCompleteTodo(t *testing.T) {
 		Deadline:    &deadline,
 		IsCompleted: true,
 	}
-	ts.On("CompleteTodo", mock.AnythingOfType("int64"), mock.AnythingOfType("string")).Return(expectedResponse, nil)
+	ts.On("CompleteTodo", mock.Anything, mock.AnythingOfType("int64")).Return(expectedResponse, nil)
 
 	t.Run("Todo 완료 요청 성공", func(t *testing.T) {
 		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
 		accessToken, err := jwtProvider.GenerateAccessToken(user)
 		assert.NoError(t, err)
 
-		req := httptest.NewRequest(http.MethodPatch, "/todo/1", nil)
+		req := httptest.NewRequest(http.MethodPost, "/todo/1/complete", nil)
 		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
@@ -192,13 +405,280 @@ func TestCompleteTodo(t *testing.T) {
 		c.SetParamNames("todo_id")
 		c.SetParamValues("1")
 
-		th := NewTodoHandler(g, ts, jwtProvider.JwtSecret())
-		err = middleware.JWTWithConfig(middleware.JWTConfig{
-			Claims:     &security.JwtCustomClaims{},
-			SigningKey: []byte(jwtProvider.JwtSecret()),
-		})(th.CompleteTodo)(c)
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.CompleteTodo)(c)
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetTodoHistory(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	originID := int64(1)
+	expectedResponse := []*dto.TodoResponse{
+		{ID: 1, Title: "분리수거", IsCompleted: true, Recurrence: "FREQ=WEEKLY;BYDAY=MO", Occurrence: 1},
+		{ID: 2, Title: "분리수거", Recurrence: "FREQ=WEEKLY;BYDAY=MO", Occurrence: 2, OriginID: &originID},
+	}
+	ts.On("GetTodoHistory", mock.Anything, int64(2)).Return(expectedResponse, nil)
+
+	t.Run("반복 Todo 회차 기록 조회 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/todo/2/history", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:todo_id/history")
+		c.SetParamNames("todo_id")
+		c.SetParamValues("2")
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.GetTodoHistory)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp []*dto.TodoResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, expectedResponse, resp)
+	})
+}
+
+func TestTrash(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	deletedAt := time.Now().Truncate(time.Second)
+	trashed := &dto.TodoResponse{ID: 1, Title: "분리수거", DeletedAt: &deletedAt}
+	restored := &dto.TodoResponse{ID: 1, Title: "분리수거"}
+	ts.On("GetTrash", mock.Anything, user.ID).Return([]*dto.TodoResponse{trashed}, nil)
+	ts.On("RestoreTodo", mock.Anything, int64(1)).Return(restored, nil)
+	jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+	accessToken, err := jwtProvider.GenerateAccessToken(user)
+	assert.NoError(t, err)
+
+	t.Run("휴지통 조회 성공", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/todo/trash", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err := jwtMiddleware(jwtProvider)(th.GetTrash)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp []*dto.TodoResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(t, resp, 1)
+		assert.True(t, deletedAt.Equal(*resp[0].DeletedAt))
+	})
+	t.Run("복원 성공", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/todo/1/restore", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:todo_id/restore")
+		c.SetParamNames("todo_id")
+		c.SetParamValues("1")
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err := jwtMiddleware(jwtProvider)(th.RestoreTodo)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp *dto.TodoResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, restored, resp)
+	})
+}
+
+func TestTodoTags(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	tagged := &dto.TodoResponse{
+		ID:    1,
+		Title: "보고서 작성",
+		Tags:  []*dto.TagResponse{{ID: 1, Name: "업무"}},
+	}
+	ts.On("AddTags", mock.Anything, int64(1), &dto.TodoTagsRequest{TagIDs: []int64{1}}).Return(tagged, nil)
+	ts.On("RemoveTag", mock.Anything, int64(1), int64(1)).Return(&dto.TodoResponse{ID: 1, Title: "보고서 작성", Tags: []*dto.TagResponse{}}, nil)
+
+	t.Run("태그 연결 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/todo/1/tags", strings.NewReader(`{"tag_ids":[1]}`))
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:todo_id/tags")
+		c.SetParamNames("todo_id")
+		c.SetParamValues("1")
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.AddTags)(c)
 		assert.NoError(t, err)
+		assert.Contains(t, rec.Body.String(), `"tags":[{"id":1,"name":"업무"}]`)
 	})
+	t.Run("태그 연결 해제 요청 성공", func(t *testing.T) {
+		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+		accessToken, err := jwtProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodDelete, "/todo/1/tags/1", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:todo_id/tags/:tag_id")
+		c.SetParamNames("todo_id", "tag_id")
+		c.SetParamValues("1", "1")
+
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.RemoveTag)(c)
+		assert.NoError(t, err)
+		assert.Contains(t, rec.Body.String(), `"tags":[]`)
+	})
+}
+
+func TestChecklist(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	expectedResponse := &dto.TodoResponse{
+		ID:    1,
+		Title: "이사 준비",
+		Checklist: []*dto.ChecklistItemResponse{
+			{ID: 1, Title: "짐 싸기", IsChecked: true},
+			{ID: 2, Title: "청소", Position: 1},
+		},
+		Progress: &dto.ProgressResponse{Done: 1, Total: 2},
+	}
+	ts.On("AddChecklistItem", mock.Anything, int64(1), &dto.CreateChecklistItemRequest{Title: "청소"}).Return(expectedResponse, nil)
+	ts.On("UpdateChecklistItem", mock.Anything, int64(1), int64(2), &dto.UpdateChecklistItemRequest{Title: "청소", IsChecked: true}).Return(expectedResponse, nil)
+	ts.On("ReorderChecklist", mock.Anything, int64(1), &dto.ReorderChecklistRequest{ItemIDs: []int64{2, 1}}).Return(expectedResponse, nil)
+	ts.On("DeleteChecklistItem", mock.Anything, int64(1), int64(2)).Return(expectedResponse, nil)
+
+	for _, tc := range []struct {
+		name    string
+		method  string
+		path    string
+		params  []string
+		body    string
+		handler func(th *TodoHandler) echo.HandlerFunc
+	}{
+		{"체크리스트 항목 추가 요청 성공", http.MethodPost, "/:todo_id/checklist", []string{"1"}, `{"title":"청소"}`, func(th *TodoHandler) echo.HandlerFunc { return th.AddChecklistItem }},
+		{"체크리스트 항목 체크 요청 성공", http.MethodPut, "/:todo_id/checklist/:item_id", []string{"1", "2"}, `{"title":"청소","is_checked":true}`, func(th *TodoHandler) echo.HandlerFunc { return th.UpdateChecklistItem }},
+		{"체크리스트 순서 변경 요청 성공", http.MethodPut, "/:todo_id/checklist/order", []string{"1"}, `{"item_ids":[2,1]}`, func(th *TodoHandler) echo.HandlerFunc { return th.ReorderChecklist }},
+		{"체크리스트 항목 삭제 요청 성공", http.MethodDelete, "/:todo_id/checklist/:item_id", []string{"1", "2"}, "", func(th *TodoHandler) echo.HandlerFunc { return th.DeleteChecklistItem }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+			accessToken, err := jwtProvider.GenerateAccessToken(user)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(tc.method, "/todo", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+			if tc.body != "" {
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath(tc.path)
+			c.SetParamNames([]string{"todo_id", "item_id"}[:len(tc.params)]...)
+			c.SetParamValues(tc.params...)
+
+			th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+			err = jwtMiddleware(jwtProvider)(tc.handler(th))(c)
+			assert.NoError(t, err)
+			assert.Contains(t, rec.Body.String(), `"progress":{"done":1,"total":2}`)
+		})
+	}
+}
+
+func TestReminders(t *testing.T) {
+	e := echo.New()
+	g := e.Group("/todo")
+	ts := new(mocks.TodoService)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+	deadline := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	remindAt := deadline.Add(-time.Hour)
+	expectedResponse := &dto.TodoResponse{
+		ID:        1,
+		Title:     "보고서 제출",
+		Deadline:  &deadline,
+		Reminders: []*dto.ReminderResponse{{ID: 3, OffsetMinutes: 60, RemindAt: &remindAt}},
+	}
+	ts.On("AddReminder", mock.Anything, int64(1), &dto.CreateReminderRequest{OffsetMinutes: 60}).Return(expectedResponse, nil)
+	ts.On("DeleteReminder", mock.Anything, int64(1), int64(3)).Return(expectedResponse, nil)
+
+	for _, tc := range []struct {
+		name    string
+		method  string
+		path    string
+		params  []string
+		body    string
+		handler func(th *TodoHandler) echo.HandlerFunc
+	}{
+		{"알림 추가 요청 성공", http.MethodPost, "/:todo_id/reminders", []string{"1"}, `{"offset_minutes":60}`, func(th *TodoHandler) echo.HandlerFunc { return th.AddReminder }},
+		{"알림 삭제 요청 성공", http.MethodDelete, "/:todo_id/reminders/:reminder_id", []string{"1", "3"}, "", func(th *TodoHandler) echo.HandlerFunc { return th.DeleteReminder }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
+			accessToken, err := jwtProvider.GenerateAccessToken(user)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(tc.method, "/todo", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+			if tc.body != "" {
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath(tc.path)
+			c.SetParamNames([]string{"todo_id", "reminder_id"}[:len(tc.params)]...)
+			c.SetParamValues(tc.params...)
+
+			th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+			err = jwtMiddleware(jwtProvider)(tc.handler(th))(c)
+			assert.NoError(t, err)
+			assert.Contains(t, rec.Body.String(), `"reminders":[{"id":3,"offset_minutes":60,"remind_at":"2026-10-19T08:00:00Z","sent_at":null}]`)
+		})
+	}
 }
 
 func TestDeleteTodo(t *testing.T) {
@@ -207,7 +687,8 @@ func TestDeleteTodo(t *testing.T) {
 	ts := new(mocks.TodoService)
 	deadline := time.Now().Add(24 * 3 * time.Hour)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
 		Password: "password",
 		Name:     "조호원",
 	}
@@ -218,7 +699,7 @@ func TestDeleteTodo(t *testing.T) {
 		Deadline:    &deadline,
 		IsCompleted: true,
 	}
-	ts.On("DeleteTodo", mock.AnythingOfType("int64"), mock.AnythingOfType("string")).Return(expectedResponse, nil)
+	ts.On("DeleteTodo", mock.Anything, mock.AnythingOfType("int64")).Return(expectedResponse, nil)
 
 	t.Run("Todo 삭제 요청 성공", func(t *testing.T) {
 		jwtProvider := security.NewJWTProvider(viper.GetString("jwt.secret"))
@@ -234,11 +715,8 @@ func TestDeleteTodo(t *testing.T) {
 		c.SetParamNames("todo_id")
 		c.SetParamValues("1")
 
-		th := NewTodoHandler(g, ts, jwtProvider.JwtSecret())
-		err = middleware.JWTWithConfig(middleware.JWTConfig{
-			Claims:     &security.JwtCustomClaims{},
-			SigningKey: []byte(jwtProvider.JwtSecret()),
-		})(th.DeleteTodo)(c)
+		th := NewTodoHandler(g, ts, NewAuthMiddleware(jwtProvider, new(mocks.UserService)))
+		err = jwtMiddleware(jwtProvider)(th.DeleteTodo)(c)
 		assert.NoError(t, err)
 	})
 }
@@ -2,27 +2,34 @@ package handler
 
 import (
 	"halill/dto"
-	"halill/repository"
 	"halill/security"
 	"halill/service"
 
-	"github.com/google/wire"
+	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
 )
 
-var UserSet = wire.NewSet(NewUserHandler, service.NewUserSerice, repository.NewUserRepository, security.NewJWTProvider)
-
 type UserHandler struct {
 	us service.UserService
 }
 
-func NewUserHandler(e *echo.Group, us service.UserService) *UserHandler {
+func NewUserHandler(e *echo.Group, us service.UserService, auth echo.MiddlewareFunc) *UserHandler {
 	handler := &UserHandler{
 		us: us,
 	}
 	e.POST("/login", handler.Login)
 	e.POST("/signup", handler.Register)
 	e.PUT("/login", handler.Refresh)
+	e.POST("/logout", handler.Logout, auth)
+	e.POST("/logout/all", handler.LogoutAll, auth)
+	e.PUT("/me/locale", handler.UpdateLocale, auth)
+	e.PUT("/me/password", handler.ChangePassword, auth)
+	e.POST("/password/forgot", handler.ForgotPassword)
+	e.POST("/password/reset", handler.ResetPassword)
+	e.POST("/verify-email", handler.VerifyEmail)
+	e.POST("/verify-email/resend", handler.ResendVerification)
+	e.PUT("/me/email", handler.ChangeEmail, auth)
+	e.POST("/email-change/confirm", handler.ConfirmEmailChange)
 	return handler
 }
 
@@ -33,7 +40,7 @@ func (h *UserHandler) Login(c echo.Context) error {
 		return err
 	}
 
-	token, err := h.us.LoginUser(request)
+	token, err := h.us.LoginUser(c.Request().Context(), request)
 	if err != nil {
 		return err
 	}
@@ -48,7 +55,7 @@ func (h *UserHandler) Register(c echo.Context) error {
 		return err
 	}
 
-	todo, err := h.us.RegistUser(request)
+	todo, err := h.us.RegistUser(c.Request().Context(), request)
 	if err != nil {
 		return err
 	}
@@ -63,10 +70,167 @@ func (h *UserHandler) Refresh(c echo.Context) error {
 		return err
 	}
 
-	response, err := h.us.RefreshToken(request)
+	response, err := h.us.RefreshToken(c.Request().Context(), request)
 	if err != nil {
 		return err
 	}
 
 	return c.JSON(200, response)
 }
+
+func (h *UserHandler) Logout(c echo.Context) error {
+	claims := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims)
+	request := &dto.LogoutRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	err = h.us.Logout(c.Request().Context(), claims, request)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (h *UserHandler) LogoutAll(c echo.Context) error {
+	claims := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims)
+
+	err := h.us.LogoutAll(c.Request().Context(), claims)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (h *UserHandler) UpdateLocale(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.UpdateLocaleRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.us.UpdateLocale(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, user)
+}
+
+func (h *UserHandler) ChangePassword(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.ChangePasswordRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	token, err := h.us.ChangePassword(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, token)
+}
+
+func (h *UserHandler) ForgotPassword(c echo.Context) error {
+	request := &dto.ForgotPasswordRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	err = h.us.ForgotPassword(c.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+
+	// 가입 여부와 관계없이 같은 응답
+	return c.NoContent(202)
+}
+
+func (h *UserHandler) ResetPassword(c echo.Context) error {
+	request := &dto.ResetPasswordRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	err = h.us.ResetPassword(c.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (h *UserHandler) VerifyEmail(c echo.Context) error {
+	request := &dto.VerifyEmailRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.us.VerifyEmail(c.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, user)
+}
+
+func (h *UserHandler) ResendVerification(c echo.Context) error {
+	request := &dto.ResendVerificationRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	err = h.us.ResendVerification(c.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(202)
+}
+
+func (h *UserHandler) ChangeEmail(c echo.Context) error {
+	userID := c.Get("user").(*jwt.Token).
+		Claims.(*security.JwtCustomClaims).UserID
+	request := &dto.ChangeEmailRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	err = h.us.ChangeEmail(c.Request().Context(), request, userID)
+	if err != nil {
+		return err
+	}
+
+	// 새 주소로 보낸 링크를 확인해야 바뀜
+	return c.NoContent(202)
+}
+
+func (h *UserHandler) ConfirmEmailChange(c echo.Context) error {
+	request := &dto.ConfirmEmailChangeRequest{}
+	err := c.Bind(request)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.us.ConfirmEmailChange(c.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, user)
+}
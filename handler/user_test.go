@@ -3,10 +3,14 @@ package handler
 import (
 	"bytes"
 	"encoding/json"
+	"halill/apperror"
 	"halill/dto"
+	"halill/ent"
 	"halill/mocks"
+	"halill/security"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -22,10 +26,10 @@ func TestLogin(t *testing.T) {
 		AccessToken:  "test-access-token",
 		RefreshToken: "test-refresh-token",
 	}
-	us.On("LoginUser", mock.AnythingOfType("*dto.LoginRequest")).Return(expectedResponse, nil)
+	us.On("LoginUser", mock.Anything, mock.AnythingOfType("*dto.LoginRequest")).Return(expectedResponse, nil)
 
 	t.Run("로그인 요청 성공", func(t *testing.T) {
-		uh := NewUserHandler(g, us)
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
 		loginRequest := &dto.LoginRequest{
 			Email:    "hwc9169@gmail.com",
 			Password: "password",
@@ -50,10 +54,10 @@ func TestRegist(t *testing.T) {
 		Name:  "조호원",
 		Email: "hwc9169@gmail.com",
 	}
-	us.On("RegistUser", mock.AnythingOfType("*dto.RegistRequest")).Return(expectedResponse, nil)
+	us.On("RegistUser", mock.Anything, mock.AnythingOfType("*dto.RegistRequest")).Return(expectedResponse, nil)
 
 	t.Run("회원가입 요청 성공", func(t *testing.T) {
-		uh := NewUserHandler(g, us)
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
 		registRequest := &dto.RegistRequest{
 			Email:    "hwc9169@gmail.com",
 			Password: "password",
@@ -79,10 +83,10 @@ func TestRefresh(t *testing.T) {
 		AccessToken:  "asdf.asdf.asdf",
 		RefreshToken: "asdf.asdf.asdf",
 	}
-	us.On("RefreshToken", mock.AnythingOfType("*dto.RefreshTokenRequest")).Return(expectedResponse, nil)
+	us.On("RefreshToken", mock.Anything, mock.AnythingOfType("*dto.RefreshTokenRequest")).Return(expectedResponse, nil)
 
 	t.Run("토큰 요청 성공", func(t *testing.T) {
-		uh := NewUserHandler(g, us)
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
 		registRequest := &dto.RefreshTokenRequest{
 			RefreshToken: "asdf.asdf.asdf",
 		}
@@ -97,3 +101,221 @@ func TestRefresh(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestLogout(t *testing.T) {
+	e := echo.New()
+	g := e.Group("")
+	us := new(mocks.UserService)
+	us.On("Logout", mock.Anything, mock.AnythingOfType("*security.JwtCustomClaims"), mock.AnythingOfType("*dto.LogoutRequest")).Return(nil)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+
+	t.Run("로그아웃 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		accessToken, err := security.NewJWTProvider("test_secret").GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		request := &bytes.Buffer{}
+		json.NewEncoder(request).Encode(&dto.LogoutRequest{RefreshToken: "asdf.asdf.asdf"})
+		req := httptest.NewRequest(http.MethodPost, "/logout", request)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err = jwtMiddleware(security.NewJWTProvider("test_secret"))(uh.Logout)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+}
+
+func TestLogoutAll(t *testing.T) {
+	e := echo.New()
+	g := e.Group("")
+	us := new(mocks.UserService)
+	us.On("LogoutAll", mock.Anything, mock.AnythingOfType("*security.JwtCustomClaims")).Return(nil)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+
+	t.Run("전체 로그아웃 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		accessToken, err := security.NewJWTProvider("test_secret").GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/logout/all", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err = jwtMiddleware(security.NewJWTProvider("test_secret"))(uh.LogoutAll)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	e := echo.New()
+	g := e.Group("")
+	us := new(mocks.UserService)
+	expectedResponse := &dto.TokenResponse{
+		AccessToken:  "test-access-token",
+		RefreshToken: "test-refresh-token",
+	}
+	us.On("ChangePassword", mock.Anything, mock.AnythingOfType("*dto.ChangePasswordRequest"), int64(1)).Return(expectedResponse, nil)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+
+	t.Run("비밀번호 변경 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		accessToken, err := security.NewJWTProvider("test_secret").GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		request := &bytes.Buffer{}
+		json.NewEncoder(request).Encode(&dto.ChangePasswordRequest{CurrentPassword: "password", NewPassword: "n3w-Passw0rd"})
+		req := httptest.NewRequest(http.MethodPut, "/me/password", request)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err = jwtMiddleware(security.NewJWTProvider("test_secret"))(uh.ChangePassword)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestForgotPassword(t *testing.T) {
+	e := echo.New()
+	e.Binder = NewBinder()
+	g := e.Group("")
+	us := new(mocks.UserService)
+	us.On("ForgotPassword", mock.Anything, &dto.ForgotPasswordRequest{Email: "hwc9169@gmail.com"}).Return(nil)
+
+	t.Run("재설정 메일 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		req := httptest.NewRequest(http.MethodPost, "/password/forgot", strings.NewReader(`{"email":"hwc9169@gmail.com"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := uh.ForgotPassword(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	})
+	t.Run("이메일 형식이 아니면 서비스를 호출하지 않음", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		req := httptest.NewRequest(http.MethodPost, "/password/forgot", strings.NewReader(`{"email":"hwc9169"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := uh.ForgotPassword(c)
+		assert.ErrorIs(t, err, apperror.ErrValidationFailed)
+		us.AssertNumberOfCalls(t, "ForgotPassword", 1)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	e := echo.New()
+	g := e.Group("")
+	us := new(mocks.UserService)
+	us.On("ResetPassword", mock.Anything, &dto.ResetPasswordRequest{Token: "reset-token", NewPassword: "n3w-Passw0rd"}).Return(nil)
+
+	t.Run("비밀번호 재설정 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		req := httptest.NewRequest(http.MethodPost, "/password/reset", strings.NewReader(`{"token":"reset-token","new_password":"n3w-Passw0rd"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := uh.ResetPassword(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+}
+
+func TestVerifyEmail(t *testing.T) {
+	e := echo.New()
+	g := e.Group("")
+	us := new(mocks.UserService)
+	us.On("VerifyEmail", mock.Anything, &dto.VerifyEmailRequest{Token: "signed-token"}).Return(&dto.UserResponse{Email: "hwc9169@gmail.com", EmailVerified: true}, nil)
+	us.On("ResendVerification", mock.Anything, &dto.ResendVerificationRequest{Email: "hwc9169@gmail.com"}).Return(nil)
+
+	t.Run("이메일 인증 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		req := httptest.NewRequest(http.MethodPost, "/verify-email", strings.NewReader(`{"token":"signed-token"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := uh.VerifyEmail(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"email_verified":true`)
+	})
+	t.Run("인증 메일 재전송 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		req := httptest.NewRequest(http.MethodPost, "/verify-email/resend", strings.NewReader(`{"email":"hwc9169@gmail.com"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := uh.ResendVerification(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	})
+}
+
+func TestChangeEmail(t *testing.T) {
+	e := echo.New()
+	g := e.Group("")
+	us := new(mocks.UserService)
+	us.On("ChangeEmail", mock.Anything, &dto.ChangeEmailRequest{NewEmail: "hwc9169@naver.com", Password: "password"}, int64(1)).Return(nil)
+	us.On("ConfirmEmailChange", mock.Anything, &dto.ConfirmEmailChangeRequest{Token: "signed-token"}).Return(&dto.UserResponse{ID: 1, Email: "hwc9169@naver.com", EmailVerified: true}, nil)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: "password",
+		Name:     "조호원",
+	}
+
+	t.Run("이메일 변경 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		accessToken, err := security.NewJWTProvider("test_secret").GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, "/me/email", strings.NewReader(`{"new_email":"hwc9169@naver.com","password":"password"}`))
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err = jwtMiddleware(security.NewJWTProvider("test_secret"))(uh.ChangeEmail)(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	})
+	t.Run("이메일 변경 확인 요청 성공", func(t *testing.T) {
+		uh := NewUserHandler(g, us, NewAuthMiddleware(security.NewJWTProvider("test_secret"), us))
+		req := httptest.NewRequest(http.MethodPost, "/email-change/confirm", strings.NewReader(`{"token":"signed-token"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := uh.ConfirmEmailChange(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"email":"hwc9169@naver.com"`)
+	})
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"errors"
+	"halill/apperror"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// requestValidator 는 dto 요청 타입의 validate 태그를 검사합니다.
+var requestValidator = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(fieldName)
+	v.RegisterValidation("notblank", func(fl validator.FieldLevel) bool {
+		return strings.TrimSpace(fl.Field().String()) != ""
+	})
+	v.RegisterValidation("future", func(fl validator.FieldLevel) bool {
+		t, ok := fl.Field().Interface().(time.Time)
+		return ok && t.After(time.Now())
+	})
+	return v
+}
+
+// fieldName 은 에러에 내려줄 필드 이름으로 json 또는 query 태그를 쓰고, 태그가 없으면 snake_case 로 바꿉니다.
+func fieldName(f reflect.StructField) string {
+	for _, key := range []string{"json", "query"} {
+		name := strings.SplitN(f.Tag.Get(key), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+
+	var b strings.Builder
+	for i, r := range f.Name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// validate 는 request 가 validate 태그를 모두 지키는지 확인하고, 어긴 필드를 모아 422 에러로 반환합니다.
+func validate(request interface{}) error {
+	err := requestValidator.Struct(request)
+	if err == nil {
+		return nil
+	}
+	var invalid *validator.InvalidValidationError
+	if errors.As(err, &invalid) {
+		// 구조체가 아닌 값은 검사할 규칙이 없음
+		return nil
+	}
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	fields := make([]apperror.FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		fields = append(fields, apperror.FieldError{
+			Field: fieldPath(fe.Namespace()),
+			Rule:  rule(fe),
+			Param: fe.Param(),
+		})
+	}
+	return apperror.ErrValidationFailed.WithFields(fields...)
+}
+
+// fieldPath 는 CreateTodoRequest.title 같은 namespace 에서 구조체 이름을 뺍니다.
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+// rule 은 validator 태그를 클라이언트에 내려줄 규칙 이름으로 바꿉니다.
+// min, max 는 문자열이면 글자 수, 배열이면 개수를 뜻하므로 구분합니다.
+func rule(fe validator.FieldError) string {
+	tag := fe.Tag()
+	switch tag {
+	case "required", "notblank", "email", "future", "oneof":
+		return tag
+	case "min", "gte", "max", "lte":
+		bound := "min"
+		if tag == "max" || tag == "lte" {
+			bound = "max"
+		}
+		switch fe.Kind() {
+		case reflect.String:
+			return bound + "_length"
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return bound + "_items"
+		default:
+			return bound
+		}
+	default:
+		return "invalid"
+	}
+}
+
+// Binder 는 요청을 dto 에 바인딩한 뒤 validate 태그를 검사합니다.
+// echo 의 Binder 로 등록하면 모든 핸들러의 c.Bind 가 검사를 거칩니다.
+type Binder struct {
+	echo.DefaultBinder
+}
+
+func NewBinder() *Binder {
+	return &Binder{}
+}
+
+func (b *Binder) Bind(i interface{}, c echo.Context) error {
+	if err := b.DefaultBinder.Bind(i, c); err != nil {
+		return err
+	}
+	return validate(i)
+}
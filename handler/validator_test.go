@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"halill/apperror"
+	"halill/dto"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinder(t *testing.T) {
+	bind := func(body string, request interface{}) error {
+		e := echo.New()
+		e.Binder = NewBinder()
+		req := httptest.NewRequest(http.MethodPost, "/todo", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		c := e.NewContext(req, httptest.NewRecorder())
+		return c.Bind(request)
+	}
+
+	t.Run("규칙을 지키면 통과", func(t *testing.T) {
+		deadline := time.Now().Add(time.Hour).Format(time.RFC3339)
+		err := bind(`{"title": "보고서 작성", "deadline": "`+deadline+`"}`, &dto.CreateTodoRequest{})
+		assert.NoError(t, err)
+	})
+	t.Run("어긴 필드를 모두 반환", func(t *testing.T) {
+		deadline := time.Now().Add(-time.Hour).Format(time.RFC3339)
+		body := `{"title": "   ", "content": "` + strings.Repeat("a", 10001) + `", "deadline": "` + deadline + `"}`
+
+		err := bind(body, &dto.CreateTodoRequest{})
+		assert.Equal(t, apperror.ErrValidationFailed.WithFields(
+			apperror.FieldError{Field: "title", Rule: "notblank"},
+			apperror.FieldError{Field: "content", Rule: "max_length", Param: "10000"},
+			apperror.FieldError{Field: "deadline", Rule: "future"},
+		), err)
+	})
+	t.Run("잘못된 이메일", func(t *testing.T) {
+		err := bind(`{"email": "hwc9169", "password": "password", "name": "조호원"}`, &dto.RegistRequest{})
+		assert.Equal(t, apperror.ErrValidationFailed.WithFields(
+			apperror.FieldError{Field: "email", Rule: "email"},
+		), err)
+	})
+	t.Run("배열은 개수로 검사", func(t *testing.T) {
+		err := bind(`{"tag_ids": []}`, &dto.TodoTagsRequest{})
+		assert.Equal(t, apperror.ErrValidationFailed.WithFields(
+			apperror.FieldError{Field: "tag_ids", Rule: "min_items", Param: "1"},
+		), err)
+	})
+}
+
+func TestValidatePatch(t *testing.T) {
+	title := ""
+	err := validate(&dto.PatchTodoRequest{Title: &title})
+	assert.Equal(t, apperror.ErrValidationFailed.WithFields(
+		apperror.FieldError{Field: "title", Rule: "notblank"},
+	), err)
+
+	assert.NoError(t, validate(&dto.PatchTodoRequest{}))
+}
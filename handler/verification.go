@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"fmt"
+	"halill/apperror"
+	"halill/dto"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UnverifiedAccess 는 메일 인증을 마치지 않은 사용자가 할 수 있는 일입니다.
+type UnverifiedAccess string
+
+const (
+	// UnverifiedAccessFull 은 인증한 사용자와 똑같이 허용합니다.
+	UnverifiedAccessFull UnverifiedAccess = "full"
+	// UnverifiedAccessReadOnly 는 조회만 허용합니다.
+	UnverifiedAccessReadOnly UnverifiedAccess = "read_only"
+	// UnverifiedAccessNone 은 모든 요청을 거부합니다.
+	UnverifiedAccessNone UnverifiedAccess = "none"
+)
+
+func ParseUnverifiedAccess(s string) (UnverifiedAccess, error) {
+	switch access := UnverifiedAccess(s); access {
+	case UnverifiedAccessFull, UnverifiedAccessReadOnly, UnverifiedAccessNone:
+		return access, nil
+	default:
+		return "", fmt.Errorf("unknown unverified access %q", s)
+	}
+}
+
+// NewVerifiedMiddleware 는 auth 로 사용자를 확인한 뒤, 메일 인증을 마치지 않은 사용자의 요청을 access 에 따라 제한합니다.
+// 로그아웃, 언어 설정처럼 계정 자체를 다루는 요청에는 auth 를 그대로 사용합니다.
+func NewVerifiedMiddleware(auth echo.MiddlewareFunc, access UnverifiedAccess) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return auth(func(c echo.Context) error {
+			user, _ := c.Get(accountKey).(*dto.UserResponse)
+			if user == nil || user.EmailVerified || access == UnverifiedAccessFull {
+				return next(c)
+			}
+			method := c.Request().Method
+			if access == UnverifiedAccessReadOnly && (method == http.MethodGet || method == http.MethodHead) {
+				return next(c)
+			}
+
+			return apperror.ErrEmailNotVerified
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"halill/apperror"
+	"halill/dto"
+	"halill/ent"
+	"halill/mocks"
+	"halill/security"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVerifiedMiddleware(t *testing.T) {
+	jp := security.NewJWTProvider("test_secret")
+	accessToken, err := jp.GenerateAccessToken(&ent.User{ID: 1, Email: "hwc9169@gmail.com", Name: "조호원"})
+	assert.NoError(t, err)
+	next := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+	serve := func(access UnverifiedAccess, verified bool, method string) error {
+		e := echo.New()
+		us := new(mocks.UserService)
+		us.On("VerifyAccessToken", mock.Anything, mock.AnythingOfType("*security.JwtCustomClaims")).
+			Return(&dto.UserResponse{ID: 1, Email: "hwc9169@gmail.com", EmailVerified: verified}, nil)
+
+		req := httptest.NewRequest(method, "/todo", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		c := e.NewContext(req, httptest.NewRecorder())
+		return NewVerifiedMiddleware(NewAuthMiddleware(jp, us), access)(next)(c)
+	}
+
+	t.Run("인증한 사용자는 제한 없음", func(t *testing.T) {
+		assert.NoError(t, serve(UnverifiedAccessNone, true, http.MethodPost))
+	})
+	t.Run("read_only 는 조회만 허용", func(t *testing.T) {
+		assert.NoError(t, serve(UnverifiedAccessReadOnly, false, http.MethodGet))
+		assert.Equal(t, apperror.ErrEmailNotVerified, serve(UnverifiedAccessReadOnly, false, http.MethodPost))
+	})
+	t.Run("none 은 모두 거부, full 은 모두 허용", func(t *testing.T) {
+		assert.Equal(t, apperror.ErrEmailNotVerified, serve(UnverifiedAccessNone, false, http.MethodGet))
+		assert.NoError(t, serve(UnverifiedAccessFull, false, http.MethodDelete))
+	})
+}
+
+func TestParseUnverifiedAccess(t *testing.T) {
+	t.Run("알 수 없는 값", func(t *testing.T) {
+		access, err := ParseUnverifiedAccess("read_only")
+		assert.NoError(t, err)
+		assert.Equal(t, UnverifiedAccessReadOnly, access)
+
+		_, err = ParseUnverifiedAccess("readonly")
+		assert.EqualError(t, err, `unknown unverified access "readonly"`)
+	})
+}
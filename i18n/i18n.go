@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Locale 은 응답 메시지의 언어입니다.
+type Locale string
+
+const (
+	Korean  Locale = "ko"
+	English Locale = "en"
+
+	Default = Korean
+)
+
+//go:embed locales/*.json
+var files embed.FS
+
+var (
+	supported = []Locale{Korean, English}
+	matcher   = language.NewMatcher([]language.Tag{language.Korean, language.English})
+	bundles   = loadBundles()
+)
+
+func loadBundles() map[Locale]map[string]string {
+	bundles := make(map[Locale]map[string]string, len(supported))
+	for _, locale := range supported {
+		data, err := files.ReadFile(path.Join("locales", string(locale)+".json"))
+		if err != nil {
+			panic(err)
+		}
+		messages := make(map[string]string)
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(err)
+		}
+		bundles[locale] = messages
+	}
+	return bundles
+}
+
+// Parse 는 지원하는 Locale 인지 확인합니다. 사용자가 저장한 언어 설정을 검사할 때 사용합니다.
+func Parse(s string) (Locale, bool) {
+	locale := Locale(strings.ToLower(s))
+	_, ok := bundles[locale]
+	return locale, ok
+}
+
+// Match 는 Accept-Language 헤더에서 지원하는 언어 중 가장 알맞은 것을 고릅니다.
+// 헤더가 없거나 지원하는 언어가 없으면 Default 를 반환합니다.
+func Match(acceptLanguage string) Locale {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Default
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default
+	}
+	return supported[index]
+}
+
+// Lookup 은 locale 번들에서 key 의 메시지를 찾고, 없으면 Default 번들에서 찾습니다.
+func Lookup(locale Locale, key string) (string, bool) {
+	if message, ok := bundles[locale][key]; ok {
+		return message, true
+	}
+	message, ok := bundles[Default][key]
+	return message, ok
+}
+
+// Message 는 Lookup 과 같지만 어느 번들에도 없으면 key 를 그대로 반환합니다.
+func Message(locale Locale, key string) string {
+	if message, ok := Lookup(locale, key); ok {
+		return message
+	}
+	return key
+}
+
+type contextKey struct{}
+
+func NewContext(parent context.Context, locale Locale) context.Context {
+	return context.WithValue(parent, contextKey{}, locale)
+}
+
+// FromContext 는 요청의 Locale 을 반환합니다. 정해지지 않았으면 Default 입니다.
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(contextKey{}).(Locale); ok {
+		return locale
+	}
+	return Default
+}
@@ -0,0 +1,36 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundles(t *testing.T) {
+	t.Run("모든 번들에 같은 메시지", func(t *testing.T) {
+		for _, locale := range supported {
+			for key := range bundles[Default] {
+				_, ok := bundles[locale][key]
+				assert.True(t, ok, "%s 번들에 %s 가 없습니다.", locale, key)
+			}
+			assert.Len(t, bundles[locale], len(bundles[Default]))
+		}
+	})
+	t.Run("없는 메시지는 key 그대로", func(t *testing.T) {
+		assert.Equal(t, "unknown_code", Message(English, "unknown_code"))
+	})
+}
+
+func TestMatch(t *testing.T) {
+	assert.Equal(t, English, Match("en-US,en;q=0.9,ko;q=0.8"))
+	assert.Equal(t, Korean, Match("ko-KR"))
+	assert.Equal(t, English, Match("ja-JP,en;q=0.5"))
+	assert.Equal(t, Default, Match("ja-JP"))
+	assert.Equal(t, Default, Match(""))
+}
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, Default, FromContext(context.Background()))
+	assert.Equal(t, English, FromContext(NewContext(context.Background(), English)))
+}
@@ -2,15 +2,26 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"database/sql"
 	"fmt"
 	"halill/ent"
-	"halill/ent/migrate"
+	_ "halill/ent/runtime"
 	"halill/handler"
+	"halill/migration"
+	"halill/notify"
 	"halill/repository"
+	"halill/search"
 	"halill/security"
 	"halill/service"
 	"log"
+	"net/http"
+	"os"
+	"time"
+	// alpine 이미지에는 시간대 데이터가 없으므로 반복 Todo 의 timezone 을 읽을 수 있도록 함께 빌드합니다.
+	_ "time/tzdata"
 
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -19,6 +30,39 @@ import (
 )
 
 func init() {
+	viper.SetDefault("migration.dir", "migration/sql")
+	viper.SetDefault("database.timeout", "5s")
+	viper.SetDefault("search.driver", "mysql")
+	viper.SetDefault("todo.next.priority", 1.0)
+	viper.SetDefault("todo.next.deadline", 1.0)
+	viper.SetDefault("todo.next.overdue", 1.0)
+	viper.SetDefault("todo.next.horizon", "168h")
+	viper.SetDefault("reminder.enabled", true)
+	viper.SetDefault("reminder.interval", "1m")
+	viper.SetDefault("reminder.lease", "5m")
+	viper.SetDefault("reminder.retry_delay", "1m")
+	viper.SetDefault("reminder.notifiers", []string{"log"})
+	viper.SetDefault("reminder.smtp.port", 587)
+	viper.SetDefault("trash.retention", "720h")
+	viper.SetDefault("trash.purge_interval", "1h")
+	viper.SetDefault("password.min_length", 8)
+	viper.SetDefault("password.max_bytes", 72)
+	viper.SetDefault("password.min_classes", 2)
+	viper.SetDefault("password.min_score", 2)
+	viper.SetDefault("password.reset_ttl", "30m")
+	viper.SetDefault("password.reset_url", "http://127.0.0.1/password/reset?token={token}")
+	viper.SetDefault("verification.ttl", "48h")
+	viper.SetDefault("verification.url", "http://127.0.0.1/verify-email?token={token}")
+	viper.SetDefault("verification.change_url", "http://127.0.0.1/confirm-email-change?token={token}")
+	viper.SetDefault("verification.resend_cooldown", "1m")
+	viper.SetDefault("verification.max_sends", 5)
+	viper.SetDefault("verification.resend_window", "24h")
+	viper.SetDefault("verification.required_for_login", false)
+	viper.SetDefault("verification.unverified_access", "read_only")
+	viper.SetDefault("mail.driver", "log")
+	viper.SetDefault("mail.from", "noreply@halill.com")
+	viper.SetDefault("mail.smtp.port", 587)
+	viper.SetDefault("mail.file.dir", "mail")
 	viper.SetConfigFile("config.json")
 	err := viper.ReadInConfig()
 	if err != nil {
@@ -30,43 +74,248 @@ func init() {
 	}
 }
 
-func InitializeUser(e *echo.Group, db *ent.Client, jwtSecret string) (*handler.UserHandler, error) {
+func InitializeJWTProvider() (security.JWTProvider, error) {
+	// 서명키가 없으면 기존처럼 공유 비밀키(HS256)를 사용
+	if !viper.IsSet("jwt.signing_key") {
+		return security.NewJWTProvider(viper.GetString("jwt.secret")), nil
+	}
+
+	signingKey, err := security.LoadKeyFromPEMFile(viper.GetString("jwt.signing_key.id"), viper.GetString("jwt.signing_key.path"))
+	if err != nil {
+		return nil, err
+	}
+
+	var keyConfigs []struct {
+		ID   string `mapstructure:"id"`
+		Path string `mapstructure:"path"`
+	}
+	if err := viper.UnmarshalKey("jwt.verification_keys", &keyConfigs); err != nil {
+		return nil, err
+	}
+	verificationKeys := make([]*security.Key, 0, len(keyConfigs))
+	for _, kc := range keyConfigs {
+		key, err := security.LoadKeyFromPEMFile(kc.ID, kc.Path)
+		if err != nil {
+			return nil, err
+		}
+		verificationKeys = append(verificationKeys, key)
+	}
+
+	return security.NewKeyedJWTProvider(signingKey, verificationKeys...)
+}
+
+func InitializePasswordPolicy() (security.PasswordPolicy, error) {
+	var breached security.BreachedPasswords
+	if path := viper.GetString("password.breached_list"); path != "" {
+		var err error
+		breached, err = security.LoadBreachedPasswordsFromFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return security.NewPasswordPolicy(security.PasswordPolicyConfig{
+		MinLength:  viper.GetInt("password.min_length"),
+		MaxBytes:   viper.GetInt("password.max_bytes"),
+		MinClasses: viper.GetInt("password.min_classes"),
+		MinScore:   viper.GetInt("password.min_score"),
+	}, breached), nil
+}
+
+func InitializeMailer() (notify.Mailer, error) {
+	switch driver := viper.GetString("mail.driver"); driver {
+	case "log":
+		return notify.NewLogMailer(log.Default()), nil
+	case "file":
+		return notify.NewFileMailer(viper.GetString("mail.file.dir"), viper.GetString("mail.from")), nil
+	case "smtp":
+		var config notify.SMTPConfig
+		if err := viper.UnmarshalKey("mail.smtp", &config); err != nil {
+			return nil, err
+		}
+		config.From = viper.GetString("mail.from")
+		return notify.NewSMTPMailer(config), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", driver)
+	}
+}
+
+func InitializeTokenSigner() (security.TokenSigner, error) {
+	if secret := viper.GetString("verification.secret"); secret != "" {
+		return security.NewTokenSigner([]byte(secret)), nil
+	}
+
+	// 임의의 키는 재시작하거나 서버가 여러 대이면 이미 보낸 인증 링크를 검증할 수 없으므로 debug 에서만 허용
+	if !viper.GetBool("debug") {
+		return nil, fmt.Errorf("verification.secret must be set")
+	}
+	log.Println("WARNING: verification.secret is not set, using a random key. Links sent before a restart will not work")
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return security.NewTokenSigner(key), nil
+}
+
+func InitializeUserService(db *ent.Client, jwtProvider security.JWTProvider, passwordPolicy security.PasswordPolicy, mailer notify.Mailer, tokenSigner security.TokenSigner) service.UserService {
 	userRepository := repository.NewUserRepository(db)
-	jwtProvider := security.NewJWTProvider(jwtSecret)
-	userService := service.NewUserSerice(userRepository, jwtProvider)
-	userHandler := handler.NewUserHandler(e, userService)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db)
+	revokedTokenRepository := repository.NewRevokedTokenRepository(db)
+	passwordResetTokenRepository := repository.NewPasswordResetTokenRepository(db)
+	resetConfig := service.PasswordResetConfig{
+		TTL: viper.GetDuration("password.reset_ttl"),
+		URL: viper.GetString("password.reset_url"),
+	}
+	verificationConfig := service.EmailVerificationConfig{
+		TTL:              viper.GetDuration("verification.ttl"),
+		URL:              viper.GetString("verification.url"),
+		ChangeURL:        viper.GetString("verification.change_url"),
+		ResendCooldown:   viper.GetDuration("verification.resend_cooldown"),
+		MaxSends:         viper.GetInt("verification.max_sends"),
+		ResendWindow:     viper.GetDuration("verification.resend_window"),
+		RequiredForLogin: viper.GetBool("verification.required_for_login"),
+	}
+	return service.NewUserService(userRepository, refreshTokenRepository, revokedTokenRepository, jwtProvider, passwordPolicy, passwordResetTokenRepository, mailer, resetConfig, tokenSigner, verificationConfig)
+}
+
+func InitializeUser(e *echo.Group, userService service.UserService, auth echo.MiddlewareFunc) (*handler.UserHandler, error) {
+	userHandler := handler.NewUserHandler(e, userService, auth)
 	return userHandler, nil
 }
 
-func InitializeTodo(e *echo.Group, db *ent.Client, jwtSecret string) (*handler.TodoHandler, error) {
+func InitializeTodoIndex(sqlDB *sql.DB, db *ent.Client) (search.TodoIndex, error) {
+	switch driver := viper.GetString("search.driver"); driver {
+	case "mysql":
+		return search.NewMySQLIndex(sqlDB, db), nil
+	case "memory":
+		return search.NewMemoryIndex(db), nil
+	default:
+		return nil, fmt.Errorf("unknown search driver %q", driver)
+	}
+}
+
+func InitializeNotifier() (notify.Notifier, error) {
+	notifiers := make([]notify.Notifier, 0)
+	for _, name := range viper.GetStringSlice("reminder.notifiers") {
+		switch name {
+		case "log":
+			notifiers = append(notifiers, notify.NewLogNotifier(log.Default()))
+		case "smtp":
+			var config notify.SMTPConfig
+			if err := viper.UnmarshalKey("reminder.smtp", &config); err != nil {
+				return nil, err
+			}
+			notifiers = append(notifiers, notify.NewSMTPNotifier(config))
+		case "webhook":
+			notifiers = append(notifiers, notify.NewWebhookNotifier(viper.GetString("reminder.webhook.url"), &http.Client{Timeout: 10 * time.Second}))
+		default:
+			return nil, fmt.Errorf("unknown notifier %q", name)
+		}
+	}
+
+	return notify.Multi(notifiers...), nil
+}
+
+func InitializeReminderScheduler(db *ent.Client, notifier notify.Notifier) service.ReminderScheduler {
+	hostname, _ := os.Hostname()
+	reminderRepository := repository.NewReminderRepository(db)
+	return service.NewReminderScheduler(reminderRepository, notifier, service.ReminderSchedulerConfig{
+		Owner:      fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		Interval:   viper.GetDuration("reminder.interval"),
+		Lease:      viper.GetDuration("reminder.lease"),
+		RetryDelay: viper.GetDuration("reminder.retry_delay"),
+	})
+}
+
+func InitializeTrashPurger(db *ent.Client) service.TrashPurger {
 	todoRepository := repository.NewTodoRepository(db)
-	todoService := service.NewTodoService(todoRepository)
-	todoHandler := handler.NewTodoHandler(e, todoService, jwtSecret)
+	return service.NewTrashPurger(todoRepository, service.TrashPurgerConfig{
+		Retention: viper.GetDuration("trash.retention"),
+		Interval:  viper.GetDuration("trash.purge_interval"),
+	})
+}
+
+func InitializeTodo(e *echo.Group, db *ent.Client, todoIndex search.TodoIndex, auth echo.MiddlewareFunc) (*handler.TodoHandler, error) {
+	todoRepository := repository.NewTodoRepository(db)
+	tagRepository := repository.NewTagRepository(db)
+	projectRepository := repository.NewProjectRepository(db)
+	checklistItemRepository := repository.NewChecklistItemRepository(db)
+	reminderRepository := repository.NewReminderRepository(db)
+	scoreWeights := service.TodoScoreWeights{
+		Priority: viper.GetFloat64("todo.next.priority"),
+		Deadline: viper.GetFloat64("todo.next.deadline"),
+		Overdue:  viper.GetFloat64("todo.next.overdue"),
+		Horizon:  viper.GetDuration("todo.next.horizon"),
+	}
+	todoService := service.NewTodoService(todoRepository, tagRepository, projectRepository, checklistItemRepository, reminderRepository, todoIndex, scoreWeights)
+	todoHandler := handler.NewTodoHandler(e, todoService, auth)
 	return todoHandler, nil
 }
 
-func main() {
+func InitializeTag(e *echo.Group, db *ent.Client, auth echo.MiddlewareFunc) (*handler.TagHandler, error) {
+	tagRepository := repository.NewTagRepository(db)
+	tagService := service.NewTagService(tagRepository)
+	tagHandler := handler.NewTagHandler(e, tagService, auth)
+	return tagHandler, nil
+}
+
+func InitializeProject(e *echo.Group, db *ent.Client, auth echo.MiddlewareFunc) (*handler.ProjectHandler, error) {
+	projectRepository := repository.NewProjectRepository(db)
+	projectService := service.NewProjectService(projectRepository)
+	projectHandler := handler.NewProjectHandler(e, projectService, auth)
+	return projectHandler, nil
+}
+
+func openDB() (*sql.DB, *ent.Client, error) {
 	dbDriver := viper.GetString("database.driver")
 	dbHost := viper.GetString("database.host")
 	// dbPort := viper.GetString("database.port")
 	dbUser := viper.GetString("database.user")
 	dbPass := viper.GetString("database.pass")
 	dbName := viper.GetString("database.name")
-	secret := viper.GetString("jwt.secret")
 
-	connection := fmt.Sprintf("%s:%s@tcp(%s:3306)/%s", dbUser, dbPass, dbHost, dbName)
-	client, err := ent.Open(dbDriver, connection)
+	connection := fmt.Sprintf("%s:%s@tcp(%s:3306)/%s?parseTime=True", dbUser, dbPass, dbHost, dbName)
+	db, err := sql.Open(dbDriver, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dbDriver, db)))
+	return db, client, nil
+}
+
+func main() {
+	db, client, err := openDB()
 	if err != nil {
 		log.Fatal(errors.WithStack(err))
 	}
 	defer client.Close()
 
-	if err = client.Schema.Create(
-		context.TODO(),
-		migrate.WithDropColumn(true),
-		migrate.WithDropIndex(true),
-	); err != nil {
-		log.Fatalf("failed createing schema resources: %v", err)
+	migrator, err := migration.NewMigrator(db, migration.Files())
+	if err != nil {
+		log.Fatal(errors.WithStack(err))
+	}
+
+	if len(os.Args) > 1 {
+		if os.Args[1] != "migrate" {
+			log.Fatalf("unknown command %q", os.Args[1])
+		}
+		if err := runMigrate(context.Background(), migrator, client, os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	pending, err := migrator.Pending(context.Background())
+	if err != nil {
+		log.Fatal(errors.WithStack(err))
+	}
+	if len(pending) > 0 {
+		log.Fatalf("database schema is behind by %d migration(s), run `migrate up` first", len(pending))
+	}
+
+	jwtProvider, err := InitializeJWTProvider()
+	if err != nil {
+		log.Fatal(errors.WithStack(err))
 	}
 
 	e := echo.New()
@@ -75,19 +324,135 @@ func main() {
 		AllowMethods: []string{"*"},
 	}))
 	e.Use(middleware.Logger())
+	e.HTTPErrorHandler = handler.ErrorHandler
+	e.Binder = handler.NewBinder()
 	e.Use(middleware.Recover())
+	e.Use(handler.NewLocaleMiddleware())
+	e.Use(handler.NewTimeoutMiddleware(viper.GetDuration("database.timeout")))
+
+	passwordPolicy, err := InitializePasswordPolicy()
+	if err != nil {
+		log.Fatal(errors.WithStack(err))
+	}
+	mailer, err := InitializeMailer()
+	if err != nil {
+		log.Fatal(errors.WithStack(err))
+	}
+	tokenSigner, err := InitializeTokenSigner()
+	if err != nil {
+		log.Fatal(errors.WithStack(err))
+	}
+	userService := InitializeUserService(client, jwtProvider, passwordPolicy, mailer, tokenSigner)
+	auth := handler.NewAuthMiddleware(jwtProvider, userService)
+	unverifiedAccess, err := handler.ParseUnverifiedAccess(viper.GetString("verification.unverified_access"))
+	if err != nil {
+		log.Fatal(errors.WithStack(err))
+	}
+	verified := handler.NewVerifiedMiddleware(auth, unverifiedAccess)
+
+	handler.NewJWKSHandler(e.Group(""), jwtProvider)
 
 	user := e.Group("")
-	_, err = InitializeUser(user, client, secret)
+	_, err = InitializeUser(user, userService, auth)
 	if err != nil {
 		e.Logger.Fatal(err)
 	}
 
+	todoIndex, err := InitializeTodoIndex(db, client)
+	if err != nil {
+		log.Fatal(errors.WithStack(err))
+	}
+
 	todo := e.Group("/todo")
-	_, err = InitializeTodo(todo, client, secret)
+	_, err = InitializeTodo(todo, client, todoIndex, verified)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	tag := e.Group("/tag")
+	_, err = InitializeTag(tag, client, verified)
 	if err != nil {
 		e.Logger.Fatal(err)
 	}
 
+	project := e.Group("/project")
+	_, err = InitializeProject(project, client, verified)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if viper.GetBool("reminder.enabled") {
+		notifier, err := InitializeNotifier()
+		if err != nil {
+			log.Fatal(errors.WithStack(err))
+		}
+		go InitializeReminderScheduler(client, notifier).Run(ctx)
+	}
+	go InitializeTrashPurger(client).Run(ctx)
+
 	e.Logger.Fatal(e.Start(":5000"))
 }
+
+func runMigrate(ctx context.Context, migrator *migration.Migrator, client *ent.Client, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up|down|status|diff <name>")
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range applied {
+			log.Printf("applied %d_%s", m.Version, m.Name)
+		}
+		log.Printf("%d migration(s) applied", len(applied))
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+		if reverted == nil {
+			log.Println("no migration to revert")
+			return nil
+		}
+		log.Printf("reverted %d_%s", reverted.Version, reverted.Name)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%d_%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+	case "diff":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: migrate diff <name>")
+		}
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("database is behind by %d migration(s), run `migrate up` before diffing", len(pending))
+		}
+		files, err := migration.Generate(ctx, client.Schema, viper.GetString("migration.dir"), args[1])
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			log.Printf("created %s", f)
+		}
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package migration
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// Files 는 바이너리에 포함된 마이그레이션 스크립트입니다.
+func Files() fs.FS {
+	sub, err := fs.Sub(files, "sql")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,80 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"halill/ent/migrate"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// unmanagedIndexes 는 ent/schema 로 선언할 수 없어 SQL 마이그레이션으로만 관리하는 인덱스입니다.
+// ent v0.9 는 FULLTEXT 인덱스를 표현하지 못하므로, 제외하지 않으면 diff 때마다 삭제 문장이 생성됩니다.
+var unmanagedIndexes = []string{
+	"todo_title_content", // 20261018000300_todo_fulltext: 한국어 검색용 FULLTEXT(ngram)
+}
+
+// dropsUnmanagedIndex 는 statement 가 unmanagedIndexes 중 하나를 삭제하는 문장인지 확인합니다.
+func dropsUnmanagedIndex(statement string) bool {
+	if !strings.HasPrefix(strings.ToUpper(statement), "DROP INDEX") {
+		return false
+	}
+	for _, name := range unmanagedIndexes {
+		if strings.Contains(statement, "`"+name+"`") {
+			return true
+		}
+	}
+	return false
+}
+
+// Generate 는 현재 데이터베이스와 ent/schema 의 차이를 up 스크립트로 작성합니다.
+// down 스크립트는 자동으로 만들 수 없으므로 리뷰어가 채워야 할 빈 파일로 생성됩니다.
+// 데이터베이스는 기존 마이그레이션이 모두 적용된 상태여야 합니다.
+func Generate(ctx context.Context, schema *migrate.Schema, dir, name string) ([]string, error) {
+	if !namePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid migration name %q: use lower_snake_case", name)
+	}
+
+	var diff bytes.Buffer
+	err := schema.WriteTo(ctx, &diff,
+		migrate.WithDropColumn(true),
+		migrate.WithDropIndex(true),
+		migrate.WithForeignKeys(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	statements := make([]string, 0)
+	for _, line := range strings.Split(diff.String(), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line == "BEGIN;" || line == "COMMIT;" || dropsUnmanagedIndex(line) {
+			continue
+		}
+		statements = append(statements, line)
+	}
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("no schema changes detected")
+	}
+
+	version := time.Now().UTC().Format("20060102150405")
+	upPath := filepath.Join(dir, fmt.Sprintf("%s_%s.up.sql", version, name))
+	downPath := filepath.Join(dir, fmt.Sprintf("%s_%s.down.sql", version, name))
+
+	up := "-- generated from ent/schema, review before committing\n" + strings.Join(statements, "\n") + "\n"
+	if err := os.WriteFile(upPath, []byte(up), 0644); err != nil {
+		return nil, err
+	}
+	down := "-- TODO: revert the statements in " + filepath.Base(upPath) + "\n"
+	if err := os.WriteFile(downPath, []byte(down), 0644); err != nil {
+		return nil, err
+	}
+
+	return []string{upPath, downPath}, nil
+}
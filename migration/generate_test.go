@@ -0,0 +1,23 @@
+package migration
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDropsUnmanagedIndex(t *testing.T) {
+	dropStatement := func(index string) string {
+		query, _ := (&schema.Index{Name: index}).DropBuilder("todos").Query()
+		return query + ";"
+	}
+
+	t.Run("SQL 마이그레이션으로만 관리하는 인덱스 삭제는 제외", func(t *testing.T) {
+		assert.True(t, dropsUnmanagedIndex(dropStatement("todo_title_content")))
+	})
+	t.Run("다른 인덱스 삭제는 유지", func(t *testing.T) {
+		assert.False(t, dropsUnmanagedIndex(dropStatement("todo_deleted_at")))
+		assert.False(t, dropsUnmanagedIndex("ALTER TABLE `todos` ADD COLUMN `todo_title_content` varchar(255) NOT NULL;"))
+	})
+}
@@ -0,0 +1,139 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/fstest"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	return db
+}
+
+var testFiles = fstest.MapFS{
+	"20211220000000_init.up.sql": {Data: []byte(`
+-- 첫 번째 마이그레이션
+CREATE TABLE users (email VARCHAR(255) NOT NULL PRIMARY KEY);
+CREATE TABLE todos (id INTEGER NOT NULL PRIMARY KEY, title VARCHAR(255) NOT NULL);
+`)},
+	"20211220000000_init.down.sql": {Data: []byte(`
+DROP TABLE todos;
+DROP TABLE users;
+`)},
+	"20211221000000_todo_content.up.sql": {Data: []byte(`
+ALTER TABLE todos ADD COLUMN content TEXT NULL;
+`)},
+	"20211221000000_todo_content.down.sql": {Data: []byte(`
+ALTER TABLE todos DROP COLUMN content;
+`)},
+	"README.md": {Data: []byte("마이그레이션 파일이 아님")},
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("버전 순으로 로드 성공", func(t *testing.T) {
+		migrations, err := Load(testFiles)
+		assert.NoError(t, err)
+		assert.Len(t, migrations, 2)
+		assert.Equal(t, int64(20211220000000), migrations[0].Version)
+		assert.Equal(t, "init", migrations[0].Name)
+		assert.Equal(t, "todo_content", migrations[1].Name)
+	})
+	t.Run("up 스크립트가 없으면 실패", func(t *testing.T) {
+		_, err := Load(fstest.MapFS{
+			"20211220000000_init.down.sql": {Data: []byte("DROP TABLE users;")},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestMigratorUp(t *testing.T) {
+	db := newTestDB(t)
+	m, err := NewMigrator(db, testFiles)
+	assert.NoError(t, err)
+
+	t.Run("모든 마이그레이션 적용 성공", func(t *testing.T) {
+		applied, err := m.Up(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, applied, 2)
+
+		_, err = db.Exec("INSERT INTO todos (id, title, content) VALUES (1, 'Go 언어 공부하기', '장재휴의 Go 웹 프로그래밍 철저 입문')")
+		assert.NoError(t, err)
+
+		pending, err := m.Pending(context.Background())
+		assert.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+	t.Run("이미 적용된 마이그레이션은 다시 적용하지 않음", func(t *testing.T) {
+		applied, err := m.Up(context.Background())
+		assert.NoError(t, err)
+		assert.Empty(t, applied)
+	})
+}
+
+func TestMigratorDown(t *testing.T) {
+	db := newTestDB(t)
+	m, err := NewMigrator(db, testFiles)
+	assert.NoError(t, err)
+	_, err = m.Up(context.Background())
+	assert.NoError(t, err)
+
+	t.Run("마지막 마이그레이션 롤백 성공", func(t *testing.T) {
+		reverted, err := m.Down(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "todo_content", reverted.Name)
+
+		statuses, err := m.Status(context.Background())
+		assert.NoError(t, err)
+		assert.NotNil(t, statuses[0].AppliedAt)
+		assert.Nil(t, statuses[1].AppliedAt)
+
+		_, err = db.Exec("INSERT INTO todos (id, title, content) VALUES (1, 'Go 언어 공부하기', '장재휴의 Go 웹 프로그래밍 철저 입문')")
+		assert.Error(t, err)
+	})
+	t.Run("적용된 마이그레이션이 없으면 nil", func(t *testing.T) {
+		_, err := m.Down(context.Background())
+		assert.NoError(t, err)
+		reverted, err := m.Down(context.Background())
+		assert.NoError(t, err)
+		assert.Nil(t, reverted)
+	})
+}
+
+func TestMigratorUpFailure(t *testing.T) {
+	db := newTestDB(t)
+	m, err := NewMigrator(db, fstest.MapFS{
+		"20211220000000_init.up.sql":   {Data: []byte("CREATE TABLE users (email VARCHAR(255) NOT NULL PRIMARY KEY);")},
+		"20211221000000_broken.up.sql": {Data: []byte("CREATE TABLE;")},
+	})
+	assert.NoError(t, err)
+
+	t.Run("실패한 마이그레이션은 기록되지 않음", func(t *testing.T) {
+		_, err := m.Up(context.Background())
+		assert.Error(t, err)
+
+		pending, err := m.Pending(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, pending, 1)
+		assert.Equal(t, "broken", pending[0].Name)
+	})
+}
+
+func TestEmbeddedFiles(t *testing.T) {
+	t.Run("내장된 마이그레이션 로드 성공", func(t *testing.T) {
+		migrations, err := Load(Files())
+		assert.NoError(t, err)
+		assert.NotEmpty(t, migrations)
+		for _, m := range migrations {
+			assert.NotEmpty(t, splitStatements(m.Down), "%d_%s has no down script", m.Version, m.Name)
+		}
+	})
+}
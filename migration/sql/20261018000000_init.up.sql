@@ -0,0 +1,18 @@
+-- 자동 마이그레이션으로 이미 생성된 데이터베이스에서도 안전하도록 IF NOT EXISTS 를 사용합니다.
+CREATE TABLE IF NOT EXISTS `users` (
+    `email` varchar(255) NOT NULL,
+    `password` varchar(255) NOT NULL,
+    `name` varchar(255) NOT NULL,
+    PRIMARY KEY (`email`)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+
+CREATE TABLE IF NOT EXISTS `todos` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `title` varchar(255) NOT NULL,
+    `content` longtext NOT NULL,
+    `deadline` timestamp NULL,
+    `is_completed` bool NOT NULL,
+    `user_todos` varchar(255) NULL,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `todos_users_todos` FOREIGN KEY (`user_todos`) REFERENCES `users` (`email`) ON DELETE SET NULL
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
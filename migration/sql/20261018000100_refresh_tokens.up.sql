@@ -0,0 +1,12 @@
+CREATE TABLE `refresh_tokens` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `family_id` varchar(255) NOT NULL,
+    `token_hash` varchar(255) NOT NULL,
+    `expires_at` timestamp NOT NULL,
+    `revoked` bool NOT NULL DEFAULT false,
+    `user_refresh_tokens` varchar(255) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `token_hash` (`token_hash`),
+    INDEX `refreshtoken_family_id` (`family_id`),
+    CONSTRAINT `refresh_tokens_users_refresh_tokens` FOREIGN KEY (`user_refresh_tokens`) REFERENCES `users` (`email`) ON DELETE SET NULL
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,9 @@
+ALTER TABLE `users` ADD COLUMN `tokens_valid_after` timestamp NULL;
+
+CREATE TABLE `revoked_tokens` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `jti` varchar(255) NOT NULL,
+    `expires_at` timestamp NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `jti` (`jti`)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1 @@
+ALTER TABLE `todos` DROP INDEX `todo_title_content`;
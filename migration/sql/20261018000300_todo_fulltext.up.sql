@@ -0,0 +1,2 @@
+-- 한국어 검색을 위해 ngram parser 를 사용합니다. 토큰 길이는 서버의 ngram_token_size(기본 2)를 따릅니다.
+ALTER TABLE `todos` ADD FULLTEXT INDEX `todo_title_content` (`title`, `content`) WITH PARSER ngram;
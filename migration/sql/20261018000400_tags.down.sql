@@ -0,0 +1,3 @@
+DROP TABLE `tag_todos`;
+
+DROP TABLE `tags`;
@@ -0,0 +1,16 @@
+CREATE TABLE `tags` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `name` varchar(255) NOT NULL,
+    `user_tags` varchar(255) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `tag_name_user_tags` (`name`, `user_tags`),
+    CONSTRAINT `tags_users_tags` FOREIGN KEY (`user_tags`) REFERENCES `users` (`email`) ON DELETE SET NULL
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+
+CREATE TABLE `tag_todos` (
+    `tag_id` bigint NOT NULL,
+    `todo_id` bigint NOT NULL,
+    PRIMARY KEY (`tag_id`, `todo_id`),
+    CONSTRAINT `tag_todos_tag_id` FOREIGN KEY (`tag_id`) REFERENCES `tags` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `tag_todos_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todos` (`id`) ON DELETE CASCADE
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
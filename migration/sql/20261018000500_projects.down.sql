@@ -0,0 +1,4 @@
+ALTER TABLE `todos` DROP FOREIGN KEY `todos_projects_todos`;
+ALTER TABLE `todos` DROP COLUMN `project_todos`;
+
+DROP TABLE `projects`;
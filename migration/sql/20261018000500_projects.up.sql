@@ -0,0 +1,14 @@
+CREATE TABLE `projects` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `name` varchar(255) NOT NULL,
+    `color` varchar(255) NOT NULL DEFAULT '',
+    `position` bigint NOT NULL DEFAULT 0,
+    `archived` bool NOT NULL DEFAULT false,
+    `user_projects` varchar(255) NULL,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `projects_users_projects` FOREIGN KEY (`user_projects`) REFERENCES `users` (`email`) ON DELETE SET NULL
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+
+-- 프로젝트가 없는 Todo 는 Inbox 에 있는 것으로 봅니다.
+ALTER TABLE `todos` ADD COLUMN `project_todos` bigint NULL;
+ALTER TABLE `todos` ADD CONSTRAINT `todos_projects_todos` FOREIGN KEY (`project_todos`) REFERENCES `projects` (`id`) ON DELETE SET NULL;
@@ -0,0 +1,11 @@
+ALTER TABLE `todos` ADD COLUMN `auto_complete` bool NOT NULL DEFAULT false;
+
+CREATE TABLE `checklist_items` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `title` varchar(255) NOT NULL,
+    `is_checked` bool NOT NULL DEFAULT false,
+    `position` bigint NOT NULL DEFAULT 0,
+    `todo_checklist_items` bigint NULL,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `checklist_items_todos_checklist_items` FOREIGN KEY (`todo_checklist_items`) REFERENCES `todos` (`id`) ON DELETE CASCADE
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
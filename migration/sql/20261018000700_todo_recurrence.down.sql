@@ -0,0 +1,5 @@
+ALTER TABLE `todos` DROP FOREIGN KEY `todos_todos_occurrences`;
+ALTER TABLE `todos` DROP COLUMN `todo_occurrences`;
+
+ALTER TABLE `todos` DROP COLUMN `occurrence`;
+ALTER TABLE `todos` DROP COLUMN `recurrence`;
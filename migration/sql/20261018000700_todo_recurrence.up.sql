@@ -0,0 +1,6 @@
+ALTER TABLE `todos` ADD COLUMN `recurrence` varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE `todos` ADD COLUMN `occurrence` bigint NOT NULL DEFAULT 1;
+
+-- 반복 Todo 가 완료되어 생성된 다음 Todo 는 첫 번째 Todo(origin)를 가리킵니다.
+ALTER TABLE `todos` ADD COLUMN `todo_occurrences` bigint NULL;
+ALTER TABLE `todos` ADD CONSTRAINT `todos_todos_occurrences` FOREIGN KEY (`todo_occurrences`) REFERENCES `todos` (`id`) ON DELETE SET NULL;
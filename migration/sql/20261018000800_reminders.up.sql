@@ -0,0 +1,15 @@
+-- locked_until/locked_by 는 여러 서버 인스턴스 중 하나만 알림을 보내도록 조건부 UPDATE 로 잡는 임대(lease)입니다.
+CREATE TABLE `reminders` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `offset_minutes` bigint NOT NULL,
+    `remind_at` timestamp NULL,
+    `sent_at` timestamp NULL,
+    `locked_until` timestamp NULL,
+    `locked_by` varchar(255) NOT NULL DEFAULT '',
+    `attempts` bigint NOT NULL DEFAULT 0,
+    `last_error` varchar(255) NOT NULL DEFAULT '',
+    `todo_reminders` bigint NULL,
+    PRIMARY KEY (`id`),
+    INDEX `reminder_sent_at_remind_at` (`sent_at`, `remind_at`),
+    CONSTRAINT `reminders_todos_reminders` FOREIGN KEY (`todo_reminders`) REFERENCES `todos` (`id`) ON DELETE CASCADE
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
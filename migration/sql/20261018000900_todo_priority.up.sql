@@ -0,0 +1 @@
+ALTER TABLE `todos` ADD COLUMN `priority` enum('none', 'low', 'medium', 'high', 'urgent') NOT NULL DEFAULT 'none';
@@ -0,0 +1,3 @@
+-- deleted_at 이 있는 Todo 는 휴지통에 있는 것으로, 보존 기간이 지나면 완전히 삭제됩니다.
+ALTER TABLE `todos` ADD COLUMN `deleted_at` timestamp NULL;
+CREATE INDEX `todo_deleted_at` ON `todos` (`deleted_at`);
@@ -0,0 +1,2 @@
+ALTER TABLE `todos` DROP COLUMN `created_at`, DROP COLUMN `updated_at`, DROP COLUMN `completed_at`;
+ALTER TABLE `users` DROP COLUMN `created_at`, DROP COLUMN `updated_at`;
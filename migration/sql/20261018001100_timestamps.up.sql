@@ -0,0 +1,10 @@
+-- 기존 행은 언제 만들어지고 완료되었는지 알 수 없으므로 마이그레이션 시각으로 채웁니다.
+-- 완료된 Todo 에는 항상 completed_at 이 있도록 완료된 기존 Todo 도 같은 시각으로 채웁니다.
+ALTER TABLE `users` ADD COLUMN `created_at` timestamp NULL, ADD COLUMN `updated_at` timestamp NULL;
+UPDATE `users` SET `created_at` = CURRENT_TIMESTAMP, `updated_at` = CURRENT_TIMESTAMP;
+ALTER TABLE `users` MODIFY COLUMN `created_at` timestamp NOT NULL, MODIFY COLUMN `updated_at` timestamp NOT NULL;
+
+ALTER TABLE `todos` ADD COLUMN `created_at` timestamp NULL, ADD COLUMN `updated_at` timestamp NULL, ADD COLUMN `completed_at` timestamp NULL;
+UPDATE `todos` SET `created_at` = CURRENT_TIMESTAMP, `updated_at` = CURRENT_TIMESTAMP;
+UPDATE `todos` SET `completed_at` = CURRENT_TIMESTAMP WHERE `is_completed` = true;
+ALTER TABLE `todos` MODIFY COLUMN `created_at` timestamp NOT NULL, MODIFY COLUMN `updated_at` timestamp NOT NULL;
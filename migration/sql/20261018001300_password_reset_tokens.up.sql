@@ -0,0 +1,10 @@
+CREATE TABLE `password_reset_tokens` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `token_hash` varchar(255) NOT NULL,
+    `expires_at` timestamp NOT NULL,
+    `used_at` timestamp NULL,
+    `user_password_reset_tokens` varchar(255) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `token_hash` (`token_hash`),
+    CONSTRAINT `password_reset_tokens_users_password_reset_tokens` FOREIGN KEY (`user_password_reset_tokens`) REFERENCES `users` (`email`) ON DELETE SET NULL
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
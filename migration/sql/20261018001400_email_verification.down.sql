@@ -0,0 +1 @@
+ALTER TABLE `users` DROP COLUMN `email_verified_at`, DROP COLUMN `verification_sent_at`, DROP COLUMN `verification_sends`;
@@ -0,0 +1,3 @@
+ALTER TABLE `users` ADD COLUMN `email_verified_at` timestamp NULL, ADD COLUMN `verification_sent_at` timestamp NULL, ADD COLUMN `verification_sends` bigint NOT NULL DEFAULT 0;
+-- 인증 기능 이전에 가입한 사용자는 인증을 마친 것으로 봄
+UPDATE `users` SET `email_verified_at` = `created_at`;
@@ -0,0 +1,21 @@
+ALTER TABLE `todos` DROP FOREIGN KEY `todos_users_todos`;
+ALTER TABLE `refresh_tokens` DROP FOREIGN KEY `refresh_tokens_users_refresh_tokens`;
+ALTER TABLE `tags` DROP FOREIGN KEY `tags_users_tags`;
+ALTER TABLE `projects` DROP FOREIGN KEY `projects_users_projects`;
+ALTER TABLE `password_reset_tokens` DROP FOREIGN KEY `password_reset_tokens_users_password_reset_tokens`;
+ALTER TABLE `todos` MODIFY COLUMN `user_todos` varchar(255) NULL;
+UPDATE `todos` SET `user_todos` = (SELECT `email` FROM `users` WHERE CAST(`users`.`id` AS CHAR) = `todos`.`user_todos`) WHERE `user_todos` IS NOT NULL;
+ALTER TABLE `refresh_tokens` MODIFY COLUMN `user_refresh_tokens` varchar(255) NULL;
+UPDATE `refresh_tokens` SET `user_refresh_tokens` = (SELECT `email` FROM `users` WHERE CAST(`users`.`id` AS CHAR) = `refresh_tokens`.`user_refresh_tokens`) WHERE `user_refresh_tokens` IS NOT NULL;
+ALTER TABLE `tags` MODIFY COLUMN `user_tags` varchar(255) NULL;
+UPDATE `tags` SET `user_tags` = (SELECT `email` FROM `users` WHERE CAST(`users`.`id` AS CHAR) = `tags`.`user_tags`) WHERE `user_tags` IS NOT NULL;
+ALTER TABLE `projects` MODIFY COLUMN `user_projects` varchar(255) NULL;
+UPDATE `projects` SET `user_projects` = (SELECT `email` FROM `users` WHERE CAST(`users`.`id` AS CHAR) = `projects`.`user_projects`) WHERE `user_projects` IS NOT NULL;
+ALTER TABLE `password_reset_tokens` MODIFY COLUMN `user_password_reset_tokens` varchar(255) NULL;
+UPDATE `password_reset_tokens` SET `user_password_reset_tokens` = (SELECT `email` FROM `users` WHERE CAST(`users`.`id` AS CHAR) = `password_reset_tokens`.`user_password_reset_tokens`) WHERE `user_password_reset_tokens` IS NOT NULL;
+ALTER TABLE `users` DROP PRIMARY KEY, DROP COLUMN `id`, DROP INDEX `email`, ADD PRIMARY KEY (`email`);
+ALTER TABLE `todos` ADD CONSTRAINT `todos_users_todos` FOREIGN KEY (`user_todos`) REFERENCES `users` (`email`) ON DELETE SET NULL;
+ALTER TABLE `refresh_tokens` ADD CONSTRAINT `refresh_tokens_users_refresh_tokens` FOREIGN KEY (`user_refresh_tokens`) REFERENCES `users` (`email`) ON DELETE SET NULL;
+ALTER TABLE `tags` ADD CONSTRAINT `tags_users_tags` FOREIGN KEY (`user_tags`) REFERENCES `users` (`email`) ON DELETE SET NULL;
+ALTER TABLE `projects` ADD CONSTRAINT `projects_users_projects` FOREIGN KEY (`user_projects`) REFERENCES `users` (`email`) ON DELETE SET NULL;
+ALTER TABLE `password_reset_tokens` ADD CONSTRAINT `password_reset_tokens_users_password_reset_tokens` FOREIGN KEY (`user_password_reset_tokens`) REFERENCES `users` (`email`) ON DELETE SET NULL;
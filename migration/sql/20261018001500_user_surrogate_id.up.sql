@@ -0,0 +1,19 @@
+-- 이메일을 바꿀 수 있도록 사용자를 바뀌지 않는 숫자 id 로 가리킵니다.
+-- 기본 키를 바꾸기 전에 users.email 을 참조하는 외래 키를 모두 제거합니다.
+ALTER TABLE `todos` DROP FOREIGN KEY `todos_users_todos`;
+ALTER TABLE `refresh_tokens` DROP FOREIGN KEY `refresh_tokens_users_refresh_tokens`;
+ALTER TABLE `tags` DROP FOREIGN KEY `tags_users_tags`;
+ALTER TABLE `projects` DROP FOREIGN KEY `projects_users_projects`;
+ALTER TABLE `password_reset_tokens` DROP FOREIGN KEY `password_reset_tokens_users_password_reset_tokens`;
+ALTER TABLE `users` DROP PRIMARY KEY, ADD COLUMN `id` bigint NOT NULL AUTO_INCREMENT PRIMARY KEY FIRST, ADD UNIQUE INDEX `email` (`email`);
+-- 각 테이블의 사용자 컬럼을 이메일에서 새 id 로 바꾼 뒤 bigint 로 변환합니다.
+UPDATE `todos` SET `user_todos` = (SELECT CAST(`id` AS CHAR) FROM `users` WHERE `users`.`email` = `todos`.`user_todos`) WHERE `user_todos` IS NOT NULL;
+ALTER TABLE `todos` MODIFY COLUMN `user_todos` bigint NULL, ADD CONSTRAINT `todos_users_todos` FOREIGN KEY (`user_todos`) REFERENCES `users` (`id`) ON DELETE SET NULL;
+UPDATE `refresh_tokens` SET `user_refresh_tokens` = (SELECT CAST(`id` AS CHAR) FROM `users` WHERE `users`.`email` = `refresh_tokens`.`user_refresh_tokens`) WHERE `user_refresh_tokens` IS NOT NULL;
+ALTER TABLE `refresh_tokens` MODIFY COLUMN `user_refresh_tokens` bigint NULL, ADD CONSTRAINT `refresh_tokens_users_refresh_tokens` FOREIGN KEY (`user_refresh_tokens`) REFERENCES `users` (`id`) ON DELETE SET NULL;
+UPDATE `tags` SET `user_tags` = (SELECT CAST(`id` AS CHAR) FROM `users` WHERE `users`.`email` = `tags`.`user_tags`) WHERE `user_tags` IS NOT NULL;
+ALTER TABLE `tags` MODIFY COLUMN `user_tags` bigint NULL, ADD CONSTRAINT `tags_users_tags` FOREIGN KEY (`user_tags`) REFERENCES `users` (`id`) ON DELETE SET NULL;
+UPDATE `projects` SET `user_projects` = (SELECT CAST(`id` AS CHAR) FROM `users` WHERE `users`.`email` = `projects`.`user_projects`) WHERE `user_projects` IS NOT NULL;
+ALTER TABLE `projects` MODIFY COLUMN `user_projects` bigint NULL, ADD CONSTRAINT `projects_users_projects` FOREIGN KEY (`user_projects`) REFERENCES `users` (`id`) ON DELETE SET NULL;
+UPDATE `password_reset_tokens` SET `user_password_reset_tokens` = (SELECT CAST(`id` AS CHAR) FROM `users` WHERE `users`.`email` = `password_reset_tokens`.`user_password_reset_tokens`) WHERE `user_password_reset_tokens` IS NOT NULL;
+ALTER TABLE `password_reset_tokens` MODIFY COLUMN `user_password_reset_tokens` bigint NULL, ADD CONSTRAINT `password_reset_tokens_users_password_reset_tokens` FOREIGN KEY (`user_password_reset_tokens`) REFERENCES `users` (`id`) ON DELETE SET NULL;
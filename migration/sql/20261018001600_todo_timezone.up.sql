@@ -0,0 +1,2 @@
+-- 반복 Todo 의 다음 마감일은 timezone 기준으로 계산합니다. 기존 Todo 는 UTC 로 계산하던 동작을 유지합니다.
+ALTER TABLE `todos` ADD COLUMN `timezone` varchar(255) NOT NULL DEFAULT 'UTC';
@@ -0,0 +1,3 @@
+ALTER TABLE `users` ADD COLUMN `tokens_valid_after` timestamp NULL;
+UPDATE `users` SET `tokens_valid_after` = CURRENT_TIMESTAMP WHERE `token_version` > 0;
+ALTER TABLE `users` DROP COLUMN `token_version`;
@@ -0,0 +1,5 @@
+-- 토큰 무효화 기준을 초 단위 시각(tokens_valid_after) 대신 token_version 으로 비교합니다.
+-- 이미 전체 로그아웃한 사용자는 버전을 올려 그 전에 발급된(ver 가 없는) 토큰이 다시 유효해지지 않게 합니다.
+ALTER TABLE `users` ADD COLUMN `token_version` bigint NOT NULL DEFAULT 0;
+UPDATE `users` SET `token_version` = 1 WHERE `tokens_valid_after` IS NOT NULL;
+ALTER TABLE `users` DROP COLUMN `tokens_valid_after`;
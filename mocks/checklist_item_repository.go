@@ -0,0 +1,121 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "halill/ent"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChecklistItemRepository is an autogenerated mock type for the ChecklistItemRepository type
+type ChecklistItemRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *ChecklistItemRepository) Create(_a0 context.Context, _a1 *ent.ChecklistItem) (*ent.ChecklistItem, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.ChecklistItem
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.ChecklistItem) *ent.ChecklistItem); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.ChecklistItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.ChecklistItem) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *ChecklistItemRepository) Delete(_a0 context.Context, _a1 int64) (*ent.ChecklistItem, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.ChecklistItem
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.ChecklistItem); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.ChecklistItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *ChecklistItemRepository) Get(_a0 context.Context, _a1 int64) (*ent.ChecklistItem, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.ChecklistItem
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.ChecklistItem); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.ChecklistItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reorder provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ChecklistItemRepository) Reorder(_a0 context.Context, _a1 int64, _a2 []int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: _a0, _a1
+func (_m *ChecklistItemRepository) Update(_a0 context.Context, _a1 *ent.ChecklistItem) (*ent.ChecklistItem, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.ChecklistItem
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.ChecklistItem) *ent.ChecklistItem); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.ChecklistItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.ChecklistItem) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
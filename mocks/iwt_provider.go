@@ -5,7 +5,10 @@ package mocks
 import (
 	ent "halill/ent"
 
+	jwt "github.com/golang-jwt/jwt"
 	mock "github.com/stretchr/testify/mock"
+
+	security "halill/security"
 )
 
 // JWTProvider is an autogenerated mock type for the JWTProvider type
@@ -55,16 +58,41 @@ func (_m *JWTProvider) GenerateRefreshToken(_a0 *ent.User) (string, error) {
 	return r0, r1
 }
 
-// JwtSecret provides a mock function with given fields:
-func (_m *JWTProvider) JwtSecret() string {
+// JWKS provides a mock function with given fields:
+func (_m *JWTProvider) JWKS() *security.JWKSet {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 *security.JWKSet
+	if rf, ok := ret.Get(0).(func() *security.JWKSet); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*security.JWKSet)
+		}
 	}
 
 	return r0
 }
+
+// ParseToken provides a mock function with given fields: _a0
+func (_m *JWTProvider) ParseToken(_a0 string) (*jwt.Token, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *jwt.Token
+	if rf, ok := ret.Get(0).(func(string) *jwt.Token); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jwt.Token)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
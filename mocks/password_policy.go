@@ -0,0 +1,24 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// PasswordPolicy is an autogenerated mock type for the PasswordPolicy type
+type PasswordPolicy struct {
+	mock.Mock
+}
+
+// Validate provides a mock function with given fields: field, password, userInputs
+func (_m *PasswordPolicy) Validate(field string, password string, userInputs []string) error {
+	ret := _m.Called(field, password, userInputs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, []string) error); ok {
+		r0 = rf(field, password, userInputs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
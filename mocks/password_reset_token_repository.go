@@ -0,0 +1,98 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "halill/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PasswordResetTokenRepository is an autogenerated mock type for the PasswordResetTokenRepository type
+type PasswordResetTokenRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *PasswordResetTokenRepository) Create(_a0 context.Context, _a1 *ent.PasswordResetToken) (*ent.PasswordResetToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.PasswordResetToken
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.PasswordResetToken) *ent.PasswordResetToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.PasswordResetToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.PasswordResetToken) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByHash provides a mock function with given fields: _a0, _a1
+func (_m *PasswordResetTokenRepository) GetByHash(_a0 context.Context, _a1 string) (*ent.PasswordResetToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.PasswordResetToken
+	if rf, ok := ret.Get(0).(func(context.Context, string) *ent.PasswordResetToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.PasswordResetToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvalidateAllByUserID provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PasswordResetTokenRepository) InvalidateAllByUserID(_a0 context.Context, _a1 int64, _a2 time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Use provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PasswordResetTokenRepository) Use(_a0 context.Context, _a1 int64, _a2 time.Time) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
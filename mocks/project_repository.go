@@ -0,0 +1,130 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "halill/ent"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProjectRepository is an autogenerated mock type for the ProjectRepository type
+type ProjectRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *ProjectRepository) Create(_a0 context.Context, _a1 *ent.Project) (*ent.Project, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Project
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Project) *ent.Project); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Project)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Project) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ProjectRepository) Delete(_a0 context.Context, _a1 int64, _a2 bool) (*ent.Project, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *ent.Project
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) *ent.Project); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Project)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *ProjectRepository) Get(_a0 context.Context, _a1 int64) (*ent.Project, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Project
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Project); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Project)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllByUserID provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ProjectRepository) GetAllByUserID(_a0 context.Context, _a1 int64, _a2 *bool) ([]*ent.Project, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*ent.Project
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *bool) []*ent.Project); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Project)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *bool) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: _a0, _a1
+func (_m *ProjectRepository) Update(_a0 context.Context, _a1 *ent.Project) (*ent.Project, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Project
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Project) *ent.Project); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Project)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Project) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
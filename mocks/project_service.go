@@ -0,0 +1,130 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "halill/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProjectService is an autogenerated mock type for the ProjectService type
+type ProjectService struct {
+	mock.Mock
+}
+
+// ArchiveProject provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ProjectService) ArchiveProject(_a0 context.Context, _a1 int64, _a2 bool) (*dto.ProjectResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.ProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) *dto.ProjectResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProjectResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateProject provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ProjectService) CreateProject(_a0 context.Context, _a1 *dto.CreateProjectRequest, _a2 int64) (*dto.ProjectResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.ProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateProjectRequest, int64) *dto.ProjectResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProjectResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.CreateProjectRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteProject provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ProjectService) DeleteProject(_a0 context.Context, _a1 int64, _a2 *dto.DeleteProjectRequest) (*dto.ProjectResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.ProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.DeleteProjectRequest) *dto.ProjectResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProjectResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.DeleteProjectRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllProjects provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ProjectService) GetAllProjects(_a0 context.Context, _a1 *dto.ProjectListRequest, _a2 int64) ([]*dto.ProjectResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*dto.ProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ProjectListRequest, int64) []*dto.ProjectResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.ProjectResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ProjectListRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateProject provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ProjectService) UpdateProject(_a0 context.Context, _a1 int64, _a2 *dto.UpdateProjectRequest) (*dto.ProjectResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.ProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.UpdateProjectRequest) *dto.ProjectResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProjectResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.UpdateProjectRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
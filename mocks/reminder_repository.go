@@ -0,0 +1,172 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "halill/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ReminderRepository is an autogenerated mock type for the ReminderRepository type
+type ReminderRepository struct {
+	mock.Mock
+}
+
+// Claim provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *ReminderRepository) Claim(_a0 context.Context, _a1 int64, _a2 string, _a3 time.Time, _a4 time.Time) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time, time.Time) bool); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, time.Time, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *ReminderRepository) Create(_a0 context.Context, _a1 *ent.Reminder) (*ent.Reminder, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Reminder
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Reminder) *ent.Reminder); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Reminder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Reminder) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *ReminderRepository) Delete(_a0 context.Context, _a1 int64) (*ent.Reminder, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Reminder
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Reminder); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Reminder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *ReminderRepository) Get(_a0 context.Context, _a1 int64) (*ent.Reminder, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Reminder
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Reminder); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Reminder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDue provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReminderRepository) GetDue(_a0 context.Context, _a1 time.Time, _a2 int) ([]*ent.Reminder, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*ent.Reminder
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*ent.Reminder); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Reminder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkFailed provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *ReminderRepository) MarkFailed(_a0 context.Context, _a1 int64, _a2 string, _a3 string, _a4 time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkSent provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ReminderRepository) MarkSent(_a0 context.Context, _a1 int64, _a2 string, _a3 time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Reschedule provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReminderRepository) Reschedule(_a0 context.Context, _a1 int64, _a2 *time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,130 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "halill/ent"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagRepository is an autogenerated mock type for the TagRepository type
+type TagRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *TagRepository) Create(_a0 context.Context, _a1 *ent.Tag) (*ent.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Tag) *ent.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Tag) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *TagRepository) Delete(_a0 context.Context, _a1 int64) (*ent.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *TagRepository) Get(_a0 context.Context, _a1 int64) (*ent.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllByUserID provides a mock function with given fields: _a0, _a1
+func (_m *TagRepository) GetAllByUserID(_a0 context.Context, _a1 int64) ([]*ent.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*ent.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*ent.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: _a0, _a1
+func (_m *TagRepository) Update(_a0 context.Context, _a1 *ent.Tag) (*ent.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Tag) *ent.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Tag) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "halill/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagService is an autogenerated mock type for the TagService type
+type TagService struct {
+	mock.Mock
+}
+
+// CreateTag provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TagService) CreateTag(_a0 context.Context, _a1 *dto.TagRequest, _a2 int64) (*dto.TagResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TagResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.TagRequest, int64) *dto.TagResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TagResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.TagRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTag provides a mock function with given fields: _a0, _a1
+func (_m *TagService) DeleteTag(_a0 context.Context, _a1 int64) (*dto.TagResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.TagResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.TagResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TagResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllTags provides a mock function with given fields: _a0, _a1
+func (_m *TagService) GetAllTags(_a0 context.Context, _a1 int64) ([]*dto.TagResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*dto.TagResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*dto.TagResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.TagResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTag provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TagService) UpdateTag(_a0 context.Context, _a1 int64, _a2 *dto.TagRequest) (*dto.TagResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TagResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.TagRequest) *dto.TagResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TagResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.TagRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,38 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	search "halill/search"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TodoIndex is an autogenerated mock type for the TodoIndex type
+type TodoIndex struct {
+	mock.Mock
+}
+
+// Search provides a mock function with given fields: ctx, userID, query, limit
+func (_m *TodoIndex) Search(ctx context.Context, userID int64, query string, limit int) ([]*search.Result, error) {
+	ret := _m.Called(ctx, userID, query, limit)
+
+	var r0 []*search.Result
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int) []*search.Result); ok {
+		r0 = rf(ctx, userID, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*search.Result)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int) error); ok {
+		r1 = rf(ctx, userID, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -3,9 +3,14 @@
 package mocks
 
 import (
+	context "context"
 	ent "halill/ent"
 
 	mock "github.com/stretchr/testify/mock"
+
+	repository "halill/repository"
+
+	time "time"
 )
 
 // TodoRepository is an autogenerated mock type for the TodoRepository type
@@ -13,13 +18,43 @@ type TodoRepository struct {
 	mock.Mock
 }
 
-// Complete provides a mock function with given fields: _a0
-func (_m *TodoRepository) Complete(_a0 int64) (*ent.Todo, error) {
-	ret := _m.Called(_a0)
+// AddTags provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoRepository) AddTags(_a0 context.Context, _a1 int64, _a2 ...int64) (*ent.Todo, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ent.Todo
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...int64) *ent.Todo); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Todo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, ...int64) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Complete provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoRepository) Complete(_a0 context.Context, _a1 int64, _a2 *time.Time) (*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *ent.Todo
-	if rf, ok := ret.Get(0).(func(int64) *ent.Todo); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *time.Time) *ent.Todo); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.Todo)
@@ -27,8 +62,8 @@ func (_m *TodoRepository) Complete(_a0 int64) (*ent.Todo, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,13 +71,13 @@ func (_m *TodoRepository) Complete(_a0 int64) (*ent.Todo, error) {
 	return r0, r1
 }
 
-// Create provides a mock function with given fields: _a0
-func (_m *TodoRepository) Create(_a0 *ent.Todo) (*ent.Todo, error) {
-	ret := _m.Called(_a0)
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) Create(_a0 context.Context, _a1 *ent.Todo) (*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *ent.Todo
-	if rf, ok := ret.Get(0).(func(*ent.Todo) *ent.Todo); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Todo) *ent.Todo); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.Todo)
@@ -50,8 +85,8 @@ func (_m *TodoRepository) Create(_a0 *ent.Todo) (*ent.Todo, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ent.Todo) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Todo) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,13 +94,13 @@ func (_m *TodoRepository) Create(_a0 *ent.Todo) (*ent.Todo, error) {
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: _a0
-func (_m *TodoRepository) Delete(_a0 int64) (*ent.Todo, error) {
-	ret := _m.Called(_a0)
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) Delete(_a0 context.Context, _a1 int64) (*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *ent.Todo
-	if rf, ok := ret.Get(0).(func(int64) *ent.Todo); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Todo); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.Todo)
@@ -73,8 +108,8 @@ func (_m *TodoRepository) Delete(_a0 int64) (*ent.Todo, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -82,13 +117,13 @@ func (_m *TodoRepository) Delete(_a0 int64) (*ent.Todo, error) {
 	return r0, r1
 }
 
-// Get provides a mock function with given fields: _a0
-func (_m *TodoRepository) Get(_a0 int64) (*ent.Todo, error) {
-	ret := _m.Called(_a0)
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) Get(_a0 context.Context, _a1 int64) (*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *ent.Todo
-	if rf, ok := ret.Get(0).(func(int64) *ent.Todo); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Todo); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.Todo)
@@ -96,8 +131,84 @@ func (_m *TodoRepository) Get(_a0 int64) (*ent.Todo, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllByUserID provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoRepository) GetAllByUserID(_a0 context.Context, _a1 int64, _a2 *repository.TodoFilter) ([]*ent.Todo, int, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*ent.Todo
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *repository.TodoFilter) []*ent.Todo); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Todo)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *repository.TodoFilter) int); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64, *repository.TodoFilter) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetNextCandidates provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoRepository) GetNextCandidates(_a0 context.Context, _a1 int64, _a2 int) ([]*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*ent.Todo
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []*ent.Todo); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Todo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOccurrences provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) GetOccurrences(_a0 context.Context, _a1 int64) ([]*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*ent.Todo
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*ent.Todo); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Todo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -105,13 +216,13 @@ func (_m *TodoRepository) Get(_a0 int64) (*ent.Todo, error) {
 	return r0, r1
 }
 
-// GetAllByEmail provides a mock function with given fields: _a0
-func (_m *TodoRepository) GetAllByEmail(_a0 string) ([]*ent.Todo, error) {
-	ret := _m.Called(_a0)
+// GetTrashByUserID provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) GetTrashByUserID(_a0 context.Context, _a1 int64) ([]*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*ent.Todo
-	if rf, ok := ret.Get(0).(func(string) []*ent.Todo); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*ent.Todo); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ent.Todo)
@@ -119,8 +230,128 @@ func (_m *TodoRepository) GetAllByEmail(_a0 string) ([]*ent.Todo, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrashed provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) GetTrashed(_a0 context.Context, _a1 int64) (*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Todo
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Todo); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Todo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Purge provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) Purge(_a0 context.Context, _a1 time.Time) (int, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTags provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoRepository) RemoveTags(_a0 context.Context, _a1 int64, _a2 ...int64) (*ent.Todo, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ent.Todo
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...int64) *ent.Todo); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Todo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, ...int64) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) Restore(_a0 context.Context, _a1 int64) (*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Todo
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.Todo); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Todo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: _a0, _a1
+func (_m *TodoRepository) Update(_a0 context.Context, _a1 *ent.Todo) (*ent.Todo, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.Todo
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Todo) *ent.Todo); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Todo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Todo) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	dto "halill/dto"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,12 +14,81 @@ type TodoService struct {
 	mock.Mock
 }
 
+// AddChecklistItem provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) AddChecklistItem(_a0 context.Context, _a1 int64, _a2 *dto.CreateChecklistItemRequest) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.CreateChecklistItemRequest) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.CreateChecklistItemRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddReminder provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) AddReminder(_a0 context.Context, _a1 int64, _a2 *dto.CreateReminderRequest) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.CreateReminderRequest) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.CreateReminderRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTags provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) AddTags(_a0 context.Context, _a1 int64, _a2 *dto.TodoTagsRequest) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.TodoTagsRequest) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.TodoTagsRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CompleteTodo provides a mock function with given fields: _a0, _a1
-func (_m *TodoService) CompleteTodo(_a0 int64, _a1 string) (*dto.TodoResponse, error) {
+func (_m *TodoService) CompleteTodo(_a0 context.Context, _a1 int64) (*dto.TodoResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *dto.TodoResponse
-	if rf, ok := ret.Get(0).(func(int64, string) *dto.TodoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.TodoResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -27,7 +97,7 @@ func (_m *TodoService) CompleteTodo(_a0 int64, _a1 string) (*dto.TodoResponse, e
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -36,12 +106,81 @@ func (_m *TodoService) CompleteTodo(_a0 int64, _a1 string) (*dto.TodoResponse, e
 	return r0, r1
 }
 
-// CreateTodo provides a mock function with given fields: _a0, _a1
-func (_m *TodoService) CreateTodo(_a0 *dto.CreateTodoRequest, _a1 string) (*dto.TodoResponse, error) {
+// CreateTodo provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) CreateTodo(_a0 context.Context, _a1 *dto.CreateTodoRequest, _a2 int64) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateTodoRequest, int64) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.CreateTodoRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteChecklistItem provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) DeleteChecklistItem(_a0 context.Context, _a1 int64, _a2 int64) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReminder provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) DeleteReminder(_a0 context.Context, _a1 int64, _a2 int64) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTodo provides a mock function with given fields: _a0, _a1
+func (_m *TodoService) DeleteTodo(_a0 context.Context, _a1 int64) (*dto.TodoResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *dto.TodoResponse
-	if rf, ok := ret.Get(0).(func(*dto.CreateTodoRequest, string) *dto.TodoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.TodoResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -50,7 +189,7 @@ func (_m *TodoService) CreateTodo(_a0 *dto.CreateTodoRequest, _a1 string) (*dto.
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dto.CreateTodoRequest, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -59,12 +198,58 @@ func (_m *TodoService) CreateTodo(_a0 *dto.CreateTodoRequest, _a1 string) (*dto.
 	return r0, r1
 }
 
-// DeleteTodo provides a mock function with given fields: _a0, _a1
-func (_m *TodoService) DeleteTodo(_a0 int64, _a1 string) (*dto.TodoResponse, error) {
+// GetAllTodos provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) GetAllTodos(_a0 context.Context, _a1 *dto.TodoListRequest, _a2 int64) (*dto.TodoPageResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoPageResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.TodoListRequest, int64) *dto.TodoPageResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoPageResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.TodoListRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNextTodos provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) GetNextTodos(_a0 context.Context, _a1 *dto.NextTodosRequest, _a2 int64) ([]*dto.NextTodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*dto.NextTodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.NextTodosRequest, int64) []*dto.NextTodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.NextTodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.NextTodosRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTodo provides a mock function with given fields: _a0, _a1
+func (_m *TodoService) GetTodo(_a0 context.Context, _a1 int64) (*dto.TodoResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *dto.TodoResponse
-	if rf, ok := ret.Get(0).(func(int64, string) *dto.TodoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.TodoResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -73,7 +258,7 @@ func (_m *TodoService) DeleteTodo(_a0 int64, _a1 string) (*dto.TodoResponse, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -82,13 +267,13 @@ func (_m *TodoService) DeleteTodo(_a0 int64, _a1 string) (*dto.TodoResponse, err
 	return r0, r1
 }
 
-// GetAllTodos provides a mock function with given fields: _a0
-func (_m *TodoService) GetAllTodos(_a0 string) ([]*dto.TodoResponse, error) {
-	ret := _m.Called(_a0)
+// GetTodoHistory provides a mock function with given fields: _a0, _a1
+func (_m *TodoService) GetTodoHistory(_a0 context.Context, _a1 int64) ([]*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*dto.TodoResponse
-	if rf, ok := ret.Get(0).(func(string) []*dto.TodoResponse); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dto.TodoResponse)
@@ -96,8 +281,8 @@ func (_m *TodoService) GetAllTodos(_a0 string) ([]*dto.TodoResponse, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -105,12 +290,104 @@ func (_m *TodoService) GetAllTodos(_a0 string) ([]*dto.TodoResponse, error) {
 	return r0, r1
 }
 
-// GetTodo provides a mock function with given fields: _a0, _a1
-func (_m *TodoService) GetTodo(_a0 int64, _a1 string) (*dto.TodoResponse, error) {
+// GetTrash provides a mock function with given fields: _a0, _a1
+func (_m *TodoService) GetTrash(_a0 context.Context, _a1 int64) ([]*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PatchTodo provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) PatchTodo(_a0 context.Context, _a1 int64, _a2 *dto.PatchTodoRequest) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.PatchTodoRequest) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.PatchTodoRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTag provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) RemoveTag(_a0 context.Context, _a1 int64, _a2 int64) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReorderChecklist provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) ReorderChecklist(_a0 context.Context, _a1 int64, _a2 *dto.ReorderChecklistRequest) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.ReorderChecklistRequest) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.ReorderChecklistRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreTodo provides a mock function with given fields: _a0, _a1
+func (_m *TodoService) RestoreTodo(_a0 context.Context, _a1 int64) (*dto.TodoResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *dto.TodoResponse
-	if rf, ok := ret.Get(0).(func(int64, string) *dto.TodoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.TodoResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -119,7 +396,7 @@ func (_m *TodoService) GetTodo(_a0 int64, _a1 string) (*dto.TodoResponse, error)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -127,3 +404,72 @@ func (_m *TodoService) GetTodo(_a0 int64, _a1 string) (*dto.TodoResponse, error)
 
 	return r0, r1
 }
+
+// SearchTodos provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) SearchTodos(_a0 context.Context, _a1 *dto.TodoSearchRequest, _a2 int64) ([]*dto.TodoSearchResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*dto.TodoSearchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.TodoSearchRequest, int64) []*dto.TodoSearchResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.TodoSearchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.TodoSearchRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateChecklistItem provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *TodoService) UpdateChecklistItem(_a0 context.Context, _a1 int64, _a2 int64, _a3 *dto.UpdateChecklistItemRequest) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *dto.UpdateChecklistItemRequest) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, *dto.UpdateChecklistItemRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTodo provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TodoService) UpdateTodo(_a0 context.Context, _a1 int64, _a2 *dto.UpdateTodoRequest) (*dto.TodoResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.TodoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *dto.UpdateTodoRequest) *dto.TodoResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TodoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *dto.UpdateTodoRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
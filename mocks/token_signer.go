@@ -0,0 +1,49 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// TokenSigner is an autogenerated mock type for the TokenSigner type
+type TokenSigner struct {
+	mock.Mock
+}
+
+// Sign provides a mock function with given fields: purpose, subject, expiresAt
+func (_m *TokenSigner) Sign(purpose string, subject string, expiresAt time.Time) string {
+	ret := _m.Called(purpose, subject, expiresAt)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) string); ok {
+		r0 = rf(purpose, subject, expiresAt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Verify provides a mock function with given fields: purpose, token, now
+func (_m *TokenSigner) Verify(purpose string, token string, now time.Time) (string, error) {
+	ret := _m.Called(purpose, token, now)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) string); ok {
+		r0 = rf(purpose, token, now)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(purpose, token, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
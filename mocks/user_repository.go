@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	context "context"
 	ent "halill/ent"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UserRepository is an autogenerated mock type for the UserRepository type
@@ -13,13 +16,174 @@ type UserRepository struct {
 	mock.Mock
 }
 
-// CreateUser provides a mock function with given fields: _a0
-func (_m *UserRepository) CreateUser(_a0 *ent.User) (*ent.User, error) {
-	ret := _m.Called(_a0)
+// CreateUser provides a mock function with given fields: _a0, _a1
+func (_m *UserRepository) CreateUser(_a0 context.Context, _a1 *ent.User) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.User
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.User) *ent.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.User) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *UserRepository) Get(_a0 context.Context, _a1 int64) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.User
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByEmail provides a mock function with given fields: _a0, _a1
+func (_m *UserRepository) GetByEmail(_a0 context.Context, _a1 string) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.User
+	if rf, ok := ret.Get(0).(func(context.Context, string) *ent.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementTokenVersion provides a mock function with given fields: _a0, _a1
+func (_m *UserRepository) IncrementTokenVersion(_a0 context.Context, _a1 int64) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ent.User
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *ent.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkEmailVerified provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserRepository) MarkEmailVerified(_a0 context.Context, _a1 int64, _a2 time.Time) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *ent.User
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) *ent.User); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEmail provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *UserRepository) UpdateEmail(_a0 context.Context, _a1 int64, _a2 string, _a3 time.Time) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *ent.User
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) *ent.User); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLocale provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserRepository) UpdateLocale(_a0 context.Context, _a1 int64, _a2 string) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *ent.User
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *ent.User); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePassword provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserRepository) UpdatePassword(_a0 context.Context, _a1 int64, _a2 string) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *ent.User
-	if rf, ok := ret.Get(0).(func(*ent.User) *ent.User); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *ent.User); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.User)
@@ -27,8 +191,8 @@ func (_m *UserRepository) CreateUser(_a0 *ent.User) (*ent.User, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ent.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,13 +200,13 @@ func (_m *UserRepository) CreateUser(_a0 *ent.User) (*ent.User, error) {
 	return r0, r1
 }
 
-// GetByEmail provides a mock function with given fields: _a0
-func (_m *UserRepository) GetByEmail(_a0 string) (*ent.User, error) {
-	ret := _m.Called(_a0)
+// UpdateVerificationSent provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *UserRepository) UpdateVerificationSent(_a0 context.Context, _a1 int64, _a2 time.Time, _a3 int) (*ent.User, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 *ent.User
-	if rf, ok := ret.Get(0).(func(string) *ent.User); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, int) *ent.User); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.User)
@@ -50,8 +214,8 @@ func (_m *UserRepository) GetByEmail(_a0 string) (*ent.User, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
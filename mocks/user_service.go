@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	context "context"
 	dto "halill/dto"
 
 	mock "github.com/stretchr/testify/mock"
+
+	security "halill/security"
 )
 
 // UserService is an autogenerated mock type for the UserService type
@@ -13,13 +16,27 @@ type UserService struct {
 	mock.Mock
 }
 
-// LoginUser provides a mock function with given fields: _a0
-func (_m *UserService) LoginUser(_a0 *dto.LoginRequest) (*dto.TokenResponse, error) {
-	ret := _m.Called(_a0)
+// ChangeEmail provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserService) ChangeEmail(_a0 context.Context, _a1 *dto.ChangeEmailRequest, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ChangeEmailRequest, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChangePassword provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserService) ChangePassword(_a0 context.Context, _a1 *dto.ChangePasswordRequest, _a2 int64) (*dto.TokenResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *dto.TokenResponse
-	if rf, ok := ret.Get(0).(func(*dto.LoginRequest) *dto.TokenResponse); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ChangePasswordRequest, int64) *dto.TokenResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.TokenResponse)
@@ -27,8 +44,31 @@ func (_m *UserService) LoginUser(_a0 *dto.LoginRequest) (*dto.TokenResponse, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dto.LoginRequest) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ChangePasswordRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfirmEmailChange provides a mock function with given fields: _a0, _a1
+func (_m *UserService) ConfirmEmailChange(_a0 context.Context, _a1 *dto.ConfirmEmailChangeRequest) (*dto.UserResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.UserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ConfirmEmailChangeRequest) *dto.UserResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ConfirmEmailChangeRequest) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,13 +76,27 @@ func (_m *UserService) LoginUser(_a0 *dto.LoginRequest) (*dto.TokenResponse, err
 	return r0, r1
 }
 
-// RefreshToken provides a mock function with given fields: _a0
-func (_m *UserService) RefreshToken(_a0 *dto.RefreshTokenRequest) (*dto.TokenResponse, error) {
-	ret := _m.Called(_a0)
+// ForgotPassword provides a mock function with given fields: _a0, _a1
+func (_m *UserService) ForgotPassword(_a0 context.Context, _a1 *dto.ForgotPasswordRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ForgotPasswordRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoginUser provides a mock function with given fields: _a0, _a1
+func (_m *UserService) LoginUser(_a0 context.Context, _a1 *dto.LoginRequest) (*dto.TokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *dto.TokenResponse
-	if rf, ok := ret.Get(0).(func(*dto.RefreshTokenRequest) *dto.TokenResponse); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.LoginRequest) *dto.TokenResponse); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.TokenResponse)
@@ -50,8 +104,156 @@ func (_m *UserService) RefreshToken(_a0 *dto.RefreshTokenRequest) (*dto.TokenRes
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dto.RefreshTokenRequest) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.LoginRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Logout provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserService) Logout(_a0 context.Context, _a1 *security.JwtCustomClaims, _a2 *dto.LogoutRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *security.JwtCustomClaims, *dto.LogoutRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LogoutAll provides a mock function with given fields: _a0, _a1
+func (_m *UserService) LogoutAll(_a0 context.Context, _a1 *security.JwtCustomClaims) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *security.JwtCustomClaims) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshToken provides a mock function with given fields: _a0, _a1
+func (_m *UserService) RefreshToken(_a0 context.Context, _a1 *dto.RefreshTokenRequest) (*dto.TokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.TokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.RefreshTokenRequest) *dto.TokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TokenResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.RefreshTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistUser provides a mock function with given fields: _a0, _a1
+func (_m *UserService) RegistUser(_a0 context.Context, _a1 *dto.RegistRequest) (*dto.UserResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.UserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.RegistRequest) *dto.UserResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.RegistRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResendVerification provides a mock function with given fields: _a0, _a1
+func (_m *UserService) ResendVerification(_a0 context.Context, _a1 *dto.ResendVerificationRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ResendVerificationRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetPassword provides a mock function with given fields: _a0, _a1
+func (_m *UserService) ResetPassword(_a0 context.Context, _a1 *dto.ResetPasswordRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ResetPasswordRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLocale provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserService) UpdateLocale(_a0 context.Context, _a1 *dto.UpdateLocaleRequest, _a2 int64) (*dto.UserResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.UserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateLocaleRequest, int64) *dto.UserResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.UpdateLocaleRequest, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyAccessToken provides a mock function with given fields: _a0, _a1
+func (_m *UserService) VerifyAccessToken(_a0 context.Context, _a1 *security.JwtCustomClaims) (*dto.UserResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.UserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *security.JwtCustomClaims) *dto.UserResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *security.JwtCustomClaims) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,13 +261,13 @@ func (_m *UserService) RefreshToken(_a0 *dto.RefreshTokenRequest) (*dto.TokenRes
 	return r0, r1
 }
 
-// RegistUser provides a mock function with given fields: _a0
-func (_m *UserService) RegistUser(_a0 *dto.RegistRequest) (*dto.UserResponse, error) {
-	ret := _m.Called(_a0)
+// VerifyEmail provides a mock function with given fields: _a0, _a1
+func (_m *UserService) VerifyEmail(_a0 context.Context, _a1 *dto.VerifyEmailRequest) (*dto.UserResponse, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *dto.UserResponse
-	if rf, ok := ret.Get(0).(func(*dto.RegistRequest) *dto.UserResponse); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.VerifyEmailRequest) *dto.UserResponse); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.UserResponse)
@@ -73,8 +275,8 @@ func (_m *UserService) RegistUser(_a0 *dto.RegistRequest) (*dto.UserResponse, er
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dto.RegistRequest) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.VerifyEmailRequest) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,19 @@
+package notify
+
+import "log"
+
+type logNotifier struct {
+	logger *log.Logger
+}
+
+// NewLogNotifier 는 알림을 보내지 않고 로그로만 남깁니다. 개발 환경에서 사용합니다.
+func NewLogNotifier(logger *log.Logger) Notifier {
+	return &logNotifier{
+		logger: logger,
+	}
+}
+
+func (l *logNotifier) Notify(n *Notification) error {
+	l.logger.Printf("reminder: todo %d %q for %s is due at %s", n.TodoID, n.Title, n.Email, n.Deadline.Format("2006-01-02 15:04:05 MST"))
+	return nil
+}
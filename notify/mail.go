@@ -0,0 +1,59 @@
+package notify
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Mail 은 Todo 알림이 아닌 계정 관련 메일입니다. (예: 비밀번호 재설정)
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(*Mail) error
+}
+
+type logMailer struct {
+	logger *log.Logger
+}
+
+// NewLogMailer 는 메일을 보내지 않고 본문까지 로그로 남깁니다. 로컬에서 재설정 링크를 확인할 때 사용합니다.
+func NewLogMailer(logger *log.Logger) Mailer {
+	return &logMailer{
+		logger: logger,
+	}
+}
+
+func (l *logMailer) Send(m *Mail) error {
+	l.logger.Printf("mail to %s: %s\n%s", m.To, m.Subject, m.Body)
+	return nil
+}
+
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer 는 메일을 보내지 않고 dir 아래에 .eml 파일로 저장합니다. 디렉터리가 없으면 만듭니다.
+func NewFileMailer(dir, from string) Mailer {
+	return &fileMailer{
+		dir:  dir,
+		from: from,
+	}
+}
+
+func (f *fileMailer) Send(m *Mail) error {
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return err
+	}
+	// 같은 수신자에게 연달아 보내도 덮어쓰지 않도록 나노초까지 파일 이름에 넣음
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), strings.NewReplacer("/", "_", "\\", "_").Replace(m.To))
+	return os.WriteFile(filepath.Join(f.dir, name), buildMessage(f.from, m.To, m.Subject, m.Body), 0o600)
+}
@@ -0,0 +1,56 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Notification 은 마감일이 다가오는 Todo 에 대한 알림입니다.
+type Notification struct {
+	Email    string    `json:"email"`
+	Name     string    `json:"name"`
+	TodoID   int64     `json:"todo_id"`
+	Title    string    `json:"title"`
+	Deadline time.Time `json:"deadline"`
+	RemindAt time.Time `json:"remind_at"`
+}
+
+func (n *Notification) Subject() string {
+	return fmt.Sprintf("[halill] %s 마감이 다가옵니다", n.Title)
+}
+
+func (n *Notification) Body() string {
+	return fmt.Sprintf("%s 님, Todo \"%s\" 의 마감일은 %s 입니다.\n", n.Name, n.Title, n.Deadline.Format("2006-01-02 15:04 MST"))
+}
+
+type Notifier interface {
+	Notify(*Notification) error
+}
+
+type multiNotifier struct {
+	notifiers []Notifier
+}
+
+// Multi 는 모든 notifier 로 알림을 보냅니다.
+// 하나라도 실패하면 오류를 반환하므로, 다시 시도하면 이미 성공한 채널로도 한 번 더 보내질 수 있습니다.
+func Multi(notifiers ...Notifier) Notifier {
+	return &multiNotifier{
+		notifiers: notifiers,
+	}
+}
+
+func (m *multiNotifier) Notify(n *Notification) error {
+	messages := make([]string, 0)
+	for _, notifier := range m.notifiers {
+		if err := notifier.Notify(n); err != nil {
+			messages = append(messages, err.Error())
+		}
+	}
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package notify
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testNotification() *Notification {
+	return &Notification{
+		Email:    "hwc9169@gmail.com",
+		Name:     "조호원",
+		TodoID:   1,
+		Title:    "Go 언어 공부하기",
+		Deadline: time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+		RemindAt: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+	}
+}
+
+// smtpStub 은 한 번의 메일 전송을 받아 봉투와 본문을 기록하는 SMTP 서버입니다.
+type smtpStub struct {
+	listener net.Listener
+	from     string
+	to       []string
+	data     string
+	done     chan struct{}
+}
+
+func newSMTPStub(t *testing.T) *smtpStub {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	stub := &smtpStub{listener: listener, done: make(chan struct{})}
+	t.Cleanup(func() {
+		listener.Close()
+	})
+
+	go stub.serve()
+	return stub
+}
+
+func (s *smtpStub) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStub) serve() {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+	reply("220 localhost ESMTP stub")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			s.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			s.to = append(s.to, strings.Trim(line[len("RCPT TO:"):], "<>"))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.data = data.String()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	t.Run("메일 전송 성공", func(t *testing.T) {
+		stub := newSMTPStub(t)
+		notifier := NewSMTPNotifier(SMTPConfig{
+			Host: "127.0.0.1",
+			Port: stub.port(),
+			From: "noreply@halill.com",
+		})
+
+		err := notifier.Notify(testNotification())
+		assert.NoError(t, err)
+		<-stub.done
+
+		assert.Equal(t, "noreply@halill.com", stub.from)
+		assert.Equal(t, []string{"hwc9169@gmail.com"}, stub.to)
+		assert.Contains(t, stub.data, "To: hwc9169@gmail.com\r\n")
+		assert.Contains(t, stub.data, "Subject: =?UTF-8?b?")
+		assert.Contains(t, stub.data, "Todo \"Go 언어 공부하기\" 의 마감일은 2026-10-19 09:00 UTC 입니다.\r\n")
+	})
+	t.Run("서버에 연결할 수 없음", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		port := listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		notifier := NewSMTPNotifier(SMTPConfig{Host: "127.0.0.1", Port: port, From: "noreply@halill.com", Timeout: time.Second})
+		assert.Error(t, notifier.Notify(testNotification()))
+	})
+}
+
+func TestSMTPMailer(t *testing.T) {
+	t.Run("메일 전송 성공", func(t *testing.T) {
+		stub := newSMTPStub(t)
+		mailer := NewSMTPMailer(SMTPConfig{
+			Host: "127.0.0.1",
+			Port: stub.port(),
+			From: "noreply@halill.com",
+		})
+
+		err := mailer.Send(&Mail{To: "hwc9169@gmail.com", Subject: "비밀번호 재설정", Body: "링크를 눌러주세요.\n"})
+		assert.NoError(t, err)
+		<-stub.done
+
+		assert.Equal(t, []string{"hwc9169@gmail.com"}, stub.to)
+		assert.Contains(t, stub.data, "링크를 눌러주세요.\r\n")
+	})
+}
+
+func TestFileMailer(t *testing.T) {
+	t.Run("메일마다 파일 저장", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "mail")
+		mailer := NewFileMailer(dir, "noreply@halill.com")
+
+		assert.NoError(t, mailer.Send(&Mail{To: "hwc9169@gmail.com", Subject: "첫 번째", Body: "본문 1\n"}))
+		assert.NoError(t, mailer.Send(&Mail{To: "hwc9169@gmail.com", Subject: "두 번째", Body: "본문 2\n"}))
+
+		files, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, files, 2)
+		data, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "From: noreply@halill.com\r\n")
+		assert.Contains(t, string(data), "본문 1\n")
+	})
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	t.Run("JSON 으로 POST", func(t *testing.T) {
+		var received Notification
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(server.URL, server.Client()).Notify(testNotification())
+		assert.NoError(t, err)
+		assert.Equal(t, *testNotification(), received)
+	})
+	t.Run("2xx 가 아닌 응답은 실패", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(server.URL, server.Client()).Notify(testNotification())
+		assert.EqualError(t, err, "webhook responded with 500 Internal Server Error")
+	})
+}
+
+type notifierFunc func(*Notification) error
+
+func (f notifierFunc) Notify(n *Notification) error {
+	return f(n)
+}
+
+func TestMulti(t *testing.T) {
+	var buf bytes.Buffer
+	logNotifier := NewLogNotifier(log.New(&buf, "", 0))
+
+	t.Run("모든 채널로 전송", func(t *testing.T) {
+		err := Multi(logNotifier).Notify(testNotification())
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), `reminder: todo 1 "Go 언어 공부하기" for hwc9169@gmail.com`)
+	})
+	t.Run("하나라도 실패하면 오류", func(t *testing.T) {
+		buf.Reset()
+		failing := notifierFunc(func(*Notification) error {
+			return errors.New("smtp down")
+		})
+
+		err := Multi(failing, logNotifier).Notify(testNotification())
+		assert.EqualError(t, err, "smtp down")
+		assert.NotEmpty(t, buf.String())
+	})
+}
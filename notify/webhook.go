@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier 는 알림을 JSON 으로 url 에 POST 합니다. 2xx 가 아닌 응답은 실패로 봅니다.
+func NewWebhookNotifier(url string, client *http.Client) Notifier {
+	return &webhookNotifier{
+		url:    url,
+		client: client,
+	}
+}
+
+func (w *webhookNotifier) Notify(n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+
+	return nil
+}
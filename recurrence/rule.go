@@ -0,0 +1,347 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+const (
+	untilLayout     = "20060102T150405Z"
+	untilDateLayout = "20060102"
+	// maxPeriods 는 다음 발생일을 찾을 때 살펴볼 최대 주기 수입니다.
+	maxPeriods = 1000
+)
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// WeekdayNum 은 BYDAY 의 한 항목입니다.
+// N 이 0 이 아니면 달 안에서 N 번째 요일이며, 음수는 달의 마지막부터 셉니다. (예: -1FR 은 마지막 금요일)
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule 은 RFC 5545 RRULE 의 일부입니다.
+// FREQ(DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT, UNTIL 을 지원합니다.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := &Rule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRule, part)
+		}
+		key, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		if seen[key] {
+			return nil, fmt.Errorf("%w: duplicate %s", ErrInvalidRule, key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			switch freq := Frequency(value); freq {
+			case Daily, Weekly, Monthly, Yearly:
+				r.Freq = freq
+			default:
+				return nil, fmt.Errorf("%w: unsupported FREQ %s", ErrInvalidRule, value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: INTERVAL must be a positive integer", ErrInvalidRule)
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: COUNT must be a positive integer", ErrInvalidRule)
+			}
+			r.Count = n
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			r.Until = &until
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				wn, err := parseWeekdayNum(day)
+				if err != nil {
+					return nil, err
+				}
+				r.ByDay = append(r.ByDay, wn)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(day)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("%w: invalid BYMONTHDAY %s", ErrInvalidRule, day)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unsupported %s", ErrInvalidRule, key)
+		}
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Rule) validate() error {
+	if r.Freq == "" {
+		return fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if r.Count > 0 && r.Until != nil {
+		return fmt.Errorf("%w: COUNT and UNTIL cannot be used together", ErrInvalidRule)
+	}
+	if len(r.ByDay) > 0 && len(r.ByMonthDay) > 0 {
+		return fmt.Errorf("%w: BYDAY and BYMONTHDAY cannot be used together", ErrInvalidRule)
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq != Monthly {
+		return fmt.Errorf("%w: BYMONTHDAY is only supported with FREQ=MONTHLY", ErrInvalidRule)
+	}
+	if r.Freq == Yearly && len(r.ByDay) > 0 {
+		return fmt.Errorf("%w: BYDAY is not supported with FREQ=YEARLY", ErrInvalidRule)
+	}
+	for _, wn := range r.ByDay {
+		if wn.N != 0 && r.Freq != Monthly {
+			return fmt.Errorf("%w: numbered BYDAY is only supported with FREQ=MONTHLY", ErrInvalidRule)
+		}
+	}
+
+	return nil
+}
+
+// String 은 규칙을 정규화된 RRULE 문자열로 반환합니다.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, wn := range r.ByDay {
+			day := strings.ToUpper(wn.Weekday.String()[:2])
+			if wn.N != 0 {
+				day = strconv.Itoa(wn.N) + day
+			}
+			days = append(days, day)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Next 는 occurrence 번째 발생일인 prev 다음의 발생일을 반환합니다.
+// 시각과 요일은 prev 의 시간대를 기준으로 하며, COUNT 나 UNTIL 에 도달하면 false 를 반환합니다.
+func (r *Rule) Next(prev time.Time, occurrence int) (time.Time, bool) {
+	if r.Count > 0 && occurrence >= r.Count {
+		return time.Time{}, false
+	}
+
+	for k := 0; k <= maxPeriods; k++ {
+		for _, candidate := range r.candidates(prev, k*r.Interval) {
+			if !candidate.After(prev) {
+				continue
+			}
+			if r.Until != nil && candidate.After(*r.Until) {
+				return time.Time{}, false
+			}
+			return candidate, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// candidates 는 prev 가 속한 주기에서 offset 만큼 떨어진 주기의 발생일 후보를 시간순으로 반환합니다.
+func (r *Rule) candidates(prev time.Time, offset int) []time.Time {
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, prev.Hour(), prev.Minute(), prev.Second(), prev.Nanosecond(), prev.Location())
+	}
+
+	switch r.Freq {
+	case Daily:
+		day := prev.AddDate(0, 0, offset)
+		if len(r.ByDay) > 0 && !r.matchesWeekday(day.Weekday()) {
+			return nil
+		}
+		return []time.Time{day}
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			return []time.Time{prev.AddDate(0, 0, 7*offset)}
+		}
+		// 주의 시작은 월요일(WKST=MO)입니다.
+		sinceMonday := (int(prev.Weekday()) + 6) % 7
+		monday := at(prev.Year(), prev.Month(), prev.Day()-sinceMonday+7*offset)
+		result := make([]time.Time, 0, len(r.ByDay))
+		for i := 0; i < 7; i++ {
+			day := monday.AddDate(0, 0, i)
+			if r.matchesWeekday(day.Weekday()) {
+				result = append(result, day)
+			}
+		}
+		return result
+	case Monthly:
+		first := time.Date(prev.Year(), prev.Month()+time.Month(offset), 1, 0, 0, 0, 0, prev.Location())
+		year, month := first.Year(), first.Month()
+		daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, prev.Location()).Day()
+		days := r.monthDays(year, month, daysInMonth, prev)
+		result := make([]time.Time, 0, len(days))
+		for _, d := range days {
+			result = append(result, at(year, month, d))
+		}
+		return result
+	case Yearly:
+		year := prev.Year() + offset
+		day := at(year, prev.Month(), prev.Day())
+		// 2 월 29 일처럼 해당 연도에 없는 날짜는 건너뜁니다.
+		if day.Month() != prev.Month() {
+			return nil
+		}
+		return []time.Time{day}
+	}
+
+	return nil
+}
+
+// monthDays 는 달 안에서 규칙에 맞는 날짜를 오름차순으로 반환합니다.
+func (r *Rule) monthDays(year int, month time.Month, daysInMonth int, prev time.Time) []int {
+	set := map[int]bool{}
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = daysInMonth + d + 1
+			}
+			if d >= 1 && d <= daysInMonth {
+				set[d] = true
+			}
+		}
+	case len(r.ByDay) > 0:
+		firstWeekday := time.Date(year, month, 1, 0, 0, 0, 0, prev.Location()).Weekday()
+		for _, wn := range r.ByDay {
+			first := 1 + (int(wn.Weekday)-int(firstWeekday)+7)%7
+			matches := make([]int, 0, 5)
+			for d := first; d <= daysInMonth; d += 7 {
+				matches = append(matches, d)
+			}
+			switch {
+			case wn.N == 0:
+				for _, d := range matches {
+					set[d] = true
+				}
+			case wn.N > 0 && wn.N <= len(matches):
+				set[matches[wn.N-1]] = true
+			case wn.N < 0 && -wn.N <= len(matches):
+				set[matches[len(matches)+wn.N]] = true
+			}
+		}
+	default:
+		// 31 일처럼 해당 달에 없는 날짜는 건너뜁니다.
+		if prev.Day() <= daysInMonth {
+			set[prev.Day()] = true
+		}
+	}
+
+	days := make([]int, 0, len(set))
+	for d := range set {
+		days = append(days, d)
+	}
+	sort.Ints(days)
+
+	return days
+}
+
+func (r *Rule) matchesWeekday(weekday time.Weekday) bool {
+	for _, wn := range r.ByDay {
+		if wn.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func parseWeekdayNum(s string) (WeekdayNum, error) {
+	if len(s) < 2 {
+		return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY %s", ErrInvalidRule, s)
+	}
+	weekday, ok := weekdays[s[len(s)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY %s", ErrInvalidRule, s)
+	}
+
+	n := 0
+	if prefix := s[:len(s)-2]; prefix != "" {
+		var err error
+		n, err = strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY %s", ErrInvalidRule, s)
+		}
+	}
+
+	return WeekdayNum{N: n, Weekday: weekday}, nil
+}
+
+func parseUntil(s string) (time.Time, error) {
+	if t, err := time.Parse(untilLayout, s); err == nil {
+		return t, nil
+	}
+	// 날짜만 있으면 그 날의 끝까지 포함합니다.
+	if t, err := time.Parse(untilDateLayout, s); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: invalid UNTIL %s", ErrInvalidRule, s)
+}
@@ -0,0 +1,109 @@
+package recurrence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	t.Run("정규화", func(t *testing.T) {
+		rule, err := Parse("RRULE:freq=weekly;byday=MO,fr;interval=1")
+		assert.NoError(t, err)
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,FR", rule.String())
+	})
+	t.Run("UNTIL 날짜만", func(t *testing.T) {
+		rule, err := Parse("FREQ=DAILY;UNTIL=20261231")
+		assert.NoError(t, err)
+		assert.Equal(t, "FREQ=DAILY;UNTIL=20261231T235959Z", rule.String())
+	})
+	t.Run("잘못된 규칙", func(t *testing.T) {
+		for _, rrule := range []string{
+			"",
+			"INTERVAL=2",
+			"FREQ=HOURLY",
+			"FREQ=DAILY;COUNT=0",
+			"FREQ=DAILY;COUNT=3;UNTIL=20261231",
+			"FREQ=DAILY;FREQ=WEEKLY",
+			"FREQ=WEEKLY;BYDAY=1MO",
+			"FREQ=WEEKLY;BYMONTHDAY=1",
+			"FREQ=MONTHLY;BYDAY=MO;BYMONTHDAY=1",
+			"FREQ=YEARLY;BYDAY=MO",
+			"FREQ=DAILY;BYSETPOS=1",
+			"FREQ=MONTHLY;BYDAY=6MO",
+		} {
+			_, err := Parse(rrule)
+			assert.True(t, errors.Is(err, ErrInvalidRule), rrule)
+		}
+	})
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		name       string
+		rrule      string
+		prev       time.Time
+		occurrence int
+		next       time.Time
+		ok         bool
+	}{
+		{"매일", "FREQ=DAILY", date(2026, 10, 18), 1, date(2026, 10, 19), true},
+		{"이틀마다", "FREQ=DAILY;INTERVAL=2", date(2026, 10, 31), 1, date(2026, 11, 2), true},
+		{"평일마다", "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", date(2026, 10, 16), 1, date(2026, 10, 19), true},
+		{"매주", "FREQ=WEEKLY", date(2026, 10, 18), 1, date(2026, 10, 25), true},
+		{"매주 월요일", "FREQ=WEEKLY;BYDAY=MO", date(2026, 10, 19), 1, date(2026, 10, 26), true},
+		{"매주 월, 목요일", "FREQ=WEEKLY;BYDAY=MO,TH", date(2026, 10, 19), 1, date(2026, 10, 22), true},
+		{"격주 월, 목요일", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH", date(2026, 10, 22), 1, date(2026, 11, 2), true},
+		{"매달", "FREQ=MONTHLY", date(2026, 10, 18), 1, date(2026, 11, 18), true},
+		{"31 일이 없는 달은 건너뜀", "FREQ=MONTHLY", date(2026, 1, 31), 1, date(2026, 3, 31), true},
+		{"매달 마지막 날", "FREQ=MONTHLY;BYMONTHDAY=-1", date(2026, 1, 31), 1, date(2026, 2, 28), true},
+		{"매달 1 일과 15 일", "FREQ=MONTHLY;BYMONTHDAY=1,15", date(2026, 10, 1), 1, date(2026, 10, 15), true},
+		{"매달 마지막 금요일", "FREQ=MONTHLY;BYDAY=-1FR", date(2026, 10, 30), 1, date(2026, 11, 27), true},
+		{"매달 두 번째 화요일", "FREQ=MONTHLY;BYDAY=2TU", date(2026, 10, 13), 1, date(2026, 11, 10), true},
+		{"매년", "FREQ=YEARLY", date(2026, 10, 18), 1, date(2027, 10, 18), true},
+		{"윤일은 윤년에만", "FREQ=YEARLY", date(2024, 2, 29), 1, date(2028, 2, 29), true},
+		{"COUNT 도달", "FREQ=DAILY;COUNT=3", date(2026, 10, 18), 3, time.Time{}, false},
+		{"COUNT 남음", "FREQ=DAILY;COUNT=3", date(2026, 10, 18), 2, date(2026, 10, 19), true},
+		{"UNTIL 이후", "FREQ=WEEKLY;UNTIL=20261020T000000Z", date(2026, 10, 18), 1, time.Time{}, false},
+		{"UNTIL 당일", "FREQ=WEEKLY;UNTIL=20261025", date(2026, 10, 18), 1, date(2026, 10, 25), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := Parse(test.rrule)
+			assert.NoError(t, err)
+
+			next, ok := rule.Next(test.prev, test.occurrence)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.next, next)
+		})
+	}
+
+	t.Run("시간대 유지", func(t *testing.T) {
+		seoul := time.FixedZone("KST", 9*60*60)
+		rule, err := Parse("FREQ=WEEKLY;BYDAY=MO")
+		assert.NoError(t, err)
+
+		// 서울 기준 월요일 오전 8 시는 UTC 로 일요일입니다.
+		next, ok := rule.Next(time.Date(2026, 10, 19, 8, 0, 0, 0, seoul), 1)
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2026, 10, 26, 8, 0, 0, 0, seoul), next)
+	})
+	t.Run("서머타임이 끝나도 같은 시각", func(t *testing.T) {
+		newYork, err := time.LoadLocation("America/New_York")
+		assert.NoError(t, err)
+		rule, err := Parse("FREQ=WEEKLY;BYDAY=MO")
+		assert.NoError(t, err)
+
+		// 2026 년 11 월 1 일에 서머타임이 끝나므로 UTC 기준으로는 한 시간 늦어집니다.
+		next, ok := rule.Next(time.Date(2026, 10, 26, 9, 0, 0, 0, newYork), 1)
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2026, 11, 2, 14, 0, 0, 0, time.UTC), next.UTC())
+	})
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/checklistitem"
+	"halill/ent/todo"
+)
+
+type ChecklistItemRepository interface {
+	Get(context.Context, int64) (*ent.ChecklistItem, error)
+	Create(context.Context, *ent.ChecklistItem) (*ent.ChecklistItem, error)
+	Update(context.Context, *ent.ChecklistItem) (*ent.ChecklistItem, error)
+	Delete(context.Context, int64) (*ent.ChecklistItem, error)
+	Reorder(context.Context, int64, []int64) error
+}
+
+type checklistItemRepositoryImpl struct {
+	db *ent.Client
+}
+
+func NewChecklistItemRepository(db *ent.Client) ChecklistItemRepository {
+	return &checklistItemRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *checklistItemRepositoryImpl) Get(ctx context.Context, itemID int64) (*ent.ChecklistItem, error) {
+	item, err := r.db.ChecklistItem.Query().
+		Where(checklistitem.ID(itemID)).
+		WithTodo().
+		Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrChecklistItemNotFound
+		}
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Create 는 새 항목을 Todo 의 체크리스트 맨 뒤에 추가합니다.
+func (r *checklistItemRepositoryImpl) Create(ctx context.Context, item *ent.ChecklistItem) (*ent.ChecklistItem, error) {
+	todoID := item.Edges.Todo.ID
+	last, err := r.db.ChecklistItem.Query().
+		Where(checklistitem.HasTodoWith(todo.ID(todoID))).
+		Order(ent.Desc(checklistitem.FieldPosition)).
+		First(ctx)
+	position := 0
+	if err == nil {
+		position = last.Position + 1
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	newItem, err := r.db.ChecklistItem.Create().
+		SetTitle(item.Title).
+		SetIsChecked(item.IsChecked).
+		SetPosition(position).
+		SetTodoID(todoID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, newItem.ID)
+}
+
+func (r *checklistItemRepositoryImpl) Update(ctx context.Context, item *ent.ChecklistItem) (*ent.ChecklistItem, error) {
+	err := r.db.ChecklistItem.UpdateOneID(item.ID).
+		SetTitle(item.Title).
+		SetIsChecked(item.IsChecked).
+		Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrChecklistItemNotFound
+		}
+		return nil, err
+	}
+
+	return r.Get(ctx, item.ID)
+}
+
+func (r *checklistItemRepositoryImpl) Delete(ctx context.Context, itemID int64) (*ent.ChecklistItem, error) {
+	item, err := r.Get(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.ChecklistItem.DeleteOneID(itemID).Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrChecklistItemNotFound
+		}
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Reorder 는 itemIDs 의 순서대로 Todo 의 체크리스트 항목 position 을 다시 매깁니다.
+// itemIDs 가 Todo 의 항목과 같은 집합인지는 호출하는 쪽에서 확인합니다.
+func (r *checklistItemRepositoryImpl) Reorder(ctx context.Context, todoID int64, itemIDs []int64) error {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for position, itemID := range itemIDs {
+		_, err := tx.ChecklistItem.Update().
+			Where(checklistitem.ID(itemID), checklistitem.HasTodoWith(todo.ID(todoID))).
+			SetPosition(position).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"errors"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/privacy"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecklistItemRepository(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	cr := NewChecklistItemRepository(client)
+	todo, err := tr.Create(ctx, &ent.Todo{Title: "이사 준비", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	items := make([]*ent.ChecklistItem, 0)
+
+	t.Run("항목은 체크리스트 맨 뒤에 추가", func(t *testing.T) {
+		for _, title := range []string{"짐 싸기", "청소", "전입신고"} {
+			item, err := cr.Create(ctx, &ent.ChecklistItem{Title: title, Edges: ent.ChecklistItemEdges{Todo: &ent.Todo{ID: todo.ID}}})
+			assert.NoError(t, err)
+			assert.Equal(t, len(items), item.Position)
+			assert.Equal(t, todo.ID, item.Edges.Todo.ID)
+			items = append(items, item)
+		}
+
+		todo, err := tr.Get(ctx, todo.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todo.Edges.ChecklistItems, 3)
+		assert.Equal(t, "짐 싸기", todo.Edges.ChecklistItems[0].Title)
+	})
+	t.Run("항목 체크", func(t *testing.T) {
+		items[1].IsChecked = true
+		item, err := cr.Update(ctx, items[1])
+		assert.NoError(t, err)
+		assert.True(t, item.IsChecked)
+	})
+	t.Run("순서 변경", func(t *testing.T) {
+		err := cr.Reorder(ctx, todo.ID, []int64{items[2].ID, items[0].ID, items[1].ID})
+		assert.NoError(t, err)
+
+		todo, err := tr.Get(ctx, todo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, items[2].ID, todo.Edges.ChecklistItems[0].ID)
+		assert.Equal(t, items[0].ID, todo.Edges.ChecklistItems[1].ID)
+		assert.Equal(t, items[1].ID, todo.Edges.ChecklistItems[2].ID)
+	})
+	t.Run("항목 삭제", func(t *testing.T) {
+		_, err := cr.Delete(ctx, items[0].ID)
+		assert.NoError(t, err)
+
+		_, err = cr.Get(ctx, items[0].ID)
+		assert.Equal(t, apperror.ErrChecklistItemNotFound, err)
+	})
+	t.Run("Todo 를 완전히 삭제하면 항목도 삭제", func(t *testing.T) {
+		_, err := tr.Delete(ctx, todo.ID)
+		assert.NoError(t, err)
+		_, err = tr.Purge(ctx, time.Now().Add(time.Second))
+		assert.NoError(t, err)
+
+		_, err = cr.Get(ctx, items[1].ID)
+		assert.Equal(t, apperror.ErrChecklistItemNotFound, err)
+	})
+}
+
+func TestChecklistItemRepositoryPrivacy(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	otherCtx := viewerContext(other.ID)
+	tr := NewTodoRepository(client)
+	cr := NewChecklistItemRepository(client)
+	todo, err := tr.Create(ctx, &ent.Todo{Title: "이사 준비", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	item, err := cr.Create(ctx, &ent.ChecklistItem{Title: "짐 싸기", Edges: ent.ChecklistItemEdges{Todo: &ent.Todo{ID: todo.ID}}})
+	assert.NoError(t, err)
+
+	t.Run("다른 사용자의 Todo 항목은 없는 항목", func(t *testing.T) {
+		_, err := cr.Get(otherCtx, item.ID)
+		assert.Equal(t, apperror.ErrChecklistItemNotFound, err)
+		_, err = cr.Update(otherCtx, &ent.ChecklistItem{ID: item.ID, Title: "청소", IsChecked: true})
+		assert.Equal(t, apperror.ErrChecklistItemNotFound, err)
+		_, err = cr.Delete(otherCtx, item.ID)
+		assert.Equal(t, apperror.ErrChecklistItemNotFound, err)
+	})
+	t.Run("다른 사용자의 Todo 에 항목 추가 불가", func(t *testing.T) {
+		_, err := cr.Create(otherCtx, &ent.ChecklistItem{Title: "청소", Edges: ent.ChecklistItemEdges{Todo: &ent.Todo{ID: todo.ID}}})
+		assert.True(t, errors.Is(err, privacy.Deny))
+
+		todo, err := tr.Get(ctx, todo.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todo.Edges.ChecklistItems, 1)
+	})
+}
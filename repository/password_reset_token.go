@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/passwordresettoken"
+	"halill/ent/user"
+	"time"
+)
+
+type PasswordResetTokenRepository interface {
+	GetByHash(context.Context, string) (*ent.PasswordResetToken, error)
+	Create(context.Context, *ent.PasswordResetToken) (*ent.PasswordResetToken, error)
+	Use(context.Context, int64, time.Time) (bool, error)
+	InvalidateAllByUserID(context.Context, int64, time.Time) error
+}
+
+type passwordResetTokenRepositoryImpl struct {
+	db *ent.Client
+}
+
+func NewPasswordResetTokenRepository(db *ent.Client) PasswordResetTokenRepository {
+	return &passwordResetTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *passwordResetTokenRepositoryImpl) GetByHash(ctx context.Context, hash string) (*ent.PasswordResetToken, error) {
+	prt, err := r.db.PasswordResetToken.Query().
+		Where(passwordresettoken.TokenHash(hash)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrInvalidResetToken
+		}
+		return nil, err
+	}
+
+	return prt, nil
+}
+
+func (r *passwordResetTokenRepositoryImpl) Create(ctx context.Context, prt *ent.PasswordResetToken) (*ent.PasswordResetToken, error) {
+	newToken, err := r.db.PasswordResetToken.Create().
+		SetTokenHash(prt.TokenHash).
+		SetExpiresAt(prt.ExpiresAt).
+		SetUserID(prt.Edges.User.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newToken, nil
+}
+
+// Use 는 아직 사용하지 않았고 now 에 만료되지 않은 토큰만 사용한 것으로 표시합니다.
+// 같은 토큰으로 동시에 요청이 들어와도 하나만 true 를 받습니다.
+func (r *passwordResetTokenRepositoryImpl) Use(ctx context.Context, id int64, now time.Time) (bool, error) {
+	n, err := r.db.PasswordResetToken.Update().
+		Where(
+			passwordresettoken.ID(id),
+			passwordresettoken.UsedAtIsNil(),
+			passwordresettoken.ExpiresAtGT(now),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// InvalidateAllByUserID 은 사용자의 남은 재설정 토큰을 모두 사용한 것으로 표시합니다.
+func (r *passwordResetTokenRepositoryImpl) InvalidateAllByUserID(ctx context.Context, userID int64, now time.Time) error {
+	_, err := r.db.PasswordResetToken.Update().
+		Where(
+			passwordresettoken.HasUserWith(user.ID(userID)),
+			passwordresettoken.UsedAtIsNil(),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	return err
+}
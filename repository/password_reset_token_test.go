@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/ent"
+	"halill/viewer"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordResetTokenRepository(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewer.NewSystemContext(context.Background())
+	prr := NewPasswordResetTokenRepository(client)
+	now := time.Now()
+	create := func(hash string, expiresAt time.Time) *ent.PasswordResetToken {
+		prt, err := prr.Create(ctx, &ent.PasswordResetToken{
+			TokenHash: hash,
+			ExpiresAt: expiresAt,
+			Edges:     ent.PasswordResetTokenEdges{User: user},
+		})
+		assert.NoError(t, err)
+		return prt
+	}
+	valid := create("hash1", now.Add(time.Hour))
+	expired := create("hash2", now.Add(-time.Minute))
+	other := create("hash3", now.Add(time.Hour))
+
+	t.Run("토큰 조회 성공", func(t *testing.T) {
+		prt, err := prr.GetByHash(ctx, "hash1")
+		assert.NoError(t, err)
+		assert.Equal(t, valid.ID, prt.ID)
+		assert.Nil(t, prt.UsedAt)
+		assert.Equal(t, user.ID, prt.Edges.User.ID)
+	})
+	t.Run("존재하지 않는 토큰", func(t *testing.T) {
+		_, err := prr.GetByHash(ctx, "unknown")
+		assert.Equal(t, apperror.ErrInvalidResetToken, err)
+	})
+	t.Run("한 번만 사용 가능", func(t *testing.T) {
+		used, err := prr.Use(ctx, valid.ID, now)
+		assert.NoError(t, err)
+		assert.True(t, used)
+
+		used, err = prr.Use(ctx, valid.ID, now)
+		assert.NoError(t, err)
+		assert.False(t, used)
+	})
+	t.Run("만료된 토큰은 사용 불가", func(t *testing.T) {
+		used, err := prr.Use(ctx, expired.ID, now)
+		assert.NoError(t, err)
+		assert.False(t, used)
+	})
+	t.Run("남은 토큰 모두 무효화", func(t *testing.T) {
+		err := prr.InvalidateAllByUserID(ctx, user.ID, now)
+		assert.NoError(t, err)
+
+		used, err := prr.Use(ctx, other.ID, now)
+		assert.NoError(t, err)
+		assert.False(t, used)
+	})
+}
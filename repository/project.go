@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/project"
+	"halill/ent/todo"
+	"halill/ent/user"
+	"time"
+)
+
+type ProjectRepository interface {
+	GetAllByUserID(context.Context, int64, *bool) ([]*ent.Project, error)
+	Get(context.Context, int64) (*ent.Project, error)
+	Create(context.Context, *ent.Project) (*ent.Project, error)
+	Update(context.Context, *ent.Project) (*ent.Project, error)
+	Delete(context.Context, int64, bool) (*ent.Project, error)
+}
+
+type projectRepositoryImpl struct {
+	db *ent.Client
+}
+
+func NewProjectRepository(db *ent.Client) ProjectRepository {
+	return &projectRepositoryImpl{
+		db: db,
+	}
+}
+
+// GetAllByUserID 은 사용자의 프로젝트를 position 순서로 반환합니다.
+// archived 가 nil 이면 보관 여부와 관계없이 모두 반환합니다.
+func (r *projectRepositoryImpl) GetAllByUserID(ctx context.Context, userID int64, archived *bool) ([]*ent.Project, error) {
+	query := r.db.Project.Query().
+		Where(project.HasUserWith(user.ID(userID)))
+	if archived != nil {
+		query.Where(project.Archived(*archived))
+	}
+
+	return query.
+		Order(ent.Asc(project.FieldPosition), ent.Asc(project.FieldID)).
+		WithUser().
+		All(ctx)
+}
+
+func (r *projectRepositoryImpl) Get(ctx context.Context, projectID int64) (*ent.Project, error) {
+	p, err := r.db.Project.Query().
+		Where(project.ID(projectID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Create 는 새 프로젝트를 사용자의 프로젝트 목록 맨 뒤에 추가합니다.
+func (r *projectRepositoryImpl) Create(ctx context.Context, p *ent.Project) (*ent.Project, error) {
+	last, err := r.db.Project.Query().
+		Where(project.HasUserWith(user.ID(p.Edges.User.ID))).
+		Order(ent.Desc(project.FieldPosition)).
+		First(ctx)
+	position := 0
+	if err == nil {
+		position = last.Position + 1
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	newProject, err := r.db.Project.Create().
+		SetName(p.Name).
+		SetColor(p.Color).
+		SetPosition(position).
+		SetUserID(p.Edges.User.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, newProject.ID)
+}
+
+func (r *projectRepositoryImpl) Update(ctx context.Context, p *ent.Project) (*ent.Project, error) {
+	err := r.db.Project.UpdateOneID(p.ID).
+		SetName(p.Name).
+		SetColor(p.Color).
+		SetPosition(p.Position).
+		SetArchived(p.Archived).
+		Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	return r.Get(ctx, p.ID)
+}
+
+// Delete 는 프로젝트를 삭제합니다. cascade 이면 프로젝트의 Todo 를 휴지통으로 보내고,
+// 아니면 Todo 를 Inbox(프로젝트 없음)로 옮깁니다. 휴지통의 Todo 도 복원하면 Inbox 로 돌아옵니다.
+func (r *projectRepositoryImpl) Delete(ctx context.Context, projectID int64, cascade bool) (*ent.Project, error) {
+	p, err := r.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cascade {
+		_, err = tx.Todo.Update().
+			Where(todo.HasProjectWith(project.ID(projectID)), todo.DeletedAtIsNil()).
+			SetDeletedAt(time.Now()).
+			Save(ctx)
+	}
+	if err == nil {
+		_, err = tx.Todo.Update().
+			Where(todo.HasProjectWith(project.ID(projectID))).
+			ClearProject().
+			Save(ctx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Project.DeleteOneID(projectID).Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/privacy"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectRepositoryCreate(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	pr := NewProjectRepository(client)
+
+	t.Run("프로젝트는 목록 맨 뒤에 추가", func(t *testing.T) {
+		first, err := pr.Create(ctx, &ent.Project{Name: "회사", Color: "#ff0000", Edges: ent.ProjectEdges{User: &ent.User{ID: user.ID}}})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, first.Position)
+		assert.Equal(t, "#ff0000", first.Color)
+		assert.False(t, first.Archived)
+
+		second, err := pr.Create(ctx, &ent.Project{Name: "개인", Edges: ent.ProjectEdges{User: &ent.User{ID: user.ID}}})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, second.Position)
+		assert.Equal(t, user.ID, second.Edges.User.ID)
+	})
+}
+
+func TestProjectRepositoryGetAllByUserID(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	pr := NewProjectRepository(client)
+	projects := make([]*ent.Project, 0)
+	for _, owner := range []*ent.User{user, user, user, other} {
+		p, err := pr.Create(viewerContext(owner.ID), &ent.Project{Name: "프로젝트", Edges: ent.ProjectEdges{User: &ent.User{ID: owner.ID}}})
+		assert.NoError(t, err)
+		projects = append(projects, p)
+	}
+	projects[0].Position = 5
+	_, err := pr.Update(ctx, projects[0])
+	assert.NoError(t, err)
+	projects[1].Archived = true
+	_, err = pr.Update(ctx, projects[1])
+	assert.NoError(t, err)
+
+	t.Run("position 순서로 조회", func(t *testing.T) {
+		result, err := pr.GetAllByUserID(ctx, user.ID, nil)
+		assert.NoError(t, err)
+		assert.Len(t, result, 3)
+		assert.Equal(t, projects[1].ID, result[0].ID)
+		assert.Equal(t, projects[2].ID, result[1].ID)
+		assert.Equal(t, projects[0].ID, result[2].ID)
+	})
+	t.Run("보관 여부 필터", func(t *testing.T) {
+		archived := true
+		result, err := pr.GetAllByUserID(ctx, user.ID, &archived)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, projects[1].ID, result[0].ID)
+	})
+}
+
+func TestProjectRepositoryDelete(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	pr := NewProjectRepository(client)
+	tr := NewTodoRepository(client)
+	setup := func(t *testing.T) (*ent.Project, *ent.Todo) {
+		p, err := pr.Create(ctx, &ent.Project{Name: "회사", Edges: ent.ProjectEdges{User: &ent.User{ID: user.ID}}})
+		assert.NoError(t, err)
+		todo, err := tr.Create(ctx, &ent.Todo{Title: "보고서 작성", Edges: ent.TodoEdges{
+			User:    &ent.User{ID: user.ID},
+			Project: &ent.Project{ID: p.ID},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, p.ID, todo.Edges.Project.ID)
+		return p, todo
+	}
+
+	t.Run("Todo 를 Inbox 로 옮기고 삭제", func(t *testing.T) {
+		p, todo := setup(t)
+		_, err := pr.Delete(ctx, p.ID, false)
+		assert.NoError(t, err)
+
+		moved, err := tr.Get(ctx, todo.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, moved.Edges.Project)
+	})
+	t.Run("Todo 는 휴지통으로 보내고 삭제", func(t *testing.T) {
+		p, todo := setup(t)
+		_, err := pr.Delete(ctx, p.ID, true)
+		assert.NoError(t, err)
+
+		_, err = tr.Get(ctx, todo.ID)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+
+		trashed, err := tr.GetTrashed(ctx, todo.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, trashed.Edges.Project)
+	})
+	t.Run("존재하지 않는 프로젝트", func(t *testing.T) {
+		_, err := pr.Delete(ctx, -1, false)
+		assert.Equal(t, apperror.ErrProjectNotFound, err)
+	})
+}
+
+func TestProjectRepositoryPrivacy(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	otherCtx := viewerContext(other.ID)
+	pr := NewProjectRepository(client)
+	project, err := pr.Create(ctx, &ent.Project{Name: "이사", Edges: ent.ProjectEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+
+	t.Run("다른 사용자의 프로젝트는 없는 프로젝트", func(t *testing.T) {
+		_, err := pr.Get(otherCtx, project.ID)
+		assert.Equal(t, apperror.ErrProjectNotFound, err)
+		_, err = pr.Update(otherCtx, &ent.Project{ID: project.ID, Name: "여행"})
+		assert.Equal(t, apperror.ErrProjectNotFound, err)
+		_, err = pr.Delete(otherCtx, project.ID, false)
+		assert.Equal(t, apperror.ErrProjectNotFound, err)
+	})
+	t.Run("다른 사용자의 프로젝트 생성 불가", func(t *testing.T) {
+		_, err := pr.Create(otherCtx, &ent.Project{Name: "여행", Edges: ent.ProjectEdges{User: &ent.User{ID: user.ID}}})
+		assert.True(t, errors.Is(err, privacy.Deny))
+	})
+	t.Run("viewer 가 없으면 거부", func(t *testing.T) {
+		_, err := pr.Get(context.Background(), project.ID)
+		assert.True(t, errors.Is(err, privacy.Deny))
+	})
+}
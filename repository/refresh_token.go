@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/refreshtoken"
+	"halill/ent/user"
+)
+
+type RefreshTokenRepository interface {
+	GetByHash(context.Context, string) (*ent.RefreshToken, error)
+	Create(context.Context, *ent.RefreshToken) (*ent.RefreshToken, error)
+	Revoke(context.Context, int64) (bool, error)
+	RevokeFamily(context.Context, string) error
+	RevokeAllByUserID(context.Context, int64) error
+}
+
+type refreshTokenRepositoryImpl struct {
+	db *ent.Client
+}
+
+func NewRefreshTokenRepository(db *ent.Client) RefreshTokenRepository {
+	return &refreshTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *refreshTokenRepositoryImpl) GetByHash(ctx context.Context, hash string) (*ent.RefreshToken, error) {
+	rt, err := r.db.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(hash)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+func (r *refreshTokenRepositoryImpl) Create(ctx context.Context, rt *ent.RefreshToken) (*ent.RefreshToken, error) {
+	newToken, err := r.db.RefreshToken.Create().
+		SetFamilyID(rt.FamilyID).
+		SetTokenHash(rt.TokenHash).
+		SetExpiresAt(rt.ExpiresAt).
+		SetUserID(rt.Edges.User.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newToken, nil
+}
+
+// Revoke 는 아직 폐기되지 않은 토큰만 폐기합니다.
+// 동시에 같은 토큰으로 요청이 들어와도 하나만 true 를 받습니다.
+func (r *refreshTokenRepositoryImpl) Revoke(ctx context.Context, id int64) (bool, error) {
+	n, err := r.db.RefreshToken.Update().
+		Where(refreshtoken.ID(id), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+func (r *refreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyID string) error {
+	_, err := r.db.RefreshToken.Update().
+		Where(refreshtoken.FamilyID(familyID)).
+		SetRevoked(true).
+		Save(ctx)
+	return err
+}
+
+func (r *refreshTokenRepositoryImpl) RevokeAllByUserID(ctx context.Context, userID int64) error {
+	_, err := r.db.RefreshToken.Update().
+		Where(refreshtoken.HasUserWith(user.ID(userID))).
+		SetRevoked(true).
+		Save(ctx)
+	return err
+}
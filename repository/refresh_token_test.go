@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"halill/apperror"
+	"halill/ent"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestRefreshToken(t *testing.T, rtr RefreshTokenRepository, user *ent.User, familyID, hash string) *ent.RefreshToken {
+	rt, err := rtr.Create(viewerContext(user.ID), &ent.RefreshToken{
+		FamilyID:  familyID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(time.Hour),
+		Edges: ent.RefreshTokenEdges{
+			User: user,
+		},
+	})
+	assert.NoError(t, err)
+	return rt
+}
+
+func TestRefreshTokenRepositoryGetByHash(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	rtr := NewRefreshTokenRepository(client)
+	created := createTestRefreshToken(t, rtr, user, "family", "hash")
+
+	t.Run("토큰 조회 성공", func(t *testing.T) {
+		rt, err := rtr.GetByHash(ctx, "hash")
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, rt.ID)
+		assert.False(t, rt.Revoked)
+		assert.Equal(t, user.ID, rt.Edges.User.ID)
+	})
+	t.Run("존재하지 않는 토큰", func(t *testing.T) {
+		_, err := rtr.GetByHash(ctx, "unknown")
+		assert.Equal(t, apperror.ErrInvalidToken, err)
+	})
+}
+
+func TestRefreshTokenRepositoryRevoke(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	rtr := NewRefreshTokenRepository(client)
+	created := createTestRefreshToken(t, rtr, user, "family", "hash")
+
+	t.Run("한 번만 폐기 가능", func(t *testing.T) {
+		revoked, err := rtr.Revoke(ctx, created.ID)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = rtr.Revoke(ctx, created.ID)
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
+
+func TestRefreshTokenRepositoryRevokeFamily(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	rtr := NewRefreshTokenRepository(client)
+	createTestRefreshToken(t, rtr, user, "family", "hash1")
+	createTestRefreshToken(t, rtr, user, "family", "hash2")
+	createTestRefreshToken(t, rtr, user, "other", "hash3")
+
+	t.Run("같은 family 의 토큰만 폐기", func(t *testing.T) {
+		err := rtr.RevokeFamily(ctx, "family")
+		assert.NoError(t, err)
+
+		for hash, expected := range map[string]bool{"hash1": true, "hash2": true, "hash3": false} {
+			rt, err := rtr.GetByHash(ctx, hash)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, rt.Revoked, hash)
+		}
+	})
+}
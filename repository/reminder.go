@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/reminder"
+	"halill/ent/todo"
+	"time"
+)
+
+// MaxReminderAttempts 는 알림 전송을 재시도하는 최대 횟수입니다. 이 횟수만큼 실패한 알림은 더 이상 보내지 않습니다.
+const MaxReminderAttempts = 5
+
+type ReminderRepository interface {
+	Get(context.Context, int64) (*ent.Reminder, error)
+	Create(context.Context, *ent.Reminder) (*ent.Reminder, error)
+	Delete(context.Context, int64) (*ent.Reminder, error)
+	Reschedule(context.Context, int64, *time.Time) error
+	GetDue(context.Context, time.Time, int) ([]*ent.Reminder, error)
+	Claim(context.Context, int64, string, time.Time, time.Time) (bool, error)
+	MarkSent(context.Context, int64, string, time.Time) error
+	MarkFailed(context.Context, int64, string, string, time.Time) error
+}
+
+type reminderRepositoryImpl struct {
+	db *ent.Client
+}
+
+func NewReminderRepository(db *ent.Client) ReminderRepository {
+	return &reminderRepositoryImpl{
+		db: db,
+	}
+}
+
+// RemindAt 은 마감일 offsetMinutes 분 전의 알림 시각을 반환합니다. 마감일이 없으면 nil 입니다.
+func RemindAt(deadline *time.Time, offsetMinutes int) *time.Time {
+	if deadline == nil {
+		return nil
+	}
+	remindAt := deadline.Add(-time.Duration(offsetMinutes) * time.Minute)
+	return &remindAt
+}
+
+func (r *reminderRepositoryImpl) Get(ctx context.Context, reminderID int64) (*ent.Reminder, error) {
+	rm, err := r.db.Reminder.Query().
+		Where(reminder.ID(reminderID)).
+		WithTodo().
+		Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrReminderNotFound
+		}
+		return nil, err
+	}
+
+	return rm, nil
+}
+
+// Create 는 Todo 의 마감일에서 알림 시각을 계산해 저장합니다.
+func (r *reminderRepositoryImpl) Create(ctx context.Context, rm *ent.Reminder) (*ent.Reminder, error) {
+	t, err := r.db.Todo.Get(ctx, rm.Edges.Todo.ID)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTodoNotFound
+		}
+		return nil, err
+	}
+
+	newReminder, err := r.db.Reminder.Create().
+		SetOffsetMinutes(rm.OffsetMinutes).
+		SetNillableRemindAt(RemindAt(t.Deadline, rm.OffsetMinutes)).
+		SetTodoID(t.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, newReminder.ID)
+}
+
+func (r *reminderRepositoryImpl) Delete(ctx context.Context, reminderID int64) (*ent.Reminder, error) {
+	rm, err := r.Get(ctx, reminderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Reminder.DeleteOneID(reminderID).Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrReminderNotFound
+		}
+		return nil, err
+	}
+
+	return rm, nil
+}
+
+// Reschedule 은 Todo 의 마감일이 바뀌었을 때 알림 시각을 다시 계산합니다.
+// 시각이 바뀐 알림은 이미 보냈더라도 새 시각에 다시 보내도록 전송 기록을 지웁니다.
+func (r *reminderRepositoryImpl) Reschedule(ctx context.Context, todoID int64, deadline *time.Time) error {
+	reminders, err := r.db.Reminder.Query().
+		Where(reminder.HasTodoWith(todo.ID(todoID))).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rm := range reminders {
+		remindAt := RemindAt(deadline, rm.OffsetMinutes)
+		if sameTime(remindAt, rm.RemindAt) {
+			continue
+		}
+
+		update := tx.Reminder.UpdateOneID(rm.ID).
+			ClearSentAt().
+			ClearLockedUntil().
+			SetLockedBy("").
+			SetAttempts(0).
+			SetLastError("")
+		if remindAt != nil {
+			update.SetRemindAt(*remindAt)
+		} else {
+			update.ClearRemindAt()
+		}
+		if err := update.Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetDue 는 now 기준으로 보낼 때가 된 알림을 알림 시각 순서로 최대 limit 개 반환합니다.
+// 다른 인스턴스가 잡고 있는 알림과 완료되었거나 휴지통에 있는 Todo 의 알림은 제외합니다.
+func (r *reminderRepositoryImpl) GetDue(ctx context.Context, now time.Time, limit int) ([]*ent.Reminder, error) {
+	return r.db.Reminder.Query().
+		Where(
+			reminder.SentAtIsNil(),
+			reminder.RemindAtLTE(now),
+			reminder.Or(reminder.LockedUntilIsNil(), reminder.LockedUntilLTE(now)),
+			reminder.AttemptsLT(MaxReminderAttempts),
+			reminder.HasTodoWith(todo.IsCompleted(false), todo.DeletedAtIsNil()),
+		).
+		Order(ent.Asc(reminder.FieldRemindAt), ent.Asc(reminder.FieldID)).
+		Limit(limit).
+		WithTodo(func(q *ent.TodoQuery) {
+			q.WithUser()
+		}).
+		All(ctx)
+}
+
+// Claim 은 알림을 until 까지 owner 가 보내도록 잡습니다.
+// 조건부 UPDATE 한 번으로 잡으므로 여러 인스턴스가 동시에 시도해도 한 곳만 성공하고,
+// 보내는 도중 인스턴스가 죽으면 until 이 지난 뒤 다른 인스턴스가 다시 잡을 수 있습니다.
+func (r *reminderRepositoryImpl) Claim(ctx context.Context, reminderID int64, owner string, now time.Time, until time.Time) (bool, error) {
+	n, err := r.db.Reminder.Update().
+		Where(
+			reminder.ID(reminderID),
+			reminder.SentAtIsNil(),
+			reminder.Or(reminder.LockedUntilIsNil(), reminder.LockedUntilLTE(now)),
+		).
+		SetLockedUntil(until).
+		SetLockedBy(owner).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+func (r *reminderRepositoryImpl) MarkSent(ctx context.Context, reminderID int64, owner string, sentAt time.Time) error {
+	_, err := r.db.Reminder.Update().
+		Where(reminder.ID(reminderID), reminder.LockedBy(owner)).
+		SetSentAt(sentAt).
+		ClearLockedUntil().
+		SetLastError("").
+		Save(ctx)
+	return err
+}
+
+// MarkFailed 는 실패 횟수를 늘리고 retryAt 이후에 다시 보내도록 합니다.
+func (r *reminderRepositoryImpl) MarkFailed(ctx context.Context, reminderID int64, owner string, message string, retryAt time.Time) error {
+	if runes := []rune(message); len(runes) > 255 {
+		message = string(runes[:255])
+	}
+	_, err := r.db.Reminder.Update().
+		Where(reminder.ID(reminderID), reminder.LockedBy(owner)).
+		AddAttempts(1).
+		SetLastError(message).
+		SetLockedUntil(retryAt).
+		Save(ctx)
+	return err
+}
+
+func sameTime(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
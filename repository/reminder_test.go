@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"halill/apperror"
+	"halill/ent"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReminderRepository(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	rr := NewReminderRepository(client)
+	deadline := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	todo, err := tr.Create(ctx, &ent.Todo{
+		Title:    "보고서 제출",
+		Deadline: &deadline,
+		Edges:    ent.TodoEdges{User: &ent.User{ID: user.ID}},
+	})
+	assert.NoError(t, err)
+	dayBefore, err := rr.Create(ctx, &ent.Reminder{OffsetMinutes: 24 * 60, Edges: ent.ReminderEdges{Todo: todo}})
+	assert.NoError(t, err)
+	hourBefore, err := rr.Create(ctx, &ent.Reminder{OffsetMinutes: 60, Edges: ent.ReminderEdges{Todo: todo}})
+	assert.NoError(t, err)
+
+	t.Run("마감일에서 알림 시각 계산", func(t *testing.T) {
+		assert.True(t, deadline.Add(-24*time.Hour).Equal(*dayBefore.RemindAt))
+		assert.True(t, deadline.Add(-time.Hour).Equal(*hourBefore.RemindAt))
+
+		todo, err := tr.Get(ctx, todo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{dayBefore.ID, hourBefore.ID}, []int64{todo.Edges.Reminders[0].ID, todo.Edges.Reminders[1].ID})
+	})
+	t.Run("보낼 때가 된 알림만 조회", func(t *testing.T) {
+		due, err := rr.GetDue(ctx, deadline.Add(-2*time.Hour), 10)
+		assert.NoError(t, err)
+		assert.Len(t, due, 1)
+		assert.Equal(t, dayBefore.ID, due[0].ID)
+		assert.Equal(t, user.ID, due[0].Edges.Todo.Edges.User.ID)
+	})
+	t.Run("한 인스턴스만 잡을 수 있음", func(t *testing.T) {
+		now := deadline.Add(-2 * time.Hour)
+		claimed, err := rr.Claim(ctx, dayBefore.ID, "server-1", now, now.Add(5*time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = rr.Claim(ctx, dayBefore.ID, "server-2", now, now.Add(5*time.Minute))
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+
+		due, err := rr.GetDue(ctx, now, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, due)
+	})
+	t.Run("임대가 끝나면 다른 인스턴스가 잡음", func(t *testing.T) {
+		now := deadline.Add(-2*time.Hour + 10*time.Minute)
+		claimed, err := rr.Claim(ctx, dayBefore.ID, "server-2", now, now.Add(5*time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+	})
+	t.Run("실패하면 retryAt 이후에 다시 조회", func(t *testing.T) {
+		now := deadline.Add(-2*time.Hour + 10*time.Minute)
+		err := rr.MarkFailed(ctx, dayBefore.ID, "server-2", "smtp down", now.Add(time.Minute))
+		assert.NoError(t, err)
+
+		due, err := rr.GetDue(ctx, now, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, due)
+
+		due, err = rr.GetDue(ctx, now.Add(time.Minute), 10)
+		assert.NoError(t, err)
+		assert.Len(t, due, 1)
+		assert.Equal(t, 1, due[0].Attempts)
+		assert.Equal(t, "smtp down", due[0].LastError)
+	})
+	t.Run("보낸 알림은 다시 보내지 않음", func(t *testing.T) {
+		now := deadline.Add(-time.Hour)
+		claimed, err := rr.Claim(ctx, dayBefore.ID, "server-1", now, now.Add(5*time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+		err = rr.MarkSent(ctx, dayBefore.ID, "server-1", now)
+		assert.NoError(t, err)
+
+		due, err := rr.GetDue(ctx, now, 10)
+		assert.NoError(t, err)
+		assert.Len(t, due, 1)
+		assert.Equal(t, hourBefore.ID, due[0].ID)
+	})
+	t.Run("마감일이 바뀌면 다시 알림", func(t *testing.T) {
+		later := deadline.Add(48 * time.Hour)
+		err := rr.Reschedule(ctx, todo.ID, &later)
+		assert.NoError(t, err)
+
+		rm, err := rr.Get(ctx, dayBefore.ID)
+		assert.NoError(t, err)
+		assert.True(t, later.Add(-24*time.Hour).Equal(*rm.RemindAt))
+		assert.Nil(t, rm.SentAt)
+		assert.Zero(t, rm.Attempts)
+
+		err = rr.Reschedule(ctx, todo.ID, nil)
+		assert.NoError(t, err)
+		due, err := rr.GetDue(ctx, later, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, due)
+	})
+	t.Run("반복 Todo 의 다음 회차로 알림 복사", func(t *testing.T) {
+		nextDeadline := deadline.AddDate(0, 0, 7)
+		_, err := tr.Complete(ctx, todo.ID, &nextDeadline)
+		assert.NoError(t, err)
+
+		todos, err := tr.GetOccurrences(ctx, todo.ID)
+		assert.NoError(t, err)
+		next := todos[len(todos)-1]
+		assert.Len(t, next.Edges.Reminders, 2)
+		assert.True(t, nextDeadline.Add(-24*time.Hour).Equal(*next.Edges.Reminders[0].RemindAt))
+		assert.Nil(t, next.Edges.Reminders[0].SentAt)
+	})
+	t.Run("알림 삭제", func(t *testing.T) {
+		_, err := rr.Delete(ctx, hourBefore.ID)
+		assert.NoError(t, err)
+
+		_, err = rr.Get(ctx, hourBefore.ID)
+		assert.Equal(t, apperror.ErrReminderNotFound, err)
+	})
+}
+
+func TestReminderRepositoryPrivacy(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	otherCtx := viewerContext(other.ID)
+	tr := NewTodoRepository(client)
+	rr := NewReminderRepository(client)
+	deadline := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	todo, err := tr.Create(ctx, &ent.Todo{
+		Title:    "보고서 제출",
+		Deadline: &deadline,
+		Edges:    ent.TodoEdges{User: &ent.User{ID: user.ID}},
+	})
+	assert.NoError(t, err)
+	reminder, err := rr.Create(ctx, &ent.Reminder{OffsetMinutes: 60, Edges: ent.ReminderEdges{Todo: todo}})
+	assert.NoError(t, err)
+
+	t.Run("다른 사용자의 Todo 알림은 없는 알림", func(t *testing.T) {
+		_, err := rr.Get(otherCtx, reminder.ID)
+		assert.Equal(t, apperror.ErrReminderNotFound, err)
+		_, err = rr.Delete(otherCtx, reminder.ID)
+		assert.Equal(t, apperror.ErrReminderNotFound, err)
+	})
+	t.Run("다른 사용자의 Todo 에 알림 추가 불가", func(t *testing.T) {
+		_, err := rr.Create(otherCtx, &ent.Reminder{OffsetMinutes: 30, Edges: ent.ReminderEdges{Todo: todo}})
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+	})
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"halill/ent"
+	"halill/ent/revokedtoken"
+	"time"
+)
+
+type RevokedTokenRepository interface {
+	Create(context.Context, *ent.RevokedToken) (*ent.RevokedToken, error)
+	Exists(context.Context, string) (bool, error)
+	DeleteExpired(context.Context, time.Time) (int, error)
+}
+
+type revokedTokenRepositoryImpl struct {
+	db *ent.Client
+}
+
+func NewRevokedTokenRepository(db *ent.Client) RevokedTokenRepository {
+	return &revokedTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *revokedTokenRepositoryImpl) Create(ctx context.Context, rt *ent.RevokedToken) (*ent.RevokedToken, error) {
+	newToken, err := r.db.RevokedToken.Create().
+		SetJti(rt.Jti).
+		SetExpiresAt(rt.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		// 이미 폐기된 토큰을 다시 폐기하는 것은 실패가 아님
+		if ent.IsConstraintError(err) {
+			return rt, nil
+		}
+		return nil, err
+	}
+
+	return newToken, nil
+}
+
+func (r *revokedTokenRepositoryImpl) Exists(ctx context.Context, jti string) (bool, error) {
+	return r.db.RevokedToken.Query().
+		Where(revokedtoken.Jti(jti)).
+		Exist(ctx)
+}
+
+// DeleteExpired 는 이미 만료되어 검사할 필요가 없는 폐기 기록을 지웁니다.
+func (r *revokedTokenRepositoryImpl) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return r.db.RevokedToken.Delete().
+		Where(revokedtoken.ExpiresAtLT(now)).
+		Exec(ctx)
+}
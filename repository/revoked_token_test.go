@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"halill/ent"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokedTokenRepository(t *testing.T) {
+	client := newTestClient(t)
+	rvr := NewRevokedTokenRepository(client)
+	ctx := context.Background()
+
+	t.Run("폐기된 토큰 조회 성공", func(t *testing.T) {
+		_, err := rvr.Create(ctx, &ent.RevokedToken{Jti: "jti", ExpiresAt: time.Now().Add(time.Hour)})
+		assert.NoError(t, err)
+
+		exists, err := rvr.Exists(ctx, "jti")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = rvr.Exists(ctx, "other")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+	t.Run("중복 폐기는 무시", func(t *testing.T) {
+		_, err := rvr.Create(ctx, &ent.RevokedToken{Jti: "jti", ExpiresAt: time.Now().Add(time.Hour)})
+		assert.NoError(t, err)
+	})
+	t.Run("만료된 기록 삭제", func(t *testing.T) {
+		_, err := rvr.Create(ctx, &ent.RevokedToken{Jti: "expired", ExpiresAt: time.Now().Add(-time.Hour)})
+		assert.NoError(t, err)
+
+		n, err := rvr.DeleteExpired(ctx, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		exists, err := rvr.Exists(ctx, "jti")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/tag"
+	"halill/ent/user"
+)
+
+type TagRepository interface {
+	GetAllByUserID(context.Context, int64) ([]*ent.Tag, error)
+	Get(context.Context, int64) (*ent.Tag, error)
+	Create(context.Context, *ent.Tag) (*ent.Tag, error)
+	Update(context.Context, *ent.Tag) (*ent.Tag, error)
+	Delete(context.Context, int64) (*ent.Tag, error)
+}
+
+type tagRepositoryImpl struct {
+	db *ent.Client
+}
+
+func NewTagRepository(db *ent.Client) TagRepository {
+	return &tagRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *tagRepositoryImpl) GetAllByUserID(ctx context.Context, userID int64) ([]*ent.Tag, error) {
+	return r.db.Tag.Query().
+		Where(tag.HasUserWith(user.ID(userID))).
+		Order(ent.Asc(tag.FieldName)).
+		WithUser().
+		All(ctx)
+}
+
+func (r *tagRepositoryImpl) Get(ctx context.Context, tagID int64) (*ent.Tag, error) {
+	t, err := r.db.Tag.Query().
+		Where(tag.ID(tagID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTagNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Create 는 같은 사용자에게 같은 이름의 태그가 있으면 409 를 반환합니다.
+func (r *tagRepositoryImpl) Create(ctx context.Context, t *ent.Tag) (*ent.Tag, error) {
+	newTag, err := r.db.Tag.Create().
+		SetName(t.Name).
+		SetUserID(t.Edges.User.ID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperror.ErrTagExists
+		}
+		return nil, err
+	}
+
+	return r.Get(ctx, newTag.ID)
+}
+
+func (r *tagRepositoryImpl) Update(ctx context.Context, t *ent.Tag) (*ent.Tag, error) {
+	err := r.db.Tag.UpdateOneID(t.ID).
+		SetName(t.Name).
+		Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTagNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, apperror.ErrTagExists
+		}
+		return nil, err
+	}
+
+	return r.Get(ctx, t.ID)
+}
+
+// Delete 는 태그를 삭제합니다. Todo 와의 연결은 함께 지워지고 Todo 는 남습니다.
+func (r *tagRepositoryImpl) Delete(ctx context.Context, tagID int64) (*ent.Tag, error) {
+	t, err := r.Get(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Tag.DeleteOneID(tagID).Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTagNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
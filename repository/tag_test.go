@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/privacy"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagRepositoryCreate(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	tgr := NewTagRepository(client)
+
+	t.Run("태그 생성 성공", func(t *testing.T) {
+		tag, err := tgr.Create(ctx, &ent.Tag{Name: "업무", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+		assert.NoError(t, err)
+		assert.NotZero(t, tag.ID)
+		assert.Equal(t, "업무", tag.Name)
+		assert.Equal(t, user.ID, tag.Edges.User.ID)
+	})
+	t.Run("같은 이름의 태그", func(t *testing.T) {
+		_, err := tgr.Create(ctx, &ent.Tag{Name: "업무", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+		assert.Equal(t, apperror.ErrTagExists, err)
+	})
+	t.Run("다른 사용자는 같은 이름 사용 가능", func(t *testing.T) {
+		_, err := tgr.Create(viewerContext(other.ID), &ent.Tag{Name: "업무", Edges: ent.TagEdges{User: &ent.User{ID: other.ID}}})
+		assert.NoError(t, err)
+	})
+}
+
+func TestTagRepositoryUpdate(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tgr := NewTagRepository(client)
+	work, err := tgr.Create(ctx, &ent.Tag{Name: "업무", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	_, err = tgr.Create(ctx, &ent.Tag{Name: "개인", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+
+	t.Run("태그 이름 변경 성공", func(t *testing.T) {
+		tag, err := tgr.Update(ctx, &ent.Tag{ID: work.ID, Name: "회사"})
+		assert.NoError(t, err)
+		assert.Equal(t, "회사", tag.Name)
+	})
+	t.Run("이미 있는 이름으로 변경", func(t *testing.T) {
+		_, err := tgr.Update(ctx, &ent.Tag{ID: work.ID, Name: "개인"})
+		assert.Equal(t, apperror.ErrTagExists, err)
+	})
+	t.Run("존재하지 않는 태그", func(t *testing.T) {
+		_, err := tgr.Update(ctx, &ent.Tag{ID: work.ID + 100, Name: "회사"})
+		assert.Equal(t, apperror.ErrTagNotFound, err)
+	})
+}
+
+func TestTagRepositoryDelete(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tgr := NewTagRepository(client)
+	tr := NewTodoRepository(client)
+	tag, err := tgr.Create(ctx, &ent.Tag{Name: "업무", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	todo, err := tr.Create(ctx, &ent.Todo{Title: "보고서 작성", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	_, err = tr.AddTags(ctx, todo.ID, tag.ID)
+	assert.NoError(t, err)
+
+	t.Run("태그 삭제 후 Todo 는 유지", func(t *testing.T) {
+		deleted, err := tgr.Delete(ctx, tag.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, tag.ID, deleted.ID)
+
+		todo, err := tr.Get(ctx, todo.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, todo.Edges.Tags)
+	})
+	t.Run("존재하지 않는 태그", func(t *testing.T) {
+		_, err := tgr.Delete(ctx, tag.ID)
+		assert.Equal(t, apperror.ErrTagNotFound, err)
+	})
+}
+
+func TestTagRepositoryGetAllByUserID(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	tgr := NewTagRepository(client)
+	for _, tag := range []*ent.Tag{
+		{Name: "업무", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}},
+		{Name: "개인", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}},
+		{Name: "운동", Edges: ent.TagEdges{User: &ent.User{ID: other.ID}}},
+	} {
+		_, err := tgr.Create(viewerContext(tag.Edges.User.ID), tag)
+		assert.NoError(t, err)
+	}
+
+	t.Run("사용자의 태그만 이름순으로 조회", func(t *testing.T) {
+		tags, err := tgr.GetAllByUserID(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Len(t, tags, 2)
+		assert.Equal(t, "개인", tags[0].Name)
+		assert.Equal(t, "업무", tags[1].Name)
+	})
+}
+
+func TestTagRepositoryPrivacy(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	otherCtx := viewerContext(other.ID)
+	tgr := NewTagRepository(client)
+	tag, err := tgr.Create(ctx, &ent.Tag{Name: "업무", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+
+	t.Run("다른 사용자의 태그는 없는 태그", func(t *testing.T) {
+		_, err := tgr.Get(otherCtx, tag.ID)
+		assert.Equal(t, apperror.ErrTagNotFound, err)
+		_, err = tgr.Update(otherCtx, &ent.Tag{ID: tag.ID, Name: "회사"})
+		assert.Equal(t, apperror.ErrTagNotFound, err)
+		_, err = tgr.Delete(otherCtx, tag.ID)
+		assert.Equal(t, apperror.ErrTagNotFound, err)
+	})
+	t.Run("다른 사용자의 태그 생성 불가", func(t *testing.T) {
+		_, err := tgr.Create(otherCtx, &ent.Tag{Name: "개인", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+		assert.True(t, errors.Is(err, privacy.Deny))
+	})
+	t.Run("viewer 가 없으면 거부", func(t *testing.T) {
+		_, err := tgr.Get(context.Background(), tag.ID)
+		assert.True(t, errors.Is(err, privacy.Deny))
+	})
+}
@@ -2,17 +2,94 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"halill/apperror"
 	"halill/ent"
+	"halill/ent/checklistitem"
+	"halill/ent/predicate"
+	"halill/ent/project"
+	"halill/ent/reminder"
+	"halill/ent/schema"
+	"halill/ent/tag"
 	"halill/ent/todo"
 	"halill/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	TodoSortID       = "id"
+	TodoSortDeadline = "deadline"
+	TodoSortTitle    = "title"
 )
 
+// TodoFilter 는 Todo 목록 조회 조건입니다.
+// 마감일 정렬에서 마감일이 없는 Todo 는 정렬 방향과 관계없이 항상 마지막에 옵니다.
+type TodoFilter struct {
+	IsCompleted  *bool
+	DeadlineFrom *time.Time
+	DeadlineTo   *time.Time
+	Overdue      bool
+	TagIDs       []int64
+	MatchAllTags bool
+	ProjectID    *int64
+	InboxOnly    bool
+	SortBy       string
+	Desc         bool
+	After        *TodoCursor
+	Limit        int
+}
+
+// TodoCursor 는 이전 페이지의 마지막 Todo 의 정렬 키입니다.
+type TodoCursor struct {
+	ID       int64      `json:"id"`
+	Deadline *time.Time `json:"deadline,omitempty"`
+	Title    string     `json:"title,omitempty"`
+}
+
+func NewTodoCursor(t *ent.Todo) *TodoCursor {
+	return &TodoCursor{
+		ID:       t.ID,
+		Deadline: t.Deadline,
+		Title:    t.Title,
+	}
+}
+
+func (c *TodoCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeTodoCursor(cursor string) (*TodoCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, apperror.ErrInvalidCursor
+	}
+	c := &TodoCursor{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, apperror.ErrInvalidCursor
+	}
+
+	return c, nil
+}
+
 type TodoRepository interface {
-	GetAllByEmail(string) ([]*ent.Todo, error)
-	Get(int64) (*ent.Todo, error)
-	Create(*ent.Todo) (*ent.Todo, error)
-	Complete(int64) (*ent.Todo, error)
-	Delete(int64) (*ent.Todo, error)
+	GetAllByUserID(context.Context, int64, *TodoFilter) ([]*ent.Todo, int, error)
+	GetNextCandidates(context.Context, int64, int) ([]*ent.Todo, error)
+	Get(context.Context, int64) (*ent.Todo, error)
+	Create(context.Context, *ent.Todo) (*ent.Todo, error)
+	Update(context.Context, *ent.Todo) (*ent.Todo, error)
+	Complete(context.Context, int64, *time.Time) (*ent.Todo, error)
+	AddTags(context.Context, int64, ...int64) (*ent.Todo, error)
+	RemoveTags(context.Context, int64, ...int64) (*ent.Todo, error)
+	GetOccurrences(context.Context, int64) ([]*ent.Todo, error)
+	Delete(context.Context, int64) (*ent.Todo, error)
+	GetTrashByUserID(context.Context, int64) ([]*ent.Todo, error)
+	GetTrashed(context.Context, int64) (*ent.Todo, error)
+	Restore(context.Context, int64) (*ent.Todo, error)
+	Purge(context.Context, time.Time) (int, error)
 }
 
 type todoRepositoryImpl struct {
@@ -25,27 +102,476 @@ func NewTodoRepository(db *ent.Client) TodoRepository {
 	}
 }
 
-func (r *todoRepositoryImpl) GetAllByEmail(email string) ([]*ent.Todo, error) {
-	result := r.db.Todo.Query().
-		Where(todo.HasUserWith(user.ID(email))).
-		WithUser().
-		AllX(context.TODO())
+// GetAllByUserID 은 조건에 맞는 Todo 를 최대 filter.Limit 개 반환합니다. filter.Limit 이 0 이면 모두 반환합니다.
+// 함께 반환하는 개수는 cursor 와 limit 을 적용하기 전의 전체 개수입니다.
+func (r *todoRepositoryImpl) GetAllByUserID(ctx context.Context, userID int64, filter *TodoFilter) ([]*ent.Todo, int, error) {
+	query := r.db.Todo.Query().
+		Where(todo.HasUserWith(user.ID(userID))).
+		Where(filterPredicates(filter)...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if filter.After != nil {
+		query.Where(cursorPredicate(filter))
+	}
+	if filter.Limit > 0 {
+		query.Limit(filter.Limit)
+	}
+	result, err := WithTodoEdges(query.
+		Order(todoOrder(filter)...)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return result, total, nil
+}
+
+// GetNextCandidates 는 완료하지 않은 Todo 를 우선순위마다 마감일 순서로 최대 limit 개씩 반환합니다.
+// 우선순위가 같으면 마감일이 빠를수록 GET /todo/next 의 점수가 높으므로, 점수 상위 limit 개는 모두 이 안에 있습니다.
+func (r *todoRepositoryImpl) GetNextCandidates(ctx context.Context, userID int64, limit int) ([]*ent.Todo, error) {
+	priorities := []todo.Priority{todo.PriorityUrgent, todo.PriorityHigh, todo.PriorityMedium, todo.PriorityLow, todo.PriorityNone}
+	order := todoOrder(&TodoFilter{SortBy: TodoSortDeadline})
+
+	result := make([]*ent.Todo, 0)
+	for _, priority := range priorities {
+		todos, err := WithTodoEdges(r.db.Todo.Query().
+			Where(todo.HasUserWith(user.ID(userID)), todo.IsCompleted(false), todo.PriorityEQ(priority)).
+			Order(order...).
+			Limit(limit)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, todos...)
+	}
 
 	return result, nil
 }
 
-func (r *todoRepositoryImpl) Get(todoID int64) (*ent.Todo, error) {
-	return nil, nil
+func filterPredicates(filter *TodoFilter) []predicate.Todo {
+	predicates := make([]predicate.Todo, 0)
+	if filter.IsCompleted != nil {
+		predicates = append(predicates, todo.IsCompleted(*filter.IsCompleted))
+	}
+	if filter.DeadlineFrom != nil {
+		predicates = append(predicates, todo.DeadlineGTE(*filter.DeadlineFrom))
+	}
+	if filter.DeadlineTo != nil {
+		predicates = append(predicates, todo.DeadlineLTE(*filter.DeadlineTo))
+	}
+	if filter.Overdue {
+		predicates = append(predicates, todo.IsCompleted(false), todo.DeadlineLT(time.Now()))
+	}
+	if filter.ProjectID != nil {
+		predicates = append(predicates, todo.HasProjectWith(project.ID(*filter.ProjectID)))
+	}
+	if filter.InboxOnly {
+		predicates = append(predicates, todo.Not(todo.HasProject()))
+	}
+	if len(filter.TagIDs) > 0 {
+		if filter.MatchAllTags {
+			for _, tagID := range filter.TagIDs {
+				predicates = append(predicates, todo.HasTagsWith(tag.ID(tagID)))
+			}
+		} else {
+			predicates = append(predicates, todo.HasTagsWith(tag.IDIn(filter.TagIDs...)))
+		}
+	}
+
+	return predicates
+}
+
+func todoOrder(filter *TodoFilter) []ent.OrderFunc {
+	direction := ent.Asc
+	if filter.Desc {
+		direction = ent.Desc
+	}
+
+	switch filter.SortBy {
+	case TodoSortDeadline:
+		deadlineIsNull := func(s *sql.Selector) {
+			s.OrderBy(s.C(todo.FieldDeadline) + " IS NULL")
+		}
+		return []ent.OrderFunc{deadlineIsNull, direction(todo.FieldDeadline), direction(todo.FieldID)}
+	case TodoSortTitle:
+		return []ent.OrderFunc{direction(todo.FieldTitle), direction(todo.FieldID)}
+	default:
+		return []ent.OrderFunc{direction(todo.FieldID)}
+	}
+}
+
+// cursorPredicate 는 정렬 순서상 cursor 보다 뒤에 있는 Todo 만 남깁니다.
+func cursorPredicate(filter *TodoFilter) predicate.Todo {
+	c := filter.After
+	idAfter := todo.IDGT(c.ID)
+	if filter.Desc {
+		idAfter = todo.IDLT(c.ID)
+	}
+
+	switch filter.SortBy {
+	case TodoSortDeadline:
+		if c.Deadline == nil {
+			return todo.And(todo.DeadlineIsNil(), idAfter)
+		}
+		deadlineAfter := todo.DeadlineGT(*c.Deadline)
+		if filter.Desc {
+			deadlineAfter = todo.DeadlineLT(*c.Deadline)
+		}
+		return todo.Or(
+			todo.DeadlineIsNil(),
+			deadlineAfter,
+			todo.And(todo.DeadlineEQ(*c.Deadline), idAfter),
+		)
+	case TodoSortTitle:
+		titleAfter := todo.TitleGT(c.Title)
+		if filter.Desc {
+			titleAfter = todo.TitleLT(c.Title)
+		}
+		return todo.Or(
+			titleAfter,
+			todo.And(todo.TitleEQ(c.Title), idAfter),
+		)
+	default:
+		return idAfter
+	}
+}
+
+func (r *todoRepositoryImpl) Get(ctx context.Context, todoID int64) (*ent.Todo, error) {
+	t, err := WithTodoEdges(r.db.Todo.Query().
+		Where(todo.ID(todoID))).
+		Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTodoNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (r *todoRepositoryImpl) Create(ctx context.Context, t *ent.Todo) (*ent.Todo, error) {
+	create := r.db.Todo.Create().
+		SetTitle(t.Title).
+		SetContent(t.Content).
+		SetNillableDeadline(t.Deadline).
+		SetIsCompleted(t.IsCompleted).
+		SetAutoComplete(t.AutoComplete).
+		SetRecurrence(t.Recurrence)
+	if t.IsCompleted {
+		create.SetCompletedAt(time.Now())
+	}
+	if t.Priority != "" {
+		create.SetPriority(t.Priority)
+	}
+	if t.Timezone != "" {
+		create.SetTimezone(t.Timezone)
+	}
+	if t.Edges.User != nil {
+		create.SetUserID(t.Edges.User.ID)
+	}
+	if t.Edges.Project != nil {
+		create.SetProjectID(t.Edges.Project.ID)
+	}
+
+	newTodo, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, newTodo.ID)
+}
+
+// Update 는 Todo 를 t 로 덮어씁니다. 완료로 바뀌면 completed_at 을 지금으로, 미완료로 바뀌면 비웁니다.
+func (r *todoRepositoryImpl) Update(ctx context.Context, t *ent.Todo) (*ent.Todo, error) {
+	update := r.db.Todo.UpdateOneID(t.ID).
+		SetTitle(t.Title).
+		SetContent(t.Content).
+		SetIsCompleted(t.IsCompleted).
+		SetAutoComplete(t.AutoComplete).
+		SetRecurrence(t.Recurrence)
+	if !t.IsCompleted {
+		update.ClearCompletedAt()
+	} else if t.CompletedAt == nil {
+		update.SetCompletedAt(time.Now())
+	}
+	if t.Priority != "" {
+		update.SetPriority(t.Priority)
+	}
+	if t.Timezone != "" {
+		update.SetTimezone(t.Timezone)
+	}
+	if t.Deadline != nil {
+		update.SetDeadline(*t.Deadline)
+	} else {
+		update.ClearDeadline()
+	}
+	if t.Edges.Project != nil {
+		update.SetProjectID(t.Edges.Project.ID)
+	} else {
+		update.ClearProject()
+	}
+
+	err := update.Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTodoNotFound
+		}
+		return nil, err
+	}
+
+	return r.Get(ctx, t.ID)
+}
+
+// Complete 는 아직 완료되지 않은 Todo 만 완료합니다. 이미 완료된 Todo 는 처음 완료한 시각을 유지합니다.
+// next 가 nil 이 아니면 이번 호출로 완료되었을 때만 같은 트랜잭션에서 next 마감의 다음 회차를 만들므로,
+// 같은 Todo 를 동시에 완료해도 다음 회차는 하나만 생깁니다.
+func (r *todoRepositoryImpl) Complete(ctx context.Context, todoID int64, next *time.Time) (*ent.Todo, error) {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := tx.Todo.Update().
+		Where(todo.ID(todoID), todo.IsCompleted(false)).
+		SetIsCompleted(true).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if n == 1 && next != nil {
+		err = createOccurrence(ctx, tx, todoID, *next)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, todoID)
+}
+
+// AddTags 는 아직 연결되지 않은 태그만 Todo 에 연결합니다.
+func (r *todoRepositoryImpl) AddTags(ctx context.Context, todoID int64, tagIDs ...int64) (*ent.Todo, error) {
+	t, err := r.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	attached := map[int64]bool{}
+	for _, tg := range t.Edges.Tags {
+		attached[tg.ID] = true
+	}
+	newTagIDs := make([]int64, 0)
+	for _, tagID := range tagIDs {
+		if !attached[tagID] {
+			attached[tagID] = true
+			newTagIDs = append(newTagIDs, tagID)
+		}
+	}
+	if len(newTagIDs) == 0 {
+		return t, nil
+	}
+
+	err = r.db.Todo.UpdateOneID(todoID).
+		AddTagIDs(newTagIDs...).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, todoID)
+}
+
+func (r *todoRepositoryImpl) RemoveTags(ctx context.Context, todoID int64, tagIDs ...int64) (*ent.Todo, error) {
+	err := r.db.Todo.UpdateOneID(todoID).
+		RemoveTagIDs(tagIDs...).
+		Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTodoNotFound
+		}
+		return nil, err
+	}
+
+	return r.Get(ctx, todoID)
+}
+
+// createOccurrence 는 tx 안에서 반복 Todo prevID 의 다음 회차를 deadline 마감으로 만듭니다.
+// 제목, 내용, 우선순위, 반복 규칙과 시간대, 프로젝트, 태그, 알림을 그대로 가져오고 체크리스트는 체크를 해제해 복사합니다.
+// 모든 회차는 첫 회차를 origin 으로 가리킵니다.
+func createOccurrence(ctx context.Context, tx *ent.Tx, prevID int64, deadline time.Time) error {
+	prev, err := WithTodoEdges(tx.Todo.Query().
+		Where(todo.ID(prevID))).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	originID := prev.ID
+	if prev.Edges.Origin != nil {
+		originID = prev.Edges.Origin.ID
+	}
+
+	create := tx.Todo.Create().
+		SetTitle(prev.Title).
+		SetContent(prev.Content).
+		SetDeadline(deadline).
+		SetIsCompleted(false).
+		SetAutoComplete(prev.AutoComplete).
+		SetRecurrence(prev.Recurrence).
+		SetTimezone(prev.Timezone).
+		SetPriority(prev.Priority).
+		SetOccurrence(prev.Occurrence + 1).
+		SetOriginID(originID)
+	if prev.Edges.User != nil {
+		create.SetUserID(prev.Edges.User.ID)
+	}
+	if prev.Edges.Project != nil {
+		create.SetProjectID(prev.Edges.Project.ID)
+	}
+	for _, tg := range prev.Edges.Tags {
+		create.AddTagIDs(tg.ID)
+	}
+
+	next, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	items := make([]*ent.ChecklistItemCreate, 0, len(prev.Edges.ChecklistItems))
+	for _, item := range prev.Edges.ChecklistItems {
+		items = append(items, tx.ChecklistItem.Create().
+			SetTitle(item.Title).
+			SetIsChecked(false).
+			SetPosition(item.Position).
+			SetTodoID(next.ID))
+	}
+	if len(items) > 0 {
+		if _, err := tx.ChecklistItem.CreateBulk(items...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	reminders := make([]*ent.ReminderCreate, 0, len(prev.Edges.Reminders))
+	for _, rm := range prev.Edges.Reminders {
+		reminders = append(reminders, tx.Reminder.Create().
+			SetOffsetMinutes(rm.OffsetMinutes).
+			SetNillableRemindAt(RemindAt(&deadline, rm.OffsetMinutes)).
+			SetTodoID(next.ID))
+	}
+	if len(reminders) > 0 {
+		if _, err := tx.Reminder.CreateBulk(reminders...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetOccurrences 는 todoID 가 속한 반복 Todo 의 모든 회차를 회차 순서로 반환합니다.
+func (r *todoRepositoryImpl) GetOccurrences(ctx context.Context, todoID int64) ([]*ent.Todo, error) {
+	t, err := r.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	originID := t.ID
+	if t.Edges.Origin != nil {
+		originID = t.Edges.Origin.ID
+	}
+
+	return WithTodoEdges(r.db.Todo.Query().
+		Where(todo.Or(todo.ID(originID), todo.HasOriginWith(todo.ID(originID))))).
+		Order(ent.Asc(todo.FieldOccurrence), ent.Asc(todo.FieldID)).
+		All(ctx)
+}
+
+// WithTodoEdges 는 dto.TodoToDTO 가 사용하는 edge 를 모두 함께 불러옵니다.
+func WithTodoEdges(query *ent.TodoQuery) *ent.TodoQuery {
+	return query.
+		WithUser().
+		WithTags(func(q *ent.TagQuery) {
+			q.Order(ent.Asc(tag.FieldName))
+		}).
+		WithProject().
+		WithOrigin().
+		WithChecklistItems(func(q *ent.ChecklistItemQuery) {
+			q.Order(ent.Asc(checklistitem.FieldPosition), ent.Asc(checklistitem.FieldID))
+		}).
+		WithReminders(func(q *ent.ReminderQuery) {
+			q.Order(ent.Desc(reminder.FieldOffsetMinutes), ent.Asc(reminder.FieldID))
+		})
+}
+
+// Delete 는 Todo 를 휴지통으로 보냅니다. 휴지통의 Todo 는 다른 조회에 나오지 않고 Purge 전까지 복원할 수 있습니다.
+func (r *todoRepositoryImpl) Delete(ctx context.Context, todoID int64) (*ent.Todo, error) {
+	t, err := r.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Todo.UpdateOneID(todoID).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTodoNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// GetTrashByUserID 은 사용자의 휴지통에 있는 Todo 를 최근에 삭제한 순서로 반환합니다.
+func (r *todoRepositoryImpl) GetTrashByUserID(ctx context.Context, userID int64) ([]*ent.Todo, error) {
+	return WithTodoEdges(r.db.Todo.Query().
+		Where(todo.HasUserWith(user.ID(userID)), todo.DeletedAtNotNil())).
+		Order(ent.Desc(todo.FieldDeletedAt), ent.Desc(todo.FieldID)).
+		All(schema.SkipSoftDelete(ctx))
 }
 
-func (r *todoRepositoryImpl) Create(*ent.Todo) (*ent.Todo, error) {
-	return nil, nil
+func (r *todoRepositoryImpl) GetTrashed(ctx context.Context, todoID int64) (*ent.Todo, error) {
+	t, err := WithTodoEdges(r.db.Todo.Query().
+		Where(todo.ID(todoID), todo.DeletedAtNotNil())).
+		Only(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTrashedTodoNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
 }
 
-func (r *todoRepositoryImpl) Complete(todoID int64) (*ent.Todo, error) {
-	return nil, nil
+func (r *todoRepositoryImpl) Restore(ctx context.Context, todoID int64) (*ent.Todo, error) {
+	err := r.db.Todo.UpdateOneID(todoID).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrTrashedTodoNotFound
+		}
+		return nil, err
+	}
+
+	return r.Get(ctx, todoID)
 }
 
-func (r *todoRepositoryImpl) Delete(todoID int64) (*ent.Todo, error) {
-	return nil, nil
+// Purge 는 before 보다 먼저 휴지통에 들어간 Todo 를 완전히 삭제하고 삭제한 개수를 반환합니다.
+// 체크리스트와 알림은 외래 키의 ON DELETE CASCADE 로 함께 지워집니다.
+func (r *todoRepositoryImpl) Purge(ctx context.Context, before time.Time) (int, error) {
+	return r.db.Todo.Delete().
+		Where(todo.DeletedAtLT(before)).
+		Exec(ctx)
 }
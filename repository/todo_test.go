@@ -0,0 +1,664 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/enttest"
+	"halill/ent/privacy"
+	"halill/viewer"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		client.Close()
+	})
+	return client
+}
+
+func createTestUser(t *testing.T, client *ent.Client, email string) *ent.User {
+	u, err := client.User.Create().
+		SetEmail(email).
+		SetPassword("password").
+		SetName("조호원").
+		Save(viewer.NewSystemContext(context.Background()))
+	assert.NoError(t, err)
+	return u
+}
+
+// viewerContext 는 userID 사용자가 보낸 요청의 context 를 반환합니다.
+func viewerContext(userID int64) context.Context {
+	return viewer.NewContext(context.Background(), &viewer.Viewer{UserID: userID})
+}
+
+func TestTodoRepositoryCreate(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+
+	t.Run("Todo 생성 성공", func(t *testing.T) {
+		deadline := time.Now().Add(24 * 3 * time.Hour).Truncate(time.Second)
+		todo, err := tr.Create(ctx, &ent.Todo{
+			Title:    "Go 언어 공부하기",
+			Content:  "장재휴의 Go 웹 프로그래밍 철저 입문",
+			Deadline: &deadline,
+			Edges: ent.TodoEdges{
+				User: &ent.User{ID: user.ID},
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotZero(t, todo.ID)
+		assert.Equal(t, "Go 언어 공부하기", todo.Title)
+		assert.True(t, deadline.Equal(*todo.Deadline))
+		assert.False(t, todo.IsCompleted)
+		assert.Nil(t, todo.CompletedAt)
+		assert.False(t, todo.CreatedAt.IsZero())
+		assert.Equal(t, user.ID, todo.Edges.User.ID)
+	})
+}
+
+func TestTodoRepositoryPriority(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+
+	t.Run("priority 가 없으면 none", func(t *testing.T) {
+		todo, err := tr.Create(ctx, &ent.Todo{Title: "장보기", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+		assert.NoError(t, err)
+		assert.Equal(t, "none", todo.Priority.String())
+	})
+	t.Run("priority 저장과 수정", func(t *testing.T) {
+		todo, err := tr.Create(ctx, &ent.Todo{Title: "보고서 제출", Priority: "high", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+		assert.NoError(t, err)
+		assert.Equal(t, "high", todo.Priority.String())
+
+		todo.Priority = "urgent"
+		todo, err = tr.Update(ctx, todo)
+		assert.NoError(t, err)
+		assert.Equal(t, "urgent", todo.Priority.String())
+	})
+	t.Run("limit 이 0 이면 모두 조회", func(t *testing.T) {
+		todos, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{SortBy: TodoSortID})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Len(t, todos, 2)
+	})
+}
+
+func TestTodoRepositoryGetNextCandidates(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	soon := time.Now().Add(time.Hour)
+	later := time.Now().Add(24 * time.Hour)
+	fixtures := []*ent.Todo{
+		{Title: "가", Priority: "high"},
+		{Title: "나", Priority: "high", Deadline: &later},
+		{Title: "다", Priority: "high", Deadline: &soon},
+		{Title: "라", Priority: "none", Deadline: &later},
+		{Title: "마", Priority: "none", Deadline: &soon, IsCompleted: true},
+		{Title: "바", Priority: "none"},
+		{Title: "사", Priority: "none", Deadline: &soon},
+	}
+	for _, fixture := range fixtures {
+		fixture.Edges.User = &ent.User{ID: user.ID}
+		_, err := tr.Create(ctx, fixture)
+		assert.NoError(t, err)
+	}
+
+	todos, err := tr.GetNextCandidates(ctx, user.ID, 2)
+	assert.NoError(t, err)
+	titles := make([]string, 0)
+	for _, todo := range todos {
+		titles = append(titles, todo.Title)
+	}
+	// 우선순위마다 완료하지 않은 Todo 를 마감일 순서로 2 개씩
+	assert.Equal(t, []string{"다", "나", "사", "라"}, titles)
+}
+
+func TestTodoRepositoryGet(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	created, err := tr.Create(ctx, &ent.Todo{
+		Title:   "Go 언어 공부하기",
+		Content: "장재휴의 Go 웹 프로그래밍 철저 입문",
+		Edges: ent.TodoEdges{
+			User: &ent.User{ID: user.ID},
+		},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Todo 조회 성공", func(t *testing.T) {
+		todo, err := tr.Get(ctx, created.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, todo.ID)
+		assert.Equal(t, user.ID, todo.Edges.User.ID)
+	})
+	t.Run("존재하지 않는 Todo", func(t *testing.T) {
+		_, err := tr.Get(ctx, created.ID+100)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+	})
+}
+
+func TestTodoRepositoryGetAllByUserID(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	for _, owner := range []*ent.User{user, user, other} {
+		_, err := tr.Create(viewerContext(owner.ID), &ent.Todo{
+			Title:   "Go 언어 공부하기",
+			Content: "장재휴의 Go 웹 프로그래밍 철저 입문",
+			Edges: ent.TodoEdges{
+				User: &ent.User{ID: owner.ID},
+			},
+		})
+		assert.NoError(t, err)
+	}
+
+	t.Run("사용자의 Todo만 조회", func(t *testing.T) {
+		todos, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{Limit: 10})
+		assert.NoError(t, err)
+		assert.Len(t, todos, 2)
+		assert.Equal(t, 2, total)
+		for _, todo := range todos {
+			assert.Equal(t, user.ID, todo.Edges.User.ID)
+		}
+	})
+}
+
+func TestTodoRepositoryPrivacy(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	other := createTestUser(t, client, "hwc9169@naver.com")
+	ctx := viewerContext(user.ID)
+	otherCtx := viewerContext(other.ID)
+	tr := NewTodoRepository(client)
+	todo, err := tr.Create(ctx, &ent.Todo{Title: "보고서 작성", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+
+	t.Run("다른 사용자의 Todo 는 없는 Todo", func(t *testing.T) {
+		_, err := tr.Get(otherCtx, todo.ID)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+
+		todos, _, err := tr.GetAllByUserID(otherCtx, user.ID, &TodoFilter{})
+		assert.NoError(t, err)
+		assert.Empty(t, todos)
+	})
+	t.Run("다른 사용자의 Todo 는 수정과 삭제 불가", func(t *testing.T) {
+		_, err := tr.Update(otherCtx, &ent.Todo{ID: todo.ID, Title: "수정"})
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+		_, err = tr.Delete(otherCtx, todo.ID)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+
+		unchanged, err := tr.Get(ctx, todo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "보고서 작성", unchanged.Title)
+	})
+	t.Run("다른 사용자의 Todo 생성 불가", func(t *testing.T) {
+		_, err := tr.Create(otherCtx, &ent.Todo{Title: "보고서 작성", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+		assert.True(t, errors.Is(err, privacy.Deny))
+	})
+	t.Run("viewer 가 없으면 거부", func(t *testing.T) {
+		_, err := tr.Get(context.Background(), todo.ID)
+		assert.True(t, errors.Is(err, privacy.Deny))
+	})
+	t.Run("system viewer 는 모든 Todo 조회", func(t *testing.T) {
+		found, err := tr.Get(viewer.NewSystemContext(context.Background()), todo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, todo.ID, found.ID)
+	})
+}
+
+func TestTodoRepositoryGetAllByUserIDFilter(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	now := time.Now().Truncate(time.Second)
+	yesterday := now.Add(-24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+	nextWeek := now.Add(7 * 24 * time.Hour)
+	fixtures := []*ent.Todo{
+		{Title: "다", Deadline: &tomorrow},
+		{Title: "가", Deadline: &yesterday},
+		{Title: "라"},
+		{Title: "나", Deadline: &nextWeek, IsCompleted: true},
+		{Title: "마", Deadline: &yesterday, IsCompleted: true},
+	}
+	ids := make([]int64, 0)
+	for _, fixture := range fixtures {
+		fixture.Edges.User = &ent.User{ID: user.ID}
+		created, err := tr.Create(ctx, fixture)
+		assert.NoError(t, err)
+		if fixture.IsCompleted {
+			_, err = tr.Complete(ctx, created.ID, nil)
+			assert.NoError(t, err)
+		}
+		ids = append(ids, created.ID)
+	}
+	titles := func(todos []*ent.Todo) []string {
+		result := make([]string, 0)
+		for _, todo := range todos {
+			result = append(result, todo.Title)
+		}
+		return result
+	}
+
+	t.Run("완료 여부 필터", func(t *testing.T) {
+		isCompleted := false
+		todos, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{IsCompleted: &isCompleted, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Equal(t, []string{"다", "가", "라"}, titles(todos))
+	})
+	t.Run("마감일 범위 필터", func(t *testing.T) {
+		from := now
+		to := nextWeek
+		todos, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{DeadlineFrom: &from, DeadlineTo: &to, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []string{"다", "나"}, titles(todos))
+	})
+	t.Run("기한이 지난 Todo 만 조회", func(t *testing.T) {
+		todos, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{Overdue: true, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []string{"가"}, titles(todos))
+	})
+	t.Run("제목 역순 정렬", func(t *testing.T) {
+		todos, _, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{SortBy: TodoSortTitle, Desc: true, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"마", "라", "다", "나", "가"}, titles(todos))
+	})
+	t.Run("마감일 정렬은 마감일 없는 Todo 가 마지막", func(t *testing.T) {
+		todos, _, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{SortBy: TodoSortDeadline, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"가", "마", "다", "나", "라"}, titles(todos))
+
+		todos, _, err = tr.GetAllByUserID(ctx, user.ID, &TodoFilter{SortBy: TodoSortDeadline, Desc: true, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"나", "다", "마", "가", "라"}, titles(todos))
+	})
+	t.Run("cursor 로 페이지 이어서 조회", func(t *testing.T) {
+		for _, desc := range []bool{false, true} {
+			filter := &TodoFilter{SortBy: TodoSortDeadline, Desc: desc, Limit: 2}
+			all, _, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{SortBy: TodoSortDeadline, Desc: desc, Limit: 10})
+			assert.NoError(t, err)
+
+			paged := make([]*ent.Todo, 0)
+			for {
+				todos, total, err := tr.GetAllByUserID(ctx, user.ID, filter)
+				assert.NoError(t, err)
+				assert.Equal(t, 5, total)
+				paged = append(paged, todos...)
+				if len(todos) < filter.Limit {
+					break
+				}
+				filter.After = NewTodoCursor(todos[len(todos)-1])
+			}
+			assert.Equal(t, titles(all), titles(paged))
+		}
+	})
+	t.Run("cursor 인코딩", func(t *testing.T) {
+		cursor := &TodoCursor{ID: ids[0], Deadline: &tomorrow, Title: "다"}
+		decoded, err := DecodeTodoCursor(cursor.Encode())
+		assert.NoError(t, err)
+		assert.Equal(t, cursor.ID, decoded.ID)
+		assert.True(t, tomorrow.Equal(*decoded.Deadline))
+
+		_, err = DecodeTodoCursor("not-a-cursor")
+		assert.Equal(t, apperror.ErrInvalidCursor, err)
+	})
+}
+
+func TestTodoRepositoryTags(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	tgr := NewTagRepository(client)
+	tags := make([]*ent.Tag, 0)
+	for _, name := range []string{"업무", "긴급"} {
+		tag, err := tgr.Create(ctx, &ent.Tag{Name: name, Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+		assert.NoError(t, err)
+		tags = append(tags, tag)
+	}
+	work, urgent := tags[0], tags[1]
+	todos := make([]*ent.Todo, 0)
+	for _, title := range []string{"보고서 작성", "회의 준비", "장보기"} {
+		todo, err := tr.Create(ctx, &ent.Todo{Title: title, Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+		assert.NoError(t, err)
+		todos = append(todos, todo)
+	}
+
+	t.Run("태그 연결", func(t *testing.T) {
+		todo, err := tr.AddTags(ctx, todos[0].ID, work.ID, urgent.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todo.Edges.Tags, 2)
+		assert.Equal(t, "긴급", todo.Edges.Tags[0].Name)
+
+		_, err = tr.AddTags(ctx, todos[1].ID, work.ID)
+		assert.NoError(t, err)
+	})
+	t.Run("이미 연결된 태그는 무시", func(t *testing.T) {
+		todo, err := tr.AddTags(ctx, todos[0].ID, work.ID, work.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todo.Edges.Tags, 2)
+	})
+	t.Run("태그 필터 AND", func(t *testing.T) {
+		result, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{TagIDs: []int64{work.ID, urgent.ID}, MatchAllTags: true, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, todos[0].ID, result[0].ID)
+	})
+	t.Run("태그 필터 OR", func(t *testing.T) {
+		result, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{TagIDs: []int64{work.ID, urgent.ID}, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, todos[0].ID, result[0].ID)
+		assert.Equal(t, todos[1].ID, result[1].ID)
+		assert.Len(t, result[0].Edges.Tags, 2)
+	})
+	t.Run("태그 연결 해제", func(t *testing.T) {
+		todo, err := tr.RemoveTags(ctx, todos[0].ID, urgent.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todo.Edges.Tags, 1)
+		assert.Equal(t, work.ID, todo.Edges.Tags[0].ID)
+	})
+	t.Run("존재하지 않는 Todo", func(t *testing.T) {
+		_, err := tr.AddTags(ctx, todos[2].ID+100, work.ID)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+	})
+}
+
+func TestTodoRepositoryProject(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	pr := NewProjectRepository(client)
+	p, err := pr.Create(ctx, &ent.Project{Name: "회사", Edges: ent.ProjectEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	inProject, err := tr.Create(ctx, &ent.Todo{Title: "보고서 작성", Edges: ent.TodoEdges{
+		User:    &ent.User{ID: user.ID},
+		Project: &ent.Project{ID: p.ID},
+	}})
+	assert.NoError(t, err)
+	inbox, err := tr.Create(ctx, &ent.Todo{Title: "장보기", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+
+	t.Run("프로젝트별 조회", func(t *testing.T) {
+		todos, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{ProjectID: &p.ID, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, inProject.ID, todos[0].ID)
+		assert.Equal(t, p.ID, todos[0].Edges.Project.ID)
+	})
+	t.Run("Inbox 조회", func(t *testing.T) {
+		todos, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{InboxOnly: true, Limit: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, inbox.ID, todos[0].ID)
+	})
+	t.Run("수정으로 프로젝트 이동", func(t *testing.T) {
+		inbox.Edges.Project = &ent.Project{ID: p.ID}
+		moved, err := tr.Update(ctx, inbox)
+		assert.NoError(t, err)
+		assert.Equal(t, p.ID, moved.Edges.Project.ID)
+
+		moved.Edges.Project = nil
+		moved, err = tr.Update(ctx, moved)
+		assert.NoError(t, err)
+		assert.Nil(t, moved.Edges.Project)
+	})
+}
+
+func TestTodoRepositoryComplete(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	created, err := tr.Create(ctx, &ent.Todo{
+		Title:   "Go 언어 공부하기",
+		Content: "장재휴의 Go 웹 프로그래밍 철저 입문",
+		Edges: ent.TodoEdges{
+			User: &ent.User{ID: user.ID},
+		},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Todo 완료 성공", func(t *testing.T) {
+		todo, err := tr.Complete(ctx, created.ID, nil)
+		assert.NoError(t, err)
+		assert.True(t, todo.IsCompleted)
+		assert.NotNil(t, todo.CompletedAt)
+		assert.Equal(t, user.ID, todo.Edges.User.ID)
+	})
+	t.Run("다시 완료해도 처음 완료한 시각 유지", func(t *testing.T) {
+		completed, err := tr.Get(ctx, created.ID)
+		assert.NoError(t, err)
+
+		todo, err := tr.Complete(ctx, created.ID, nil)
+		assert.NoError(t, err)
+		assert.True(t, completed.CompletedAt.Equal(*todo.CompletedAt))
+	})
+	t.Run("미완료로 바꾸면 완료 시각 삭제", func(t *testing.T) {
+		todo, err := tr.Get(ctx, created.ID)
+		assert.NoError(t, err)
+		todo.IsCompleted = false
+
+		updated, err := tr.Update(ctx, todo)
+		assert.NoError(t, err)
+		assert.Nil(t, updated.CompletedAt)
+		assert.False(t, updated.UpdatedAt.Before(created.UpdatedAt))
+	})
+	t.Run("존재하지 않는 Todo", func(t *testing.T) {
+		_, err := tr.Complete(ctx, created.ID+100, nil)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+	})
+}
+
+func TestTodoRepositoryOccurrence(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	tgr := NewTagRepository(client)
+	cr := NewChecklistItemRepository(client)
+	tg, err := tgr.Create(ctx, &ent.Tag{Name: "집안일", Edges: ent.TagEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	deadline := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	first, err := tr.Create(ctx, &ent.Todo{
+		Title:      "분리수거",
+		Deadline:   &deadline,
+		Recurrence: "FREQ=WEEKLY;BYDAY=MO",
+		Timezone:   "Asia/Seoul",
+		Edges:      ent.TodoEdges{User: &ent.User{ID: user.ID}},
+	})
+	assert.NoError(t, err)
+	_, err = tr.AddTags(ctx, first.ID, tg.ID)
+	assert.NoError(t, err)
+	_, err = cr.Create(ctx, &ent.ChecklistItem{Title: "플라스틱", IsChecked: true, Edges: ent.ChecklistItemEdges{Todo: first}})
+	assert.NoError(t, err)
+
+	var second *ent.Todo
+	t.Run("완료하면 다음 회차 생성", func(t *testing.T) {
+		next := deadline.AddDate(0, 0, 7)
+		first, err = tr.Complete(ctx, first.ID, &next)
+		assert.NoError(t, err)
+		assert.True(t, first.IsCompleted)
+
+		todos, err := tr.GetOccurrences(ctx, first.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todos, 2)
+		second = todos[1]
+		assert.Equal(t, "분리수거", second.Title)
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO", second.Recurrence)
+		assert.Equal(t, "Asia/Seoul", second.Timezone)
+		assert.Equal(t, 2, second.Occurrence)
+		assert.True(t, next.Equal(*second.Deadline))
+		assert.False(t, second.IsCompleted)
+		assert.Equal(t, first.ID, second.Edges.Origin.ID)
+		assert.Equal(t, user.ID, second.Edges.User.ID)
+		assert.Equal(t, tg.ID, second.Edges.Tags[0].ID)
+		assert.Equal(t, "플라스틱", second.Edges.ChecklistItems[0].Title)
+		assert.False(t, second.Edges.ChecklistItems[0].IsChecked)
+	})
+	t.Run("이미 완료된 회차는 다시 만들지 않음", func(t *testing.T) {
+		next := deadline.AddDate(0, 0, 7)
+		_, err := tr.Complete(ctx, first.ID, &next)
+		assert.NoError(t, err)
+
+		todos, err := tr.GetOccurrences(ctx, first.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todos, 2)
+	})
+	t.Run("모든 회차는 첫 회차를 가리킴", func(t *testing.T) {
+		next := deadline.AddDate(0, 0, 14)
+		_, err := tr.Complete(ctx, second.ID, &next)
+		assert.NoError(t, err)
+
+		todos, err := tr.GetOccurrences(ctx, second.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todos, 3)
+		third := todos[2]
+		assert.Equal(t, 3, third.Occurrence)
+		assert.Equal(t, first.ID, third.Edges.Origin.ID)
+		assert.Equal(t, []int64{first.ID, second.ID}, []int64{todos[0].ID, todos[1].ID})
+	})
+	t.Run("존재하지 않는 Todo", func(t *testing.T) {
+		_, err := tr.GetOccurrences(ctx, first.ID+100)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+	})
+}
+
+func TestTodoRepositoryDelete(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	created, err := tr.Create(ctx, &ent.Todo{
+		Title:   "Go 언어 공부하기",
+		Content: "장재휴의 Go 웹 프로그래밍 철저 입문",
+		Edges: ent.TodoEdges{
+			User: &ent.User{ID: user.ID},
+		},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Todo 삭제 성공", func(t *testing.T) {
+		todo, err := tr.Delete(ctx, created.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, todo.ID)
+
+		_, err = tr.Get(ctx, created.ID)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+	})
+	t.Run("존재하지 않는 Todo", func(t *testing.T) {
+		_, err := tr.Delete(ctx, created.ID)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+	})
+}
+
+func TestTodoRepositoryTrash(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	kept, err := tr.Create(ctx, &ent.Todo{Title: "장보기", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	trashed, err := tr.Create(ctx, &ent.Todo{Title: "분리수거", Edges: ent.TodoEdges{User: &ent.User{ID: user.ID}}})
+	assert.NoError(t, err)
+	_, err = tr.Delete(ctx, trashed.ID)
+	assert.NoError(t, err)
+
+	t.Run("휴지통의 Todo 는 목록에서 제외", func(t *testing.T) {
+		todos, total, err := tr.GetAllByUserID(ctx, user.ID, &TodoFilter{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, kept.ID, todos[0].ID)
+	})
+	t.Run("휴지통 조회", func(t *testing.T) {
+		todos, err := tr.GetTrashByUserID(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Len(t, todos, 1)
+		assert.Equal(t, trashed.ID, todos[0].ID)
+		assert.NotNil(t, todos[0].DeletedAt)
+
+		_, err = tr.GetTrashed(ctx, kept.ID)
+		assert.Equal(t, apperror.ErrTrashedTodoNotFound, err)
+	})
+	t.Run("복원", func(t *testing.T) {
+		restored, err := tr.Restore(ctx, trashed.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+
+		_, err = tr.Get(ctx, trashed.ID)
+		assert.NoError(t, err)
+	})
+	t.Run("보존 기간이 지난 Todo 만 완전히 삭제", func(t *testing.T) {
+		_, err := tr.Delete(ctx, trashed.ID)
+		assert.NoError(t, err)
+
+		n, err := tr.Purge(ctx, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+
+		n, err = tr.Purge(ctx, time.Now().Add(time.Second))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		_, err = tr.GetTrashed(ctx, trashed.ID)
+		assert.Equal(t, apperror.ErrTrashedTodoNotFound, err)
+	})
+}
+
+func TestTodoRepositoryUpdate(t *testing.T) {
+	client := newTestClient(t)
+	user := createTestUser(t, client, "hwc9169@gmail.com")
+	ctx := viewerContext(user.ID)
+	tr := NewTodoRepository(client)
+	deadline := time.Now().Add(24 * 3 * time.Hour).Truncate(time.Second)
+	created, err := tr.Create(ctx, &ent.Todo{
+		Title:       "Go 언어 공부하기",
+		Content:     "장재휴의 Go 웹 프로그래밍 철저 입문",
+		Deadline:    &deadline,
+		IsCompleted: true,
+		Edges: ent.TodoEdges{
+			User: &ent.User{ID: user.ID},
+		},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Todo 수정 성공", func(t *testing.T) {
+		todo, err := tr.Update(ctx, &ent.Todo{
+			ID:          created.ID,
+			Title:       "Rust 공부하기",
+			Content:     "The Rust Programming Language",
+			IsCompleted: false,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "Rust 공부하기", todo.Title)
+		assert.Equal(t, "The Rust Programming Language", todo.Content)
+		assert.Nil(t, todo.Deadline)
+		assert.False(t, todo.IsCompleted)
+		assert.Equal(t, user.ID, todo.Edges.User.ID)
+	})
+	t.Run("존재하지 않는 Todo", func(t *testing.T) {
+		_, err := tr.Update(ctx, &ent.Todo{ID: created.ID + 100, Title: "Rust 공부하기"})
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+	})
+}
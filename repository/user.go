@@ -2,16 +2,22 @@ package repository
 
 import (
 	"context"
+	"halill/apperror"
 	"halill/ent"
 	"halill/ent/user"
-	"net/http"
-
-	"github.com/labstack/echo/v4"
+	"time"
 )
 
 type UserRepository interface {
-	GetByEmail(string) (*ent.User, error)
-	CreateUser(*ent.User) (*ent.User, error)
+	Get(context.Context, int64) (*ent.User, error)
+	GetByEmail(context.Context, string) (*ent.User, error)
+	CreateUser(context.Context, *ent.User) (*ent.User, error)
+	IncrementTokenVersion(context.Context, int64) (*ent.User, error)
+	UpdateLocale(context.Context, int64, string) (*ent.User, error)
+	UpdatePassword(context.Context, int64, string) (*ent.User, error)
+	MarkEmailVerified(context.Context, int64, time.Time) (*ent.User, error)
+	UpdateVerificationSent(context.Context, int64, time.Time, int) (*ent.User, error)
+	UpdateEmail(context.Context, int64, string, time.Time) (*ent.User, error)
 }
 
 type userRepositoryImpl struct {
@@ -24,13 +30,25 @@ func NewUserRepository(db *ent.Client) UserRepository {
 	}
 }
 
-func (ur *userRepositoryImpl) GetByEmail(email string) (*ent.User, error) {
+func (ur *userRepositoryImpl) Get(ctx context.Context, id int64) (*ent.User, error) {
+	u, err := ur.db.User.Get(ctx, id)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func (ur *userRepositoryImpl) GetByEmail(ctx context.Context, email string) (*ent.User, error) {
 	u, err := ur.db.User.Query().
-		Where(user.ID(email)).
-		Only(context.Background())
+		Where(user.Email(email)).
+		Only(ctx)
 	if err != nil {
 		if _, ok := err.(*ent.NotFoundError); ok {
-			return nil, echo.NewHTTPError(http.StatusBadRequest, "존재하지 않는 사용자 입니다.")
+			return nil, apperror.ErrUserNotFound
 		}
 		return nil, err
 	}
@@ -38,13 +56,111 @@ func (ur *userRepositoryImpl) GetByEmail(email string) (*ent.User, error) {
 	return u, nil
 }
 
-func (ur *userRepositoryImpl) CreateUser(user *ent.User) (*ent.User, error) {
+func (ur *userRepositoryImpl) CreateUser(ctx context.Context, user *ent.User) (*ent.User, error) {
 	u, err := ur.db.User.Create().
-		SetID(user.ID).
+		SetEmail(user.Email).
 		SetPassword(user.Password).
 		SetName(user.Name).
-		Save(context.Background())
+		SetLocale(user.Locale).
+		Save(ctx)
+	if err != nil {
+		// 확인한 뒤 저장하기 전에 다른 요청이 같은 이메일로 가입한 경우
+		if ent.IsConstraintError(err) {
+			return nil, apperror.ErrEmailTaken
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// IncrementTokenVersion 은 token_version 을 1 올려 지금까지 발급된 토큰을 모두 무효화합니다.
+func (ur *userRepositoryImpl) IncrementTokenVersion(ctx context.Context, id int64) (*ent.User, error) {
+	u, err := ur.db.User.UpdateOneID(id).
+		AddTokenVersion(1).
+		Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func (ur *userRepositoryImpl) UpdateLocale(ctx context.Context, id int64, locale string) (*ent.User, error) {
+	u, err := ur.db.User.UpdateOneID(id).
+		SetLocale(locale).
+		Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// UpdatePassword 는 이미 해시한 비밀번호를 저장합니다.
+func (ur *userRepositoryImpl) UpdatePassword(ctx context.Context, id int64, hashedPassword string) (*ent.User, error) {
+	u, err := ur.db.User.UpdateOneID(id).
+		SetPassword(hashedPassword).
+		Save(ctx)
 	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func (ur *userRepositoryImpl) MarkEmailVerified(ctx context.Context, id int64, verifiedAt time.Time) (*ent.User, error) {
+	u, err := ur.db.User.UpdateOneID(id).
+		SetEmailVerifiedAt(verifiedAt).
+		Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// UpdateVerificationSent 는 인증 메일을 보낸 시각과 재전송 제한에 쓰는 전송 횟수를 저장합니다.
+func (ur *userRepositoryImpl) UpdateVerificationSent(ctx context.Context, id int64, sentAt time.Time, sends int) (*ent.User, error) {
+	u, err := ur.db.User.UpdateOneID(id).
+		SetVerificationSentAt(sentAt).
+		SetVerificationSends(sends).
+		Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// UpdateEmail 은 새 주소로 보낸 링크를 확인한 뒤 이메일을 바꾸므로 verifiedAt 을 인증 시각으로 함께 저장합니다.
+func (ur *userRepositoryImpl) UpdateEmail(ctx context.Context, id int64, email string, verifiedAt time.Time) (*ent.User, error) {
+	u, err := ur.db.User.UpdateOneID(id).
+		SetEmail(email).
+		SetEmailVerifiedAt(verifiedAt).
+		Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, apperror.ErrUserNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, apperror.ErrEmailTaken
+		}
 		return nil, err
 	}
 
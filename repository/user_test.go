@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/privacy"
+	"halill/viewer"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRepository(t *testing.T) {
+	client := newTestClient(t)
+	ur := NewUserRepository(client)
+	ctx := viewer.NewSystemContext(context.Background())
+	user, err := ur.CreateUser(ctx, &ent.User{Email: "hwc9169@gmail.com", Password: "password", Name: "조호원"})
+	assert.NoError(t, err)
+	other, err := ur.CreateUser(ctx, &ent.User{Email: "hwc9169@naver.com", Password: "password", Name: "조호원"})
+	assert.NoError(t, err)
+
+	t.Run("본인 조회", func(t *testing.T) {
+		u, err := ur.GetByEmail(viewerContext(user.ID), "hwc9169@gmail.com")
+		assert.NoError(t, err)
+		assert.Equal(t, "조호원", u.Name)
+
+		u, err = ur.Get(viewerContext(user.ID), user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "hwc9169@gmail.com", u.Email)
+	})
+	t.Run("다른 사용자는 조회 불가", func(t *testing.T) {
+		_, err := ur.GetByEmail(viewerContext(other.ID), "hwc9169@gmail.com")
+		assert.Equal(t, apperror.ErrUserNotFound, err)
+
+		_, err = ur.Get(viewerContext(other.ID), user.ID)
+		assert.Equal(t, apperror.ErrUserNotFound, err)
+	})
+	t.Run("일반 viewer 로는 가입 불가", func(t *testing.T) {
+		_, err := ur.CreateUser(viewerContext(user.ID), &ent.User{Email: "hwc9169@kakao.com", Password: "password", Name: "조호원"})
+		assert.True(t, errors.Is(err, privacy.Deny))
+	})
+	t.Run("viewer 가 없으면 거부", func(t *testing.T) {
+		_, err := ur.GetByEmail(context.Background(), "hwc9169@gmail.com")
+		assert.True(t, errors.Is(err, privacy.Deny))
+
+		_, err = ur.Get(context.Background(), user.ID)
+		assert.True(t, errors.Is(err, privacy.Deny))
+
+		_, err = ur.CreateUser(context.Background(), &ent.User{Email: "hwc9169@kakao.com", Password: "password", Name: "조호원"})
+		assert.True(t, errors.Is(err, privacy.Deny))
+
+		_, err = ur.IncrementTokenVersion(context.Background(), user.ID)
+		assert.True(t, errors.Is(err, privacy.Deny))
+	})
+	t.Run("본인의 토큰 버전 증가", func(t *testing.T) {
+		u, err := ur.IncrementTokenVersion(viewerContext(user.ID), user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, user.TokenVersion+1, u.TokenVersion)
+	})
+	t.Run("다른 사용자의 토큰 버전은 수정 불가", func(t *testing.T) {
+		_, err := ur.IncrementTokenVersion(viewerContext(other.ID), user.ID)
+		assert.Error(t, err)
+	})
+	t.Run("이미 가입한 이메일로 가입 불가", func(t *testing.T) {
+		_, err := ur.CreateUser(ctx, &ent.User{Email: "hwc9169@gmail.com", Password: "password", Name: "조호원"})
+		assert.Equal(t, apperror.ErrEmailTaken, err)
+	})
+	t.Run("언어 설정 저장", func(t *testing.T) {
+		u, err := ur.UpdateLocale(viewerContext(user.ID), user.ID, "en")
+		assert.NoError(t, err)
+		assert.Equal(t, "en", u.Locale)
+	})
+	t.Run("비밀번호 변경", func(t *testing.T) {
+		u, err := ur.UpdatePassword(viewerContext(user.ID), user.ID, "hashed")
+		assert.NoError(t, err)
+		assert.Equal(t, "hashed", u.Password)
+	})
+	t.Run("가입 직후에는 인증 전", func(t *testing.T) {
+		u, err := ur.GetByEmail(ctx, "hwc9169@gmail.com")
+		assert.NoError(t, err)
+		assert.Nil(t, u.EmailVerifiedAt)
+		assert.Zero(t, u.VerificationSends)
+	})
+	t.Run("인증 메일 전송 기록과 인증 완료", func(t *testing.T) {
+		now := time.Now()
+		u, err := ur.UpdateVerificationSent(ctx, user.ID, now, 2)
+		assert.NoError(t, err)
+		assert.True(t, now.Equal(*u.VerificationSentAt))
+		assert.Equal(t, 2, u.VerificationSends)
+
+		u, err = ur.MarkEmailVerified(ctx, user.ID, now)
+		assert.NoError(t, err)
+		assert.NotNil(t, u.EmailVerifiedAt)
+	})
+	t.Run("이메일 변경", func(t *testing.T) {
+		todo, err := NewTodoRepository(client).Create(viewerContext(other.ID), &ent.Todo{
+			Title:   "보고서 작성",
+			Content: "",
+			Edges: ent.TodoEdges{
+				User: &ent.User{ID: other.ID},
+			},
+		})
+		assert.NoError(t, err)
+
+		now := time.Now()
+		u, err := ur.UpdateEmail(ctx, other.ID, "hwc9169@daum.net", now)
+		assert.NoError(t, err)
+		assert.Equal(t, "hwc9169@daum.net", u.Email)
+		assert.True(t, now.Equal(*u.EmailVerifiedAt))
+
+		// 다른 테이블은 id 로 사용자를 가리키므로 이메일이 바뀌어도 그대로 연결됨
+		owner, err := client.Todo.QueryUser(todo).Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, other.ID, owner.ID)
+
+		_, err = ur.GetByEmail(ctx, "hwc9169@naver.com")
+		assert.Equal(t, apperror.ErrUserNotFound, err)
+	})
+	t.Run("다른 사용자의 이메일로 변경 불가", func(t *testing.T) {
+		_, err := ur.UpdateEmail(ctx, other.ID, "hwc9169@gmail.com", time.Now())
+		assert.Equal(t, apperror.ErrEmailTaken, err)
+	})
+	t.Run("취소된 요청은 중단", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := ur.IncrementTokenVersion(ctx, user.ID)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
@@ -0,0 +1,62 @@
+package search
+
+import (
+	"context"
+	"halill/ent"
+	"halill/ent/todo"
+	"halill/ent/user"
+	"halill/repository"
+	"sort"
+)
+
+const titleWeight = 2
+
+type memoryIndex struct {
+	db *ent.Client
+}
+
+// NewMemoryIndex 는 FULLTEXT 인덱스가 없는 데이터베이스(SQLite 등)를 위한 TodoIndex 입니다.
+// 사용자의 Todo 를 모두 읽어 프로세스 안에서 검색어 등장 횟수로 점수를 매깁니다.
+func NewMemoryIndex(db *ent.Client) TodoIndex {
+	return &memoryIndex{
+		db: db,
+	}
+}
+
+func (i *memoryIndex) Search(ctx context.Context, userID int64, query string, limit int) ([]*Result, error) {
+	terms := Terms(query)
+	if len(terms) == 0 {
+		return []*Result{}, nil
+	}
+
+	todos, err := repository.WithTodoEdges(i.db.Todo.Query().
+		Where(todo.HasUserWith(user.ID(userID)))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*Result, 0)
+	for _, t := range todos {
+		score := float64(titleWeight*len(findMatches([]rune(t.Title), terms)) + len(findMatches([]rune(t.Content), terms)))
+		if score == 0 {
+			continue
+		}
+		results = append(results, &Result{
+			Todo:    t,
+			Score:   score,
+			Snippet: snippetOf(t, terms),
+		})
+	}
+	sort.SliceStable(results, func(a, b int) bool {
+		if results[a].Score != results[b].Score {
+			return results[a].Score > results[b].Score
+		}
+		return results[a].Todo.ID > results[b].Todo.ID
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
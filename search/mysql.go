@@ -0,0 +1,86 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"halill/ent"
+	"halill/ent/todo"
+	"halill/repository"
+)
+
+const mysqlSearchQuery = "SELECT `id`, MATCH(`title`, `content`) AGAINST (? IN NATURAL LANGUAGE MODE) AS `score` " +
+	"FROM `todos` " +
+	"WHERE `user_todos` = ? AND `deleted_at` IS NULL AND MATCH(`title`, `content`) AGAINST (? IN NATURAL LANGUAGE MODE) " +
+	"ORDER BY `score` DESC, `id` DESC " +
+	"LIMIT ?"
+
+type mysqlIndex struct {
+	db     *sql.DB
+	client *ent.Client
+}
+
+// NewMySQLIndex 는 todos 테이블의 FULLTEXT(ngram) 인덱스를 사용하는 TodoIndex 입니다.
+// 순위는 MySQL 의 관련도 점수를 따르고 snippet 은 가져온 Todo 에서 만듭니다.
+func NewMySQLIndex(db *sql.DB, client *ent.Client) TodoIndex {
+	return &mysqlIndex{
+		db:     db,
+		client: client,
+	}
+}
+
+func (i *mysqlIndex) Search(ctx context.Context, userID int64, query string, limit int) ([]*Result, error) {
+	terms := Terms(query)
+	if len(terms) == 0 {
+		return []*Result{}, nil
+	}
+
+	rows, err := i.db.QueryContext(ctx, mysqlSearchQuery, query, userID, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	scores := map[int64]float64{}
+	for rows.Next() {
+		var id int64
+		var score float64
+		if err := rows.Scan(&id, &score); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		scores[id] = score
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []*Result{}, nil
+	}
+
+	todos, err := repository.WithTodoEdges(i.client.Todo.Query().
+		Where(todo.IDIn(ids...))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[int64]*ent.Todo{}
+	for _, t := range todos {
+		byID[t.ID] = t
+	}
+
+	results := make([]*Result, 0, len(ids))
+	for _, id := range ids {
+		t, ok := byID[id]
+		if !ok {
+			continue
+		}
+		results = append(results, &Result{
+			Todo:    t,
+			Score:   scores[id],
+			Snippet: snippetOf(t, terms),
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,160 @@
+package search
+
+import (
+	"context"
+	"halill/ent"
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Result 는 검색된 Todo 와 관련도 점수, 검색어가 강조된 본문 일부입니다.
+type Result struct {
+	Todo    *ent.Todo
+	Score   float64
+	Snippet string
+}
+
+// TodoIndex 는 Todo 의 제목과 내용을 검색합니다.
+// 결과는 관련도가 높은 순서이며 userID 사용자의 Todo 만 포함합니다.
+type TodoIndex interface {
+	Search(ctx context.Context, userID int64, query string, limit int) ([]*Result, error)
+}
+
+const (
+	snippetLength  = 80
+	highlightOpen  = "<mark>"
+	highlightClose = "</mark>"
+)
+
+// Terms 는 검색어를 공백 기준으로 나누고 소문자로 바꿉니다.
+func Terms(query string) []string {
+	terms := make([]string, 0)
+	seen := map[string]bool{}
+	for _, field := range strings.Fields(query) {
+		term := string(lowerRunes([]rune(field)))
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	return terms
+}
+
+// Snippet 은 text 에서 검색어가 처음 나오는 부분을 중심으로 length 글자를 잘라
+// 검색어를 <mark> 로 감싸 반환합니다. 나머지 문자열은 HTML escape 됩니다.
+// 검색어가 없으면 text 의 앞부분을 반환합니다.
+func Snippet(text string, terms []string, length int) string {
+	runes := []rune(text)
+	matches := findMatches(runes, terms)
+
+	start := 0
+	if len(matches) > 0 {
+		start = matches[0][0] - length/4
+		if start < 0 {
+			start = 0
+		}
+	}
+	end := start + length
+	if end > len(runes) {
+		end = len(runes)
+		start = end - length
+		if start < 0 {
+			start = 0
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, m := range matches {
+		if m[1] <= pos || m[0] >= end {
+			continue
+		}
+		from, to := m[0], m[1]
+		if from < pos {
+			from = pos
+		}
+		if to > end {
+			to = end
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:from])))
+		b.WriteString(highlightOpen)
+		b.WriteString(html.EscapeString(string(runes[from:to])))
+		b.WriteString(highlightClose)
+		pos = to
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// snippetOf 는 검색어가 제목에만 있으면 제목에서, 아니면 내용에서 snippet 을 만듭니다.
+func snippetOf(t *ent.Todo, terms []string) string {
+	if len(findMatches([]rune(t.Content), terms)) == 0 && len(findMatches([]rune(t.Title), terms)) > 0 {
+		return Snippet(t.Title, terms, snippetLength)
+	}
+	return Snippet(t.Content, terms, snippetLength)
+}
+
+// findMatches 는 겹치지 않는 검색어 위치를 [시작, 끝) rune index 로 반환합니다.
+func findMatches(text []rune, terms []string) [][2]int {
+	lower := lowerRunes(text)
+	matches := make([][2]int, 0)
+	for _, term := range terms {
+		pattern := []rune(term)
+		if len(pattern) == 0 {
+			continue
+		}
+		for i := 0; i+len(pattern) <= len(lower); i++ {
+			if runesEqual(lower[i:i+len(pattern)], pattern) {
+				matches = append(matches, [2]int{i, i + len(pattern)})
+				i += len(pattern) - 1
+			}
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i][0] != matches[j][0] {
+			return matches[i][0] < matches[j][0]
+		}
+		return matches[i][1] > matches[j][1]
+	})
+
+	merged := make([][2]int, 0, len(matches))
+	for _, m := range matches {
+		if len(merged) > 0 && m[0] < merged[len(merged)-1][1] {
+			if m[1] > merged[len(merged)-1][1] {
+				merged[len(merged)-1][1] = m[1]
+			}
+			continue
+		}
+		merged = append(merged, m)
+	}
+
+	return merged
+}
+
+// lowerRunes 는 rune 개수를 유지한 채 소문자로 바꿉니다.
+func lowerRunes(text []rune) []rune {
+	lower := make([]rune, len(text))
+	for i, r := range text {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
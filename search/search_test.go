@@ -0,0 +1,90 @@
+package search
+
+import (
+	"context"
+	"halill/ent"
+	"halill/ent/enttest"
+	"halill/viewer"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnippet(t *testing.T) {
+	t.Run("검색어 강조", func(t *testing.T) {
+		snippet := Snippet("거래처에 보낼 인보이스 작성하기", Terms("인보이스"), 80)
+		assert.Equal(t, "거래처에 보낼 <mark>인보이스</mark> 작성하기", snippet)
+	})
+	t.Run("대소문자 구분 없이 강조하고 HTML escape", func(t *testing.T) {
+		snippet := Snippet("Send <b>Invoice</b> to invoice@example.com", Terms("INVOICE"), 80)
+		assert.Equal(t, "Send &lt;b&gt;<mark>Invoice</mark>&lt;/b&gt; to <mark>invoice</mark>@example.com", snippet)
+	})
+	t.Run("긴 본문은 검색어 주변만 자름", func(t *testing.T) {
+		text := "가나다라마바사아자차카타파하가나다라마바사아자차카타파하 인보이스 가나다라마바사아자차카타파하"
+		snippet := Snippet(text, Terms("인보이스"), 20)
+		assert.Equal(t, "…카타파하 <mark>인보이스</mark> 가나다라마바사아자차…", snippet)
+	})
+}
+
+func TestMemoryIndexSearch(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		client.Close()
+	})
+	ctx := viewer.NewSystemContext(context.Background())
+	users := make([]*ent.User, 0)
+	for _, email := range []string{"hwc9169@gmail.com", "hwc9169@naver.com"} {
+		user, err := client.User.Create().
+			SetEmail(email).
+			SetPassword("password").
+			SetName("조호원").
+			Save(ctx)
+		assert.NoError(t, err)
+		users = append(users, user)
+	}
+	fixtures := []struct {
+		owner   int
+		title   string
+		content string
+	}{
+		{0, "인보이스 발행", "3월 인보이스를 거래처에 보내기"},
+		{0, "장보기", "우유, 계란"},
+		{0, "세금 신고", "지난달 인보이스 모아두기"},
+		{1, "인보이스", "다른 사용자의 인보이스"},
+	}
+	todos := make([]*ent.Todo, 0)
+	for _, f := range fixtures {
+		todo, err := client.Todo.Create().
+			SetTitle(f.title).
+			SetContent(f.content).
+			SetIsCompleted(false).
+			SetUserID(users[f.owner].ID).
+			Save(ctx)
+		assert.NoError(t, err)
+		todos = append(todos, todo)
+	}
+	index := NewMemoryIndex(client)
+	ctx = viewer.NewContext(context.Background(), &viewer.Viewer{UserID: users[0].ID})
+
+	t.Run("관련도 순으로 검색", func(t *testing.T) {
+		results, err := index.Search(ctx, users[0].ID, "인보이스", 10)
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, todos[0].ID, results[0].Todo.ID)
+		assert.Equal(t, todos[2].ID, results[1].Todo.ID)
+		assert.Greater(t, results[0].Score, results[1].Score)
+		assert.Equal(t, "3월 <mark>인보이스</mark>를 거래처에 보내기", results[0].Snippet)
+		assert.Equal(t, users[0].ID, results[0].Todo.Edges.User.ID)
+	})
+	t.Run("limit 적용", func(t *testing.T) {
+		results, err := index.Search(ctx, users[0].ID, "인보이스", 1)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+	t.Run("일치하는 Todo 가 없음", func(t *testing.T) {
+		results, err := index.Search(ctx, users[0].ID, "회의록", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
@@ -4,7 +4,17 @@ import (
 	"github.com/golang-jwt/jwt"
 )
 
+// 토큰 종류. refresh token 이 bearer 토큰으로 쓰이거나 그 반대가 되지 않도록 구분합니다.
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+)
+
+// JwtCustomClaims 는 바뀔 수 있는 이메일 대신 사용자 ID 로 사용자를 가리킵니다.
+// TokenVersion 은 발급 당시 사용자의 token_version 으로, iat 은 초 단위라 같은 초에 무효화된 토큰을 구분하지 못하므로 이 값으로 비교합니다.
 type JwtCustomClaims struct {
-	Email string `json:"email"`
+	UserID       int64  `json:"user_id"`
+	TokenType    string `json:"token_type"`
+	TokenVersion int    `json:"ver"`
 	jwt.StandardClaims
 }
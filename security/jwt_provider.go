@@ -1,58 +1,165 @@
 package security
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"halill/ent"
+	"sort"
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+const (
+	AccessTokenExpiry  = time.Hour * 72
+	RefreshTokenExpiry = time.Hour * 24 * 14
 )
 
 type JWTProvider interface {
 	GenerateAccessToken(*ent.User) (string, error)
 	GenerateRefreshToken(*ent.User) (string, error)
-	JwtSecret() string
+	ParseToken(string) (*jwt.Token, error)
+	JWKS() *JWKSet
 }
 
 type jwtProvider struct {
-	jwtSecret string
+	signingKey       *Key
+	verificationKeys map[string]*Key
 }
 
+// NewJWTProvider 는 하나의 공유 비밀키로 HS256 서명/검증하는 provider 를 만듭니다.
+// 비밀키는 외부에 공개할 수 없으므로 JWKS 는 비어 있습니다.
 func NewJWTProvider(jwtSecret string) JWTProvider {
-	return &jwtProvider{jwtSecret}
+	key := &Key{
+		Method:     jwt.SigningMethodHS256,
+		PrivateKey: []byte(jwtSecret),
+		PublicKey:  []byte(jwtSecret),
+	}
+	return &jwtProvider{
+		signingKey:       key,
+		verificationKeys: map[string]*Key{"": key},
+	}
+}
+
+// NewKeyedJWTProvider 는 signingKey 로 서명하고 kid 헤더로 검증키를 고르는 provider 를 만듭니다.
+// 키 교체 중에는 이전 키를 verificationKeys 로 넘겨 이미 발급된 토큰을 계속 검증할 수 있습니다.
+func NewKeyedJWTProvider(signingKey *Key, verificationKeys ...*Key) (JWTProvider, error) {
+	if signingKey.PrivateKey == nil {
+		return nil, fmt.Errorf("key %s: signing key must be a private key", signingKey.ID)
+	}
+	if signingKey.ID == "" {
+		return nil, fmt.Errorf("signing key must have an id")
+	}
+
+	keys := map[string]*Key{signingKey.ID: signingKey}
+	for _, key := range verificationKeys {
+		if _, ok := keys[key.ID]; ok {
+			return nil, fmt.Errorf("key %s: duplicated key id", key.ID)
+		}
+		keys[key.ID] = key
+	}
+
+	return &jwtProvider{
+		signingKey:       signingKey,
+		verificationKeys: keys,
+	}, nil
 }
 
 func (j *jwtProvider) GenerateAccessToken(user *ent.User) (string, error) {
+	now := time.Now()
 	accessTokenClaims := &JwtCustomClaims{
 		user.ID,
+		AccessTokenType,
+		user.TokenVersion,
 		jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(time.Hour * 72).Unix(),
+			Id:        uuid.NewString(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(AccessTokenExpiry).Unix(),
 		},
 	}
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessTokenClaims)
-	at, err := accessToken.SignedString([]byte(j.JwtSecret()))
-	if err != nil {
-		return "", err
-	}
 
-	return at, nil
+	return j.sign(accessTokenClaims)
 }
 
 func (j *jwtProvider) GenerateRefreshToken(user *ent.User) (string, error) {
-	accessTokenClaims := &JwtCustomClaims{
+	// 토큰마다 해시가 달라야 저장소에서 구분할 수 있으므로 jti 를 넣습니다.
+	now := time.Now()
+	refreshTokenClaims := &JwtCustomClaims{
 		user.ID,
+		RefreshTokenType,
+		user.TokenVersion,
 		jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(time.Hour * 24 * 14).Unix(),
+			Id:        uuid.NewString(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(RefreshTokenExpiry).Unix(),
 		},
 	}
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessTokenClaims)
-	at, err := accessToken.SignedString([]byte(j.JwtSecret()))
+
+	return j.sign(refreshTokenClaims)
+}
+
+func (j *jwtProvider) sign(claims *JwtCustomClaims) (string, error) {
+	token := jwt.NewWithClaims(j.signingKey.Method, claims)
+	if j.signingKey.ID != "" {
+		token.Header["kid"] = j.signingKey.ID
+	}
+
+	signed, err := token.SignedString(j.signingKey.PrivateKey)
 	if err != nil {
 		return "", err
 	}
 
-	return at, nil
+	return signed, nil
+}
+
+// ParseToken 은 서명과 만료를 검증하고 JwtCustomClaims 를 담은 토큰을 반환합니다.
+func (j *jwtProvider) ParseToken(tokenString string) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, &JwtCustomClaims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := j.verificationKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		// 다른 알고리즘으로 위조된 토큰(alg confusion)을 막기 위해 키의 알고리즘과 비교
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+		return key.PublicKey, nil
+	})
+}
+
+func (j *jwtProvider) JWKS() *JWKSet {
+	ids := make([]string, 0, len(j.verificationKeys))
+	for id := range j.verificationKeys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	set := &JWKSet{Keys: make([]JWK, 0)}
+	for _, id := range ids {
+		if jwk, ok := keyToJWK(j.verificationKeys[id]); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+
+	return set
 }
 
-func (j *jwtProvider) JwtSecret() string {
-	return j.jwtSecret
+// HashToken 은 토큰 원문 대신 저장할 SHA-256 해시를 반환합니다.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateToken 은 URL 에 그대로 넣을 수 있는 임의의 일회용 토큰을 만듭니다.
+func GenerateToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
@@ -1,7 +1,14 @@
 package security
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"halill/ent"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang-jwt/jwt"
@@ -13,9 +20,11 @@ func TestGenerateAccessToken(t *testing.T) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
 	assert.NoError(t, err)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
-		Password: string(hashedPassword),
-		Name:     "조호원",
+		ID:           1,
+		Email:        "hwc9169@gmail.com",
+		Password:     string(hashedPassword),
+		Name:         "조호원",
+		TokenVersion: 2,
 	}
 
 	t.Run("access token 생성 성공", func(t *testing.T) {
@@ -23,11 +32,13 @@ func TestGenerateAccessToken(t *testing.T) {
 		resp, err := jp.GenerateAccessToken(user)
 		assert.NoError(t, err)
 
-		claims := &JwtCustomClaims{}
-		jwt.ParseWithClaims(resp, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jp.JwtSecret()), nil
-		})
-		assert.Equal(t, user.ID, claims.Email)
+		token, err := jp.ParseToken(resp)
+		assert.NoError(t, err)
+		claims := token.Claims.(*JwtCustomClaims)
+		assert.Equal(t, user.ID, claims.UserID)
+		assert.Equal(t, AccessTokenType, claims.TokenType)
+		assert.Equal(t, user.TokenVersion, claims.TokenVersion)
+		assert.NotEmpty(t, claims.Id)
 	})
 }
 
@@ -35,7 +46,8 @@ func TestGenerateRefreshTOkdn(t *testing.T) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
 	assert.NoError(t, err)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
 		Password: string(hashedPassword),
 		Name:     "조호원",
 	}
@@ -45,10 +57,127 @@ func TestGenerateRefreshTOkdn(t *testing.T) {
 		resp, err := jp.GenerateRefreshToken(user)
 		assert.NoError(t, err)
 
-		claims := &JwtCustomClaims{}
-		jwt.ParseWithClaims(resp, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jp.JwtSecret()), nil
-		})
-		assert.Equal(t, user.ID, claims.Email)
+		token, err := jp.ParseToken(resp)
+		assert.NoError(t, err)
+		claims := token.Claims.(*JwtCustomClaims)
+		assert.Equal(t, user.ID, claims.UserID)
+		assert.Equal(t, RefreshTokenType, claims.TokenType)
+	})
+}
+
+func writePEM(t *testing.T, blockType string, der []byte) string {
+	path := filepath.Join(t.TempDir(), "key.pem")
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	assert.NoError(t, err)
+	return path
+}
+
+func newRSAKey(t *testing.T, id string) *Key {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	key, err := LoadKeyFromPEMFile(id, writePEM(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(privateKey)))
+	assert.NoError(t, err)
+	return key
+}
+
+func newEd25519Key(t *testing.T, id string) *Key {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	assert.NoError(t, err)
+	key, err := LoadKeyFromPEMFile(id, writePEM(t, "PRIVATE KEY", der))
+	assert.NoError(t, err)
+	return key
+}
+
+func TestKeyedJWTProvider(t *testing.T) {
+	user := &ent.User{
+		ID:    1,
+		Email: "hwc9169@gmail.com",
+		Name:  "조호원",
+	}
+
+	t.Run("RS256 서명 및 검증 성공", func(t *testing.T) {
+		jp, err := NewKeyedJWTProvider(newRSAKey(t, "rsa-1"))
+		assert.NoError(t, err)
+
+		resp, err := jp.GenerateAccessToken(user)
+		assert.NoError(t, err)
+		token, err := jp.ParseToken(resp)
+		assert.NoError(t, err)
+		assert.Equal(t, "RS256", token.Method.Alg())
+		assert.Equal(t, "rsa-1", token.Header["kid"])
+		assert.Equal(t, user.ID, token.Claims.(*JwtCustomClaims).UserID)
+	})
+	t.Run("EdDSA 서명 및 검증 성공", func(t *testing.T) {
+		jp, err := NewKeyedJWTProvider(newEd25519Key(t, "ed-1"))
+		assert.NoError(t, err)
+
+		resp, err := jp.GenerateAccessToken(user)
+		assert.NoError(t, err)
+		token, err := jp.ParseToken(resp)
+		assert.NoError(t, err)
+		assert.Equal(t, "EdDSA", token.Method.Alg())
+	})
+	t.Run("교체된 이전 키로 서명된 토큰 검증 성공", func(t *testing.T) {
+		oldKey := newEd25519Key(t, "old")
+		oldProvider, err := NewKeyedJWTProvider(oldKey)
+		assert.NoError(t, err)
+		resp, err := oldProvider.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		// 새 provider 에는 이전 키의 공개키만 등록
+		oldPublic := &Key{ID: oldKey.ID, Method: oldKey.Method, PublicKey: oldKey.PublicKey}
+		jp, err := NewKeyedJWTProvider(newRSAKey(t, "new"), oldPublic)
+		assert.NoError(t, err)
+		_, err = jp.ParseToken(resp)
+		assert.NoError(t, err)
+	})
+	t.Run("알 수 없는 kid 거부", func(t *testing.T) {
+		other, err := NewKeyedJWTProvider(newEd25519Key(t, "other"))
+		assert.NoError(t, err)
+		resp, err := other.GenerateAccessToken(user)
+		assert.NoError(t, err)
+
+		jp, err := NewKeyedJWTProvider(newEd25519Key(t, "ed-1"))
+		assert.NoError(t, err)
+		_, err = jp.ParseToken(resp)
+		assert.Error(t, err)
+	})
+	t.Run("공개키로 HS256 서명한 토큰 거부", func(t *testing.T) {
+		key := newEd25519Key(t, "ed-1")
+		jp, err := NewKeyedJWTProvider(key)
+		assert.NoError(t, err)
+
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, &JwtCustomClaims{UserID: user.ID})
+		forged.Header["kid"] = "ed-1"
+		resp, err := forged.SignedString([]byte(key.PublicKey.(ed25519.PublicKey)))
+		assert.NoError(t, err)
+		_, err = jp.ParseToken(resp)
+		assert.Error(t, err)
+	})
+	t.Run("공개키만으로는 서명 불가", func(t *testing.T) {
+		key := newEd25519Key(t, "ed-1")
+		_, err := NewKeyedJWTProvider(&Key{ID: key.ID, Method: key.Method, PublicKey: key.PublicKey})
+		assert.Error(t, err)
+	})
+}
+
+func TestJWKS(t *testing.T) {
+	t.Run("모든 검증키 공개", func(t *testing.T) {
+		jp, err := NewKeyedJWTProvider(newRSAKey(t, "rsa-1"), newEd25519Key(t, "ed-1"))
+		assert.NoError(t, err)
+
+		set := jp.JWKS()
+		assert.Len(t, set.Keys, 2)
+		assert.Equal(t, "ed-1", set.Keys[0].Kid)
+		assert.Equal(t, "OKP", set.Keys[0].Kty)
+		assert.Equal(t, "Ed25519", set.Keys[0].Crv)
+		assert.Equal(t, "rsa-1", set.Keys[1].Kid)
+		assert.Equal(t, "RSA", set.Keys[1].Kty)
+		assert.Equal(t, "AQAB", set.Keys[1].E)
+	})
+	t.Run("HS256 비밀키는 공개하지 않음", func(t *testing.T) {
+		assert.Empty(t, NewJWTProvider("test_secret").JWKS().Keys)
 	})
 }
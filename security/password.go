@@ -0,0 +1,141 @@
+package security
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"halill/apperror"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bcryptMaxBytes 는 bcrypt 가 사용하는 비밀번호의 최대 길이입니다. 이후의 바이트는 무시되므로 받지 않습니다.
+const bcryptMaxBytes = 72
+
+type PasswordPolicyConfig struct {
+	// MinLength 는 최소 글자 수입니다.
+	MinLength int
+	// MaxBytes 는 최대 바이트 수이며 bcrypt 의 72 바이트를 넘을 수 없습니다.
+	MaxBytes int
+	// MinClasses 는 영문 소문자, 대문자, 숫자, 특수문자 중 섞어야 하는 종류의 수입니다.
+	MinClasses int
+	// MinScore 는 EstimateStrength 로 잰 최소 강도(0 ~ 4)입니다.
+	MinScore int
+}
+
+type PasswordPolicy interface {
+	// Validate 는 password 가 정책을 지키는지 확인하고, 어긴 규칙을 field 의 필드 에러로 반환합니다.
+	// userInputs 에는 이메일, 이름처럼 비밀번호에 들어가면 쉽게 추측되는 사용자 정보를 넘깁니다.
+	Validate(field string, password string, userInputs []string) error
+}
+
+type passwordPolicy struct {
+	config   PasswordPolicyConfig
+	breached BreachedPasswords
+}
+
+func NewPasswordPolicy(config PasswordPolicyConfig, breached BreachedPasswords) PasswordPolicy {
+	if config.MinLength <= 0 {
+		config.MinLength = 8
+	}
+	if config.MaxBytes <= 0 || config.MaxBytes > bcryptMaxBytes {
+		config.MaxBytes = bcryptMaxBytes
+	}
+	return &passwordPolicy{
+		config:   config,
+		breached: breached,
+	}
+}
+
+func (p *passwordPolicy) Validate(field string, password string, userInputs []string) error {
+	var fields []apperror.FieldError
+	if utf8.RuneCountInString(password) < p.config.MinLength {
+		fields = append(fields, apperror.FieldError{Field: field, Rule: "min_length", Param: strconv.Itoa(p.config.MinLength)})
+	}
+	if len(password) > p.config.MaxBytes {
+		fields = append(fields, apperror.FieldError{Field: field, Rule: "max_bytes", Param: strconv.Itoa(p.config.MaxBytes)})
+	}
+	if characterClasses(password) < p.config.MinClasses {
+		fields = append(fields, apperror.FieldError{Field: field, Rule: "min_classes", Param: strconv.Itoa(p.config.MinClasses)})
+	}
+	if p.breached.Contains(password) {
+		fields = append(fields, apperror.FieldError{Field: field, Rule: "breached"})
+	} else if EstimateStrength(password, userInputs) < p.config.MinScore {
+		fields = append(fields, apperror.FieldError{Field: field, Rule: "weak"})
+	}
+
+	if len(fields) > 0 {
+		return apperror.ErrValidationFailed.WithFields(fields...)
+	}
+	return nil
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, ok := range []bool{lower, upper, digit, symbol} {
+		if ok {
+			classes++
+		}
+	}
+	return classes
+}
+
+// BreachedPasswords 는 유출된 비밀번호의 SHA-1 해시 목록입니다. 비밀번호 원문은 들고 있지 않습니다.
+type BreachedPasswords map[string]struct{}
+
+// LoadBreachedPasswordsFromFile 은 한 줄에 하나씩 SHA-1 해시가 적힌 파일을 읽습니다.
+// Have I Been Pwned 에서 받은 "해시:횟수" 형식도 그대로 읽을 수 있습니다.
+func LoadBreachedPasswordsFromFile(path string) (BreachedPasswords, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseBreachedPasswords(f)
+}
+
+func ParseBreachedPasswords(r io.Reader) (BreachedPasswords, error) {
+	breached := make(BreachedPasswords)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hash := strings.SplitN(line, ":", 2)[0]
+		breached[strings.ToUpper(hash)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return breached, nil
+}
+
+func (b BreachedPasswords) Contains(password string) bool {
+	if len(b) == 0 {
+		return false
+	}
+	sum := sha1.Sum([]byte(password))
+	_, ok := b[strings.ToUpper(hex.EncodeToString(sum[:]))]
+	return ok
+}
@@ -0,0 +1,72 @@
+package security
+
+import (
+	"halill/apperror"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateStrength(t *testing.T) {
+	t.Run("흔한 비밀번호", func(t *testing.T) {
+		assert.Equal(t, 0, EstimateStrength("password", nil))
+		assert.Equal(t, 0, EstimateStrength("password1", nil))
+		assert.LessOrEqual(t, EstimateStrength("P@ssw0rd", nil), 1)
+	})
+	t.Run("반복, 연속, 키보드 배열", func(t *testing.T) {
+		assert.Equal(t, 0, EstimateStrength("aaaaaaaaaaaa", nil))
+		assert.LessOrEqual(t, EstimateStrength("abcdefgh12345678", nil), 1)
+		assert.LessOrEqual(t, EstimateStrength("qwertyuiopasdfgh", nil), 1)
+	})
+	t.Run("사용자 정보", func(t *testing.T) {
+		assert.Equal(t, 0, EstimateStrength("hwc9169", []string{"hwc9169@gmail.com"}))
+		assert.Greater(t, EstimateStrength("hwc9169", nil), EstimateStrength("hwc9169", []string{"hwc9169@gmail.com"}))
+	})
+	t.Run("무작위 비밀번호", func(t *testing.T) {
+		assert.Equal(t, 4, EstimateStrength("gK7#vQ2!mZ9x", nil))
+	})
+}
+
+func TestPasswordPolicy(t *testing.T) {
+	breached, err := ParseBreachedPasswords(strings.NewReader(`# sha1
+5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:3861493
+7c4a8d09ca3762af61e59520943dc26494f8941b
+`))
+	assert.NoError(t, err)
+	policy := NewPasswordPolicy(PasswordPolicyConfig{MinLength: 8, MinClasses: 2, MinScore: 3}, breached)
+
+	t.Run("정책을 지키면 통과", func(t *testing.T) {
+		assert.NoError(t, policy.Validate("password", "gK7#vQ2!mZ9x", nil))
+	})
+	t.Run("짧고 한 종류의 문자만 사용", func(t *testing.T) {
+		err := policy.Validate("password", "1234", nil)
+		assert.Equal(t, apperror.ErrValidationFailed.WithFields(
+			apperror.FieldError{Field: "password", Rule: "min_length", Param: "8"},
+			apperror.FieldError{Field: "password", Rule: "min_classes", Param: "2"},
+			apperror.FieldError{Field: "password", Rule: "weak"},
+		), err)
+	})
+	t.Run("bcrypt 가 무시하는 길이", func(t *testing.T) {
+		err := policy.Validate("password", "gK7#vQ2!mZ9x"+strings.Repeat("가", 30), nil)
+		assert.Equal(t, apperror.ErrValidationFailed.WithFields(
+			apperror.FieldError{Field: "password", Rule: "max_bytes", Param: "72"},
+		), err)
+	})
+	t.Run("유출된 비밀번호", func(t *testing.T) {
+		assert.True(t, breached.Contains("password"))
+		assert.True(t, breached.Contains("123456"))
+
+		err := policy.Validate("new_password", "password", nil)
+		assert.Equal(t, apperror.ErrValidationFailed.WithFields(
+			apperror.FieldError{Field: "new_password", Rule: "min_classes", Param: "2"},
+			apperror.FieldError{Field: "new_password", Rule: "breached"},
+		), err)
+	})
+	t.Run("사용자 정보가 들어간 비밀번호", func(t *testing.T) {
+		err := policy.Validate("password", "Hwc9169!", []string{"hwc9169@gmail.com", "조호원"})
+		assert.Equal(t, apperror.ErrValidationFailed.WithFields(
+			apperror.FieldError{Field: "password", Rule: "weak"},
+		), err)
+	})
+}
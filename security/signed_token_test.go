@@ -0,0 +1,39 @@
+package security
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenSigner(t *testing.T) {
+	signer := NewTokenSigner([]byte("test_secret"))
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	token := signer.Sign("email_verification", "hwc9169@gmail.com", now.Add(time.Hour))
+
+	t.Run("서명한 subject 를 돌려받음", func(t *testing.T) {
+		subject, err := signer.Verify("email_verification", token, now)
+		assert.NoError(t, err)
+		assert.Equal(t, "hwc9169@gmail.com", subject)
+	})
+	t.Run("만료된 토큰", func(t *testing.T) {
+		_, err := signer.Verify("email_verification", token, now.Add(time.Hour))
+		assert.Equal(t, ErrSignedTokenExpired, err)
+	})
+	t.Run("다른 용도나 다른 키로는 검증 실패", func(t *testing.T) {
+		_, err := signer.Verify("password_reset", token, now)
+		assert.Equal(t, ErrInvalidSignature, err)
+		_, err = NewTokenSigner([]byte("other_secret")).Verify("email_verification", token, now)
+		assert.Equal(t, ErrInvalidSignature, err)
+	})
+	t.Run("변조된 토큰", func(t *testing.T) {
+		forged := signer.Sign("email_verification", "hwc9169@naver.com", now.Add(time.Hour))
+		tampered := forged[:strings.IndexByte(forged, '.')] + token[strings.IndexByte(token, '.'):]
+		_, err := signer.Verify("email_verification", tampered, now)
+		assert.Equal(t, ErrInvalidSignature, err)
+		_, err = signer.Verify("email_verification", "not-a-token", now)
+		assert.Equal(t, ErrMalformedSignedToken, err)
+	})
+}
@@ -0,0 +1,200 @@
+package security
+
+import (
+	"bufio"
+	_ "embed"
+	"strings"
+	"unicode"
+)
+
+//go:embed passwords/common.txt
+var commonPasswordList string
+
+// commonPasswords 는 흔한 비밀번호와 그 순위입니다. 순위가 낮을수록 먼저 시도된다고 봅니다.
+var commonPasswords = loadCommonPasswords(commonPasswordList)
+
+func loadCommonPasswords(list string) map[string]float64 {
+	ranks := make(map[string]float64)
+	scanner := bufio.NewScanner(strings.NewReader(list))
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		if _, ok := ranks[word]; !ok {
+			ranks[word] = float64(len(ranks) + 1)
+		}
+	}
+	return ranks
+}
+
+var keyboardRows = []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+var leet = strings.NewReplacer("@", "a", "4", "a", "3", "e", "1", "i", "!", "i", "0", "o", "$", "s", "5", "s", "7", "t")
+
+// EstimateStrength 는 zxcvbn 처럼 비밀번호를 맞히는 데 필요한 시도 횟수를 어림해 0(매우 약함) ~ 4(매우 강함) 점으로 나타냅니다.
+// 흔한 비밀번호, 사용자 정보(userInputs), 연속된 문자(abc, 123), 반복(aaa), 키보드 배열(qwerty)은
+// 한 덩어리로 보고 글자 수와 관계없이 적은 시도로 맞힐 수 있다고 봅니다.
+func EstimateStrength(password string, userInputs []string) int {
+	guesses := estimateGuesses(password, userInputs)
+	switch {
+	case guesses < 1e3:
+		return 0
+	case guesses < 1e6:
+		return 1
+	case guesses < 1e8:
+		return 2
+	case guesses < 1e10:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func estimateGuesses(password string, userInputs []string) float64 {
+	dictionary := userDictionary(userInputs)
+	runes := []rune(password)
+	lower := []rune(strings.ToLower(password))
+	unleeted := []rune(leet.Replace(strings.ToLower(password)))
+	if len(unleeted) != len(runes) {
+		unleeted = lower
+	}
+
+	guesses := 1.0
+	for i := 0; i < len(runes); {
+		length, g := matchPattern(lower, unleeted, i, dictionary)
+		if length == 0 {
+			length, g = 1, cardinality(runes[i])
+		}
+		guesses *= g
+		i += length
+	}
+	return guesses
+}
+
+// matchPattern 은 i 에서 시작하는 가장 긴 패턴의 길이와 그 패턴을 맞히는 데 필요한 시도 횟수를 반환합니다.
+// 3 글자보다 짧은 패턴은 한 글자씩 맞히는 것과 차이가 없으므로 찾지 않습니다.
+func matchPattern(lower, unleeted []rune, i int, dictionary map[string]float64) (int, float64) {
+	bestLength, bestGuesses := 0, 0.0
+	consider := func(length int, guesses float64) {
+		if length > bestLength || (length == bestLength && guesses < bestGuesses) {
+			bestLength, bestGuesses = length, guesses
+		}
+	}
+
+	for j := len(lower); j >= i+3; j-- {
+		if rank, ok := dictionary[string(lower[i:j])]; ok {
+			consider(j-i, rank)
+		}
+		// l33t 치환은 한 번 더 시도해야 하므로 두 배로 셈
+		if rank, ok := dictionary[string(unleeted[i:j])]; ok {
+			consider(j-i, rank*2)
+		}
+	}
+
+	if length := repeatLength(lower, i); length >= 3 {
+		consider(length, cardinality(lower[i])*float64(length))
+	}
+	if length := sequenceLength(lower, i); length >= 3 {
+		consider(length, 26*float64(length))
+	}
+	if length := keyboardLength(lower, i); length >= 3 {
+		consider(length, 40*float64(length))
+	}
+
+	return bestLength, bestGuesses
+}
+
+// userDictionary 는 흔한 비밀번호에 사용자 정보를 더한 사전입니다. 이메일은 @ 앞뒤를 나눠 넣습니다.
+func userDictionary(userInputs []string) map[string]float64 {
+	if len(userInputs) == 0 {
+		return commonPasswords
+	}
+
+	dictionary := make(map[string]float64, len(commonPasswords)+len(userInputs))
+	for word, rank := range commonPasswords {
+		dictionary[word] = rank
+	}
+	for _, input := range userInputs {
+		for _, word := range strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+			return r == '@' || r == '.' || unicode.IsSpace(r)
+		}) {
+			if len([]rune(word)) >= 3 {
+				dictionary[word] = 1
+			}
+		}
+	}
+	return dictionary
+}
+
+func repeatLength(s []rune, i int) int {
+	j := i + 1
+	for j < len(s) && s[j] == s[i] {
+		j++
+	}
+	return j - i
+}
+
+// sequenceLength 는 abc, 987 처럼 1 씩 늘거나 줄어드는 문자열의 길이입니다.
+func sequenceLength(s []rune, i int) int {
+	if i+1 >= len(s) {
+		return 1
+	}
+	delta := s[i+1] - s[i]
+	if delta != 1 && delta != -1 {
+		return 1
+	}
+	j := i + 1
+	for j < len(s) && s[j]-s[j-1] == delta {
+		j++
+	}
+	return j - i
+}
+
+// keyboardLength 는 qwerty, lkjh 처럼 키보드의 한 줄을 따라 누른 문자열의 길이입니다.
+func keyboardLength(s []rune, i int) int {
+	best := 1
+	for _, row := range keyboardRows {
+		keys := []rune(row)
+		for _, step := range []int{1, -1} {
+			start := indexOf(keys, s[i])
+			if start < 0 {
+				continue
+			}
+			length := 1
+			for k := start + step; k >= 0 && k < len(keys) && i+length < len(s) && s[i+length] == keys[k]; k += step {
+				length++
+			}
+			if length > best {
+				best = length
+			}
+		}
+	}
+	return best
+}
+
+func indexOf(keys []rune, r rune) int {
+	for i, key := range keys {
+		if key == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// cardinality 는 한 글자를 무작위로 맞힐 때 시도해야 하는 문자 종류의 수입니다.
+func cardinality(r rune) float64 {
+	switch {
+	case unicode.IsDigit(r):
+		return 10
+	case unicode.IsLower(r), unicode.IsUpper(r):
+		if r > unicode.MaxASCII {
+			return 2000
+		}
+		return 26
+	case r > unicode.MaxASCII:
+		return 2000
+	default:
+		return 33
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/dto"
+	"halill/ent"
+	"halill/repository"
+)
+
+const (
+	ProjectDeleteInbox   = "inbox"
+	ProjectDeleteCascade = "cascade"
+)
+
+type ProjectService interface {
+	GetAllProjects(context.Context, *dto.ProjectListRequest, int64) ([]*dto.ProjectResponse, error)
+	CreateProject(context.Context, *dto.CreateProjectRequest, int64) (*dto.ProjectResponse, error)
+	UpdateProject(context.Context, int64, *dto.UpdateProjectRequest) (*dto.ProjectResponse, error)
+	ArchiveProject(context.Context, int64, bool) (*dto.ProjectResponse, error)
+	DeleteProject(context.Context, int64, *dto.DeleteProjectRequest) (*dto.ProjectResponse, error)
+}
+
+type projectServiceImpl struct {
+	pr repository.ProjectRepository
+}
+
+func NewProjectService(pr repository.ProjectRepository) ProjectService {
+	return &projectServiceImpl{
+		pr: pr,
+	}
+}
+
+func (s *projectServiceImpl) GetAllProjects(ctx context.Context, request *dto.ProjectListRequest, userID int64) ([]*dto.ProjectResponse, error) {
+	projects, err := s.pr.GetAllByUserID(ctx, userID, request.Archived)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.ProjectResponse, 0)
+	for _, project := range projects {
+		response = append(response, dto.ProjectToDTO(project))
+	}
+
+	return response, nil
+}
+
+func (s *projectServiceImpl) CreateProject(ctx context.Context, request *dto.CreateProjectRequest, userID int64) (*dto.ProjectResponse, error) {
+	project := &ent.Project{
+		Name:  request.Name,
+		Color: request.Color,
+		Edges: ent.ProjectEdges{
+			User: &ent.User{ID: userID},
+		},
+	}
+
+	newProject, err := s.pr.Create(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ProjectToDTO(newProject), nil
+}
+
+func (s *projectServiceImpl) UpdateProject(ctx context.Context, projectID int64, request *dto.UpdateProjectRequest) (*dto.ProjectResponse, error) {
+	project, err := s.pr.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Name = request.Name
+	project.Color = request.Color
+	project.Position = request.Position
+	updated, err := s.pr.Update(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ProjectToDTO(updated), nil
+}
+
+func (s *projectServiceImpl) ArchiveProject(ctx context.Context, projectID int64, archived bool) (*dto.ProjectResponse, error) {
+	project, err := s.pr.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Archived = archived
+	updated, err := s.pr.Update(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ProjectToDTO(updated), nil
+}
+
+func (s *projectServiceImpl) DeleteProject(ctx context.Context, projectID int64, request *dto.DeleteProjectRequest) (*dto.ProjectResponse, error) {
+	var cascade bool
+	switch request.Mode {
+	case "", ProjectDeleteInbox:
+	case ProjectDeleteCascade:
+		cascade = true
+	default:
+		return nil, apperror.ErrInvalidProjectDeleteMode
+	}
+
+	project, err := s.pr.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.pr.Delete(ctx, projectID, cascade)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ProjectToDTO(project), nil
+}
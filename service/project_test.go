@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/dto"
+	"halill/ent"
+	"halill/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAllProjects(t *testing.T) {
+	pr := new(mocks.ProjectRepository)
+	archived := false
+	expectedResponse := []*ent.Project{
+		{ID: 1, Name: "회사", Color: "#ff0000", Position: 0},
+		{ID: 2, Name: "개인", Position: 1},
+	}
+
+	t.Run("전체 프로젝트 조회 성공", func(t *testing.T) {
+		pr.On("GetAllByUserID", mock.Anything, int64(1), &archived).Return(expectedResponse, nil)
+		ps := NewProjectService(pr)
+
+		resp, err := ps.GetAllProjects(context.Background(), &dto.ProjectListRequest{Archived: &archived}, int64(1))
+		assert.NoError(t, err)
+		assert.Len(t, resp, 2)
+		assert.Equal(t, dto.ProjectToDTO(expectedResponse[0]), resp[0])
+	})
+}
+
+func TestCreateProject(t *testing.T) {
+	pr := new(mocks.ProjectRepository)
+
+	t.Run("프로젝트 생성 성공", func(t *testing.T) {
+		pr.On("Create", mock.Anything, mock.AnythingOfType("*ent.Project")).Return(&ent.Project{ID: 1, Name: "회사", Color: "#ff0000"}, nil)
+		ps := NewProjectService(pr)
+
+		resp, err := ps.CreateProject(context.Background(), &dto.CreateProjectRequest{Name: "회사", Color: "#ff0000"}, int64(1))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), resp.ID)
+
+		project := pr.Calls[0].Arguments.Get(1).(*ent.Project)
+		assert.Equal(t, int64(1), project.Edges.User.ID)
+	})
+}
+
+func TestUpdateProject(t *testing.T) {
+	user := &ent.User{ID: 1}
+	newProject := func() *ent.Project {
+		return &ent.Project{ID: 1, Name: "회사", Edges: ent.ProjectEdges{User: user}}
+	}
+
+	t.Run("프로젝트 수정 성공", func(t *testing.T) {
+		pr := new(mocks.ProjectRepository)
+		pr.On("Get", mock.Anything, int64(1)).Return(newProject(), nil)
+		pr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Project")).Return(func(_ context.Context, p *ent.Project) *ent.Project {
+			return p
+		}, nil)
+		ps := NewProjectService(pr)
+
+		resp, err := ps.UpdateProject(context.Background(), 1, &dto.UpdateProjectRequest{Name: "업무", Color: "#00ff00", Position: 3})
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.ProjectResponse{ID: 1, Name: "업무", Color: "#00ff00", Position: 3}, resp)
+	})
+	t.Run("프로젝트 보관", func(t *testing.T) {
+		pr := new(mocks.ProjectRepository)
+		pr.On("Get", mock.Anything, int64(1)).Return(newProject(), nil)
+		pr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Project")).Return(func(_ context.Context, p *ent.Project) *ent.Project {
+			return p
+		}, nil)
+		ps := NewProjectService(pr)
+
+		resp, err := ps.ArchiveProject(context.Background(), 1, true)
+		assert.NoError(t, err)
+		assert.True(t, resp.Archived)
+		assert.Equal(t, "회사", resp.Name)
+	})
+	t.Run("다른 사용자의 프로젝트", func(t *testing.T) {
+		pr := new(mocks.ProjectRepository)
+		pr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrProjectNotFound)
+		ps := NewProjectService(pr)
+
+		_, err := ps.ArchiveProject(context.Background(), 1, true)
+		assert.Equal(t, apperror.ErrProjectNotFound, err)
+		pr.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteProject(t *testing.T) {
+	user := &ent.User{ID: 1}
+	project := &ent.Project{ID: 1, Name: "회사", Edges: ent.ProjectEdges{User: user}}
+
+	t.Run("기본값은 Todo 를 Inbox 로 이동", func(t *testing.T) {
+		pr := new(mocks.ProjectRepository)
+		pr.On("Get", mock.Anything, int64(1)).Return(project, nil)
+		pr.On("Delete", mock.Anything, int64(1), false).Return(project, nil)
+		ps := NewProjectService(pr)
+
+		_, err := ps.DeleteProject(context.Background(), 1, &dto.DeleteProjectRequest{})
+		assert.NoError(t, err)
+		pr.AssertCalled(t, "Delete", mock.Anything, int64(1), false)
+	})
+	t.Run("cascade 삭제", func(t *testing.T) {
+		pr := new(mocks.ProjectRepository)
+		pr.On("Get", mock.Anything, int64(1)).Return(project, nil)
+		pr.On("Delete", mock.Anything, int64(1), true).Return(project, nil)
+		ps := NewProjectService(pr)
+
+		_, err := ps.DeleteProject(context.Background(), 1, &dto.DeleteProjectRequest{Mode: "cascade"})
+		assert.NoError(t, err)
+		pr.AssertCalled(t, "Delete", mock.Anything, int64(1), true)
+	})
+	t.Run("지원하지 않는 mode", func(t *testing.T) {
+		ps := NewProjectService(new(mocks.ProjectRepository))
+
+		_, err := ps.DeleteProject(context.Background(), 1, &dto.DeleteProjectRequest{Mode: "archive"})
+		assert.Equal(t, apperror.ErrInvalidProjectDeleteMode, err)
+	})
+}
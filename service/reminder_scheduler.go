@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"halill/ent"
+	"halill/notify"
+	"halill/repository"
+	"halill/viewer"
+	"log"
+	"time"
+)
+
+type ReminderSchedulerConfig struct {
+	// Owner 는 알림을 잡은 인스턴스를 구분하는 이름입니다. 인스턴스마다 달라야 합니다.
+	Owner      string
+	Interval   time.Duration
+	Lease      time.Duration
+	RetryDelay time.Duration
+	BatchSize  int
+}
+
+type ReminderScheduler interface {
+	Run(context.Context)
+	RunOnce(context.Context, time.Time) (int, error)
+}
+
+type reminderSchedulerImpl struct {
+	rr       repository.ReminderRepository
+	notifier notify.Notifier
+	config   ReminderSchedulerConfig
+}
+
+func NewReminderScheduler(rr repository.ReminderRepository, notifier notify.Notifier, config ReminderSchedulerConfig) ReminderScheduler {
+	if config.Interval <= 0 {
+		config.Interval = time.Minute
+	}
+	if config.Lease <= 0 {
+		config.Lease = 5 * time.Minute
+	}
+	if config.RetryDelay <= 0 {
+		config.RetryDelay = time.Minute
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	return &reminderSchedulerImpl{
+		rr:       rr,
+		notifier: notifier,
+		config:   config,
+	}
+}
+
+// Run 은 ctx 가 끝날 때까지 Interval 마다 보낼 때가 된 알림을 보냅니다.
+// 알림은 데이터베이스에 남아 있으므로 서버가 꺼져 있던 동안 놓친 알림도 시작하자마자 보냅니다.
+// 모든 사용자의 알림을 다루므로 system viewer 로 조회합니다.
+func (s *reminderSchedulerImpl) Run(ctx context.Context) {
+	ctx = viewer.NewSystemContext(ctx)
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RunOnce(ctx, time.Now()); err != nil {
+			log.Printf("reminder scheduler: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce 는 now 기준으로 보낼 때가 된 알림을 한 번 처리하고 보낸 개수를 반환합니다.
+// 다른 인스턴스가 먼저 잡은 알림은 건너뛰고, 전송에 실패한 알림은 RetryDelay 의 실패 횟수 배만큼 뒤에 다시 시도합니다.
+func (s *reminderSchedulerImpl) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	reminders, err := s.rr.GetDue(ctx, now, s.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, reminder := range reminders {
+		claimed, err := s.rr.Claim(ctx, reminder.ID, s.config.Owner, now, now.Add(s.config.Lease))
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		err = s.send(reminder)
+		if err != nil {
+			retryAt := now.Add(s.config.RetryDelay * time.Duration(reminder.Attempts+1))
+			log.Printf("reminder scheduler: reminder %d failed: %v", reminder.ID, err)
+			if err := s.rr.MarkFailed(ctx, reminder.ID, s.config.Owner, err.Error(), retryAt); err != nil {
+				return sent, err
+			}
+			continue
+		}
+
+		if err := s.rr.MarkSent(ctx, reminder.ID, s.config.Owner, time.Now()); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (s *reminderSchedulerImpl) send(reminder *ent.Reminder) error {
+	todo := reminder.Edges.Todo
+	if todo == nil || todo.Edges.User == nil || todo.Deadline == nil || reminder.RemindAt == nil {
+		return errors.New("reminder has no recipient or deadline")
+	}
+
+	return s.notifier.Notify(&notify.Notification{
+		Email:    todo.Edges.User.Email,
+		Name:     todo.Edges.User.Name,
+		TodoID:   todo.ID,
+		Title:    todo.Title,
+		Deadline: *todo.Deadline,
+		RemindAt: *reminder.RemindAt,
+	})
+}
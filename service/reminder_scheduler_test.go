@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"halill/ent"
+	"halill/mocks"
+	"halill/notify"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReminderSchedulerRunOnce(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	deadline := now.Add(time.Hour)
+	config := ReminderSchedulerConfig{Owner: "server-1", Lease: 5 * time.Minute, RetryDelay: time.Minute}
+	dueReminder := func(id int64, attempts int) *ent.Reminder {
+		return &ent.Reminder{
+			ID:            id,
+			OffsetMinutes: 60,
+			RemindAt:      &now,
+			Attempts:      attempts,
+			Edges: ent.ReminderEdges{
+				Todo: &ent.Todo{
+					ID:       1,
+					Title:    "보고서 제출",
+					Deadline: &deadline,
+					Edges:    ent.TodoEdges{User: &ent.User{ID: 1, Email: "hwc9169@gmail.com", Name: "조호원"}},
+				},
+			},
+		}
+	}
+
+	t.Run("잡은 알림만 전송", func(t *testing.T) {
+		rr := new(mocks.ReminderRepository)
+		notifier := new(mocks.Notifier)
+		rr.On("GetDue", mock.Anything, now, 100).Return([]*ent.Reminder{dueReminder(1, 0), dueReminder(2, 0)}, nil)
+		rr.On("Claim", mock.Anything, int64(1), "server-1", now, now.Add(5*time.Minute)).Return(true, nil)
+		rr.On("Claim", mock.Anything, int64(2), "server-1", now, now.Add(5*time.Minute)).Return(false, nil)
+		rr.On("MarkSent", mock.Anything, int64(1), "server-1", mock.AnythingOfType("time.Time")).Return(nil)
+		notifier.On("Notify", &notify.Notification{
+			Email:    "hwc9169@gmail.com",
+			Name:     "조호원",
+			TodoID:   1,
+			Title:    "보고서 제출",
+			Deadline: deadline,
+			RemindAt: now,
+		}).Return(nil)
+		s := NewReminderScheduler(rr, notifier, config)
+
+		sent, err := s.RunOnce(context.Background(), now)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		notifier.AssertNumberOfCalls(t, "Notify", 1)
+		rr.AssertNotCalled(t, "MarkSent", mock.Anything, int64(2), mock.Anything, mock.Anything)
+	})
+	t.Run("전송 실패는 실패 횟수만큼 늦춰 재시도", func(t *testing.T) {
+		rr := new(mocks.ReminderRepository)
+		notifier := new(mocks.Notifier)
+		rr.On("GetDue", mock.Anything, now, 100).Return([]*ent.Reminder{dueReminder(1, 2)}, nil)
+		rr.On("Claim", mock.Anything, int64(1), "server-1", now, now.Add(5*time.Minute)).Return(true, nil)
+		rr.On("MarkFailed", mock.Anything, int64(1), "server-1", "smtp down", now.Add(3*time.Minute)).Return(nil)
+		notifier.On("Notify", mock.Anything).Return(errors.New("smtp down"))
+		s := NewReminderScheduler(rr, notifier, config)
+
+		sent, err := s.RunOnce(context.Background(), now)
+		assert.NoError(t, err)
+		assert.Zero(t, sent)
+		rr.AssertCalled(t, "MarkFailed", mock.Anything, int64(1), "server-1", "smtp down", now.Add(3*time.Minute))
+		rr.AssertNotCalled(t, "MarkSent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
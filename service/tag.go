@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"halill/dto"
+	"halill/ent"
+	"halill/repository"
+)
+
+type TagService interface {
+	GetAllTags(context.Context, int64) ([]*dto.TagResponse, error)
+	CreateTag(context.Context, *dto.TagRequest, int64) (*dto.TagResponse, error)
+	UpdateTag(context.Context, int64, *dto.TagRequest) (*dto.TagResponse, error)
+	DeleteTag(context.Context, int64) (*dto.TagResponse, error)
+}
+
+type tagServiceImpl struct {
+	tgr repository.TagRepository
+}
+
+func NewTagService(tgr repository.TagRepository) TagService {
+	return &tagServiceImpl{
+		tgr: tgr,
+	}
+}
+
+func (s *tagServiceImpl) GetAllTags(ctx context.Context, userID int64) ([]*dto.TagResponse, error) {
+	tags, err := s.tgr.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.TagResponse, 0)
+	for _, tag := range tags {
+		response = append(response, dto.TagToDTO(tag))
+	}
+
+	return response, nil
+}
+
+func (s *tagServiceImpl) CreateTag(ctx context.Context, request *dto.TagRequest, userID int64) (*dto.TagResponse, error) {
+	tag := &ent.Tag{
+		Name: request.Name,
+		Edges: ent.TagEdges{
+			User: &ent.User{ID: userID},
+		},
+	}
+
+	newTag, err := s.tgr.Create(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TagToDTO(newTag), nil
+}
+
+func (s *tagServiceImpl) UpdateTag(ctx context.Context, tagID int64, request *dto.TagRequest) (*dto.TagResponse, error) {
+	tag, err := s.tgr.Get(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	tag.Name = request.Name
+	updated, err := s.tgr.Update(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TagToDTO(updated), nil
+}
+
+func (s *tagServiceImpl) DeleteTag(ctx context.Context, tagID int64) (*dto.TagResponse, error) {
+	tag, err := s.tgr.Get(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.tgr.Delete(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TagToDTO(tag), nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"halill/apperror"
+	"halill/dto"
+	"halill/ent"
+	"halill/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAllTags(t *testing.T) {
+	tgr := new(mocks.TagRepository)
+	expectedResponse := []*ent.Tag{
+		{ID: 1, Name: "개인"},
+		{ID: 2, Name: "업무"},
+	}
+
+	t.Run("전체 태그 조회 성공", func(t *testing.T) {
+		tgr.On("GetAllByUserID", mock.Anything, int64(1)).Return(expectedResponse, nil)
+		ts := NewTagService(tgr)
+
+		resp, err := ts.GetAllTags(context.Background(), int64(1))
+		assert.NoError(t, err)
+		assert.Equal(t, []*dto.TagResponse{{ID: 1, Name: "개인"}, {ID: 2, Name: "업무"}}, resp)
+	})
+}
+
+func TestCreateTag(t *testing.T) {
+	tgr := new(mocks.TagRepository)
+
+	t.Run("태그 생성 성공", func(t *testing.T) {
+		tgr.On("Create", mock.Anything, mock.AnythingOfType("*ent.Tag")).Return(&ent.Tag{ID: 1, Name: "업무"}, nil)
+		ts := NewTagService(tgr)
+
+		resp, err := ts.CreateTag(context.Background(), &dto.TagRequest{Name: "업무"}, int64(1))
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.TagResponse{ID: 1, Name: "업무"}, resp)
+
+		tag := tgr.Calls[0].Arguments.Get(1).(*ent.Tag)
+		assert.Equal(t, int64(1), tag.Edges.User.ID)
+	})
+}
+
+func TestUpdateTag(t *testing.T) {
+	user := &ent.User{ID: 1}
+
+	t.Run("태그 이름 변경 성공", func(t *testing.T) {
+		tgr := new(mocks.TagRepository)
+		tgr.On("Get", mock.Anything, int64(1)).Return(&ent.Tag{ID: 1, Name: "업무", Edges: ent.TagEdges{User: user}}, nil)
+		tgr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Tag")).Return(&ent.Tag{ID: 1, Name: "회사", Edges: ent.TagEdges{User: user}}, nil)
+		ts := NewTagService(tgr)
+
+		resp, err := ts.UpdateTag(context.Background(), 1, &dto.TagRequest{Name: "회사"})
+		assert.NoError(t, err)
+		assert.Equal(t, "회사", resp.Name)
+	})
+	t.Run("다른 사용자의 태그", func(t *testing.T) {
+		tgr := new(mocks.TagRepository)
+		tgr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTagNotFound)
+		ts := NewTagService(tgr)
+
+		_, err := ts.UpdateTag(context.Background(), 1, &dto.TagRequest{Name: "회사"})
+		assert.Equal(t, apperror.ErrTagNotFound, err)
+		tgr.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteTag(t *testing.T) {
+	user := &ent.User{ID: 1}
+	tag := &ent.Tag{ID: 1, Name: "업무", Edges: ent.TagEdges{User: user}}
+
+	t.Run("태그 삭제 성공", func(t *testing.T) {
+		tgr := new(mocks.TagRepository)
+		tgr.On("Get", mock.Anything, int64(1)).Return(tag, nil)
+		tgr.On("Delete", mock.Anything, int64(1)).Return(tag, nil)
+		ts := NewTagService(tgr)
+
+		resp, err := ts.DeleteTag(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, dto.TagToDTO(tag), resp)
+	})
+	t.Run("다른 사용자의 태그", func(t *testing.T) {
+		tgr := new(mocks.TagRepository)
+		tgr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTagNotFound)
+		ts := NewTagService(tgr)
+
+		_, err := ts.DeleteTag(context.Background(), 1)
+		assert.Equal(t, apperror.ErrTagNotFound, err)
+		tgr.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
@@ -1,87 +1,581 @@
 package service
 
 import (
+	"context"
+	"halill/apperror"
 	"halill/dto"
 	"halill/ent"
+	"halill/recurrence"
 	"halill/repository"
-	"net/http"
-
-	"github.com/labstack/echo/v4"
+	"halill/search"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type TodoService interface {
-	GetAllTodos(string) ([]*dto.TodoResponse, error)
-	GetTodo(int64, string) (*dto.TodoResponse, error)
-	CreateTodo(*dto.CreateTodoRequest, string) (*dto.TodoResponse, error)
-	CompleteTodo(int64, string) (*dto.TodoResponse, error)
-	DeleteTodo(int64, string) (*dto.TodoResponse, error)
+	GetAllTodos(context.Context, *dto.TodoListRequest, int64) (*dto.TodoPageResponse, error)
+	SearchTodos(context.Context, *dto.TodoSearchRequest, int64) ([]*dto.TodoSearchResponse, error)
+	GetNextTodos(context.Context, *dto.NextTodosRequest, int64) ([]*dto.NextTodoResponse, error)
+	GetTodo(context.Context, int64) (*dto.TodoResponse, error)
+	CreateTodo(context.Context, *dto.CreateTodoRequest, int64) (*dto.TodoResponse, error)
+	UpdateTodo(context.Context, int64, *dto.UpdateTodoRequest) (*dto.TodoResponse, error)
+	PatchTodo(context.Context, int64, *dto.PatchTodoRequest) (*dto.TodoResponse, error)
+	CompleteTodo(context.Context, int64) (*dto.TodoResponse, error)
+	GetTodoHistory(context.Context, int64) ([]*dto.TodoResponse, error)
+	AddTags(context.Context, int64, *dto.TodoTagsRequest) (*dto.TodoResponse, error)
+	RemoveTag(context.Context, int64, int64) (*dto.TodoResponse, error)
+	AddChecklistItem(context.Context, int64, *dto.CreateChecklistItemRequest) (*dto.TodoResponse, error)
+	UpdateChecklistItem(context.Context, int64, int64, *dto.UpdateChecklistItemRequest) (*dto.TodoResponse, error)
+	ReorderChecklist(context.Context, int64, *dto.ReorderChecklistRequest) (*dto.TodoResponse, error)
+	DeleteChecklistItem(context.Context, int64, int64) (*dto.TodoResponse, error)
+	AddReminder(context.Context, int64, *dto.CreateReminderRequest) (*dto.TodoResponse, error)
+	DeleteReminder(context.Context, int64, int64) (*dto.TodoResponse, error)
+	DeleteTodo(context.Context, int64) (*dto.TodoResponse, error)
+	GetTrash(context.Context, int64) ([]*dto.TodoResponse, error)
+	RestoreTodo(context.Context, int64) (*dto.TodoResponse, error)
 }
 
 type todoServiceImpl struct {
-	tr repository.TodoRepository
+	tr  repository.TodoRepository
+	tgr repository.TagRepository
+	pr  repository.ProjectRepository
+	cr  repository.ChecklistItemRepository
+	rr  repository.ReminderRepository
+	ti  search.TodoIndex
+	sw  TodoScoreWeights
 }
 
-func NewTodoService(tr repository.TodoRepository) TodoService {
+func NewTodoService(tr repository.TodoRepository, tgr repository.TagRepository, pr repository.ProjectRepository, cr repository.ChecklistItemRepository, rr repository.ReminderRepository, ti search.TodoIndex, sw TodoScoreWeights) TodoService {
 	return &todoServiceImpl{
-		tr: tr,
+		tr:  tr,
+		tgr: tgr,
+		pr:  pr,
+		cr:  cr,
+		rr:  rr,
+		ti:  ti,
+		sw:  sw,
 	}
 }
 
-func (s *todoServiceImpl) GetAllTodos(email string) ([]*dto.TodoResponse, error) {
-	todos, err := s.tr.GetAllByEmail(email)
+const (
+	defaultTodoPageSize = 20
+	maxTodoPageSize     = 100
+)
+
+func (s *todoServiceImpl) GetAllTodos(ctx context.Context, request *dto.TodoListRequest, userID int64) (*dto.TodoPageResponse, error) {
+	filter, err := todoFilter(request)
 	if err != nil {
 		return nil, err
 	}
+	limit := filter.Limit
+	// 다음 페이지가 있는지 알기 위해 하나 더 가져옵니다.
+	filter.Limit = limit + 1
 
-	response := make([]*dto.TodoResponse, 0)
+	todos, total, err := s.tr.GetAllByUserID(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TodoPageResponse{
+		Items:      make([]*dto.TodoResponse, 0),
+		TotalCount: total,
+	}
+	if len(todos) > limit {
+		todos = todos[:limit]
+		response.NextCursor = repository.NewTodoCursor(todos[limit-1]).Encode()
+	}
 	for _, todo := range todos {
-		response = append(response, dto.TodoToDTO(todo))
+		response.Items = append(response.Items, dto.TodoToDTO(todo))
+	}
+
+	return response, nil
+}
+
+func todoFilter(request *dto.TodoListRequest) (*repository.TodoFilter, error) {
+	filter := &repository.TodoFilter{
+		IsCompleted:  request.IsCompleted,
+		DeadlineFrom: request.DeadlineFrom,
+		DeadlineTo:   request.DeadlineTo,
+		Overdue:      request.Overdue,
+		TagIDs:       request.Tags,
+		SortBy:       strings.TrimPrefix(request.Sort, "-"),
+		Desc:         strings.HasPrefix(request.Sort, "-"),
+		Limit:        request.Limit,
+	}
+
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = repository.TodoSortID
+	case repository.TodoSortID, repository.TodoSortDeadline, repository.TodoSortTitle:
+	default:
+		return nil, apperror.ErrInvalidSort
+	}
+
+	switch request.Project {
+	case "":
+	case "inbox":
+		filter.InboxOnly = true
+	default:
+		projectID, err := strconv.ParseInt(request.Project, 10, 64)
+		if err != nil {
+			return nil, apperror.ErrInvalidProjectFilter
+		}
+		filter.ProjectID = &projectID
+	}
+
+	switch request.TagMode {
+	case "", "and":
+		filter.MatchAllTags = true
+	case "or":
+	default:
+		return nil, apperror.ErrInvalidTagMode
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultTodoPageSize
+	}
+	if filter.Limit > maxTodoPageSize {
+		filter.Limit = maxTodoPageSize
+	}
+
+	if request.Cursor != "" {
+		cursor, err := repository.DecodeTodoCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = cursor
+	}
+
+	return filter, nil
+}
+
+func (s *todoServiceImpl) SearchTodos(ctx context.Context, request *dto.TodoSearchRequest, userID int64) ([]*dto.TodoSearchResponse, error) {
+	if len(search.Terms(request.Query)) == 0 {
+		return nil, apperror.ErrEmptySearchQuery
+	}
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultTodoPageSize
+	}
+	if limit > maxTodoPageSize {
+		limit = maxTodoPageSize
+	}
+
+	results, err := s.ti.Search(ctx, userID, request.Query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.TodoSearchResponse, 0)
+	for _, result := range results {
+		response = append(response, &dto.TodoSearchResponse{
+			TodoResponse: dto.TodoToDTO(result.Todo),
+			Score:        result.Score,
+			Snippet:      result.Snippet,
+		})
 	}
 
 	return response, nil
 }
 
-func (s *todoServiceImpl) GetTodo(todoID int64, email string) (*dto.TodoResponse, error) {
-	todo, err := s.tr.Get(todoID)
+// GetNextTodos 는 완료하지 않은 Todo 를 점수가 높은 순서로 반환합니다.
+// 점수가 같으면 마감일이 빠른 Todo 가, 마감일도 같으면 먼저 만든 Todo 가 앞에 옵니다.
+func (s *todoServiceImpl) GetNextTodos(ctx context.Context, request *dto.NextTodosRequest, userID int64) ([]*dto.NextTodoResponse, error) {
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultTodoPageSize
+	}
+	if limit > maxTodoPageSize {
+		limit = maxTodoPageSize
+	}
+
+	todos, err := s.tr.GetNextCandidates(ctx, userID, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	if todo.Edges.User.ID != email {
-		return nil, echo.NewHTTPError(http.StatusForbidden, "해당 요청에 대한 권한이 없습니다.")
+	now := time.Now()
+	response := make([]*dto.NextTodoResponse, 0, len(todos))
+	for _, todo := range todos {
+		response = append(response, &dto.NextTodoResponse{
+			TodoResponse: dto.TodoToDTO(todo),
+			Score:        s.sw.Score(todo, now),
+		})
+	}
+	sort.Slice(response, func(a, b int) bool {
+		x, y := response[a], response[b]
+		if x.Score != y.Score {
+			return x.Score > y.Score
+		}
+		if x.Deadline == nil || y.Deadline == nil {
+			return x.Deadline != nil && y.Deadline == nil
+		}
+		if !x.Deadline.Equal(*y.Deadline) {
+			return x.Deadline.Before(*y.Deadline)
+		}
+		return x.ID < y.ID
+	})
+	if len(response) > limit {
+		response = response[:limit]
+	}
+
+	return response, nil
+}
+
+func (s *todoServiceImpl) GetTodo(ctx context.Context, todoID int64) (*dto.TodoResponse, error) {
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
 	}
 
 	return dto.TodoToDTO(todo), nil
 }
 
-func (s *todoServiceImpl) CreateTodo(request *dto.CreateTodoRequest, email string) (*dto.TodoResponse, error) {
+func (s *todoServiceImpl) CreateTodo(ctx context.Context, request *dto.CreateTodoRequest, userID int64) (*dto.TodoResponse, error) {
+	project, err := s.projectOf(ctx, request.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	rrule, err := normalizeRecurrence(request.Recurrence, request.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	timezone, err := timezoneOf(request.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := priorityOf(request.Priority)
+	if err != nil {
+		return nil, err
+	}
+
 	todo := &ent.Todo{
-		Title:    request.Title,
-		Content:  request.Content,
-		Deadline: request.Deadline,
+		Title:        request.Title,
+		Content:      request.Content,
+		Deadline:     request.Deadline,
+		AutoComplete: request.AutoComplete,
+		Recurrence:   rrule,
+		Timezone:     timezone,
+		Priority:     priority,
 		Edges: ent.TodoEdges{
-			User: &ent.User{ID: email},
+			User:    &ent.User{ID: userID},
+			Project: project,
 		},
 	}
 
-	newTodo, err := s.tr.Create(todo)
+	newTodo, err := s.tr.Create(ctx, todo)
 	if err != nil {
 		return nil, err
 	}
 	return dto.TodoToDTO(newTodo), nil
 }
 
-func (s *todoServiceImpl) CompleteTodo(todoID int64, email string) (*dto.TodoResponse, error) {
-	todo, err := s.tr.Get(todoID)
+func (s *todoServiceImpl) UpdateTodo(ctx context.Context, todoID int64, request *dto.UpdateTodoRequest) (*dto.TodoResponse, error) {
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	prevDeadline, wasCompleted := todo.Deadline, todo.IsCompleted
+
+	todo.Title = request.Title
+	todo.Content = request.Content
+	todo.Deadline = request.Deadline
+	todo.IsCompleted = request.IsCompleted
+	todo.AutoComplete = request.AutoComplete
+	todo.Recurrence, err = normalizeRecurrence(request.Recurrence, request.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	todo.Timezone, err = timezoneOf(request.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	todo.Priority, err = priorityOf(request.Priority)
+	if err != nil {
+		return nil, err
+	}
+	todo.Edges.Project, err = s.projectOf(ctx, request.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.update(ctx, todo, prevDeadline, wasCompleted)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(updated), nil
+}
+
+func (s *todoServiceImpl) PatchTodo(ctx context.Context, todoID int64, request *dto.PatchTodoRequest) (*dto.TodoResponse, error) {
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	prevDeadline, wasCompleted := todo.Deadline, todo.IsCompleted
+	request.Apply(todo)
+	todo.Recurrence, err = normalizeRecurrence(todo.Recurrence, todo.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	todo.Timezone, err = timezoneOf(todo.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	if request.Priority != nil {
+		todo.Priority, err = priorityOf(*request.Priority)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if request.ProjectIDSet {
+		todo.Edges.Project, err = s.projectOf(ctx, request.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.update(ctx, todo, prevDeadline, wasCompleted)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(updated), nil
+}
+
+// update 는 Todo 를 저장하고, 마감일이 바뀌었으면 알림 시각을 다시 계산합니다.
+// 미완료에서 완료로 바뀌면 CompleteTodo 와 같이 complete 로 완료해 반복 Todo 의 다음 회차를 만듭니다.
+func (s *todoServiceImpl) update(ctx context.Context, todo *ent.Todo, prevDeadline *time.Time, wasCompleted bool) (*ent.Todo, error) {
+	completing := todo.IsCompleted && !wasCompleted
+	if completing {
+		todo.IsCompleted = false
+	}
+
+	updated, err := s.tr.Update(ctx, todo)
+	if err != nil {
+		return nil, err
+	}
+	if !sameDeadline(prevDeadline, updated.Deadline) && len(updated.Edges.Reminders) > 0 {
+		if err := s.rr.Reschedule(ctx, updated.ID, updated.Deadline); err != nil {
+			return nil, err
+		}
+		updated, err = s.tr.Get(ctx, updated.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if completing {
+		return s.complete(ctx, updated)
+	}
+
+	return updated, nil
+}
+
+func sameDeadline(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func (s *todoServiceImpl) CompleteTodo(ctx context.Context, todoID int64) (*dto.TodoResponse, error) {
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := s.complete(ctx, todo)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(completed), nil
+}
+
+// GetTodoHistory 는 반복 Todo 의 지난 회차와 현재 회차를 회차 순서로 반환합니다.
+func (s *todoServiceImpl) GetTodoHistory(ctx context.Context, todoID int64) ([]*dto.TodoResponse, error) {
+	_, err := s.tr.Get(ctx, todoID)
 	if err != nil {
 		return nil, err
 	}
 
-	if todo.Edges.User.ID != email {
-		return nil, echo.NewHTTPError(http.StatusForbidden, "해당 요청에 대한 권한이 없습니다.")
+	todos, err := s.tr.GetOccurrences(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.TodoResponse, 0)
+	for _, todo := range todos {
+		response = append(response, dto.TodoToDTO(todo))
+	}
+
+	return response, nil
+}
+
+// complete 는 Todo 를 완료하고, 반복 Todo 이면 다음 마감일로 다음 회차를 만듭니다.
+// 이미 완료된 Todo 를 다시 완료하거나 같은 Todo 를 동시에 완료할 때는 다음 회차를 한 번만 만듭니다.
+func (s *todoServiceImpl) complete(ctx context.Context, todo *ent.Todo) (*ent.Todo, error) {
+	next, err := nextOccurrence(todo)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tr.Complete(ctx, todo.ID, next)
+}
+
+// nextOccurrence 는 아직 완료되지 않은 반복 Todo 의 다음 회차 마감일을 계산합니다. 다음 회차가 없으면 nil 입니다.
+// DB 에서 읽은 마감일은 UTC 이므로 Todo 의 시간대로 바꿔 요일과 날짜를 계산합니다.
+func nextOccurrence(todo *ent.Todo) (*time.Time, error) {
+	if todo.IsCompleted || todo.Recurrence == "" || todo.Deadline == nil {
+		return nil, nil
 	}
 
-	_, err = s.tr.Complete(todoID)
+	rule, err := recurrence.Parse(todo.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(todo.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	next, ok := rule.Next(todo.Deadline.In(loc), todo.Occurrence)
+	if !ok {
+		return nil, nil
+	}
+
+	return &next, nil
+}
+
+// normalizeRecurrence 는 RRULE 을 검사해 정규화된 문자열로 바꿉니다.
+// 다음 회차의 마감일은 이전 마감일로부터 계산하므로 반복 Todo 에는 마감일이 있어야 합니다.
+func normalizeRecurrence(rrule string, deadline *time.Time) (string, error) {
+	if strings.TrimSpace(rrule) == "" {
+		return "", nil
+	}
+
+	rule, err := recurrence.Parse(rrule)
+	if err != nil {
+		return "", apperror.ErrInvalidRecurrence
+	}
+	if deadline == nil {
+		return "", apperror.ErrRecurrenceNeedsDeadline
+	}
+
+	return rule.String(), nil
+}
+
+// timezoneOf 는 IANA 시간대 이름을 검사합니다. 비어 있으면 UTC 입니다.
+// Local 은 서버 설정에 따라 달라지므로 허용하지 않습니다.
+func timezoneOf(name string) (string, error) {
+	if name == "" {
+		return "UTC", nil
+	}
+	if name == "Local" {
+		return "", apperror.ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return "", apperror.ErrInvalidTimezone
+	}
+
+	return name, nil
+}
+
+func (s *todoServiceImpl) DeleteTodo(ctx context.Context, todoID int64) (*dto.TodoResponse, error) {
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.tr.Delete(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(todo), nil
+}
+
+func (s *todoServiceImpl) GetTrash(ctx context.Context, userID int64) ([]*dto.TodoResponse, error) {
+	todos, err := s.tr.GetTrashByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.TodoResponse, 0)
+	for _, todo := range todos {
+		response = append(response, dto.TodoToDTO(todo))
+	}
+
+	return response, nil
+}
+
+// RestoreTodo 는 휴지통의 Todo 를 되돌립니다. 그 사이 프로젝트가 삭제되었다면 Inbox 로 돌아옵니다.
+func (s *todoServiceImpl) RestoreTodo(ctx context.Context, todoID int64) (*dto.TodoResponse, error) {
+	_, err := s.tr.GetTrashed(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := s.tr.Restore(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(restored), nil
+}
+
+func (s *todoServiceImpl) AddTags(ctx context.Context, todoID int64, request *dto.TodoTagsRequest) (*dto.TodoResponse, error) {
+	_, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tagID := range request.TagIDs {
+		if _, err := s.tgr.Get(ctx, tagID); err != nil {
+			return nil, err
+		}
+	}
+
+	todo, err := s.tr.AddTags(ctx, todoID, request.TagIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(todo), nil
+}
+
+func (s *todoServiceImpl) RemoveTag(ctx context.Context, todoID int64, tagID int64) (*dto.TodoResponse, error) {
+	_, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := s.tr.RemoveTags(ctx, todoID, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(todo), nil
+}
+
+func (s *todoServiceImpl) AddChecklistItem(ctx context.Context, todoID int64, request *dto.CreateChecklistItemRequest) (*dto.TodoResponse, error) {
+	_, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.cr.Create(ctx, &ent.ChecklistItem{
+		Title: request.Title,
+		Edges: ent.ChecklistItemEdges{
+			Todo: &ent.Todo{ID: todoID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := s.tr.Get(ctx, todoID)
 	if err != nil {
 		return nil, err
 	}
@@ -89,20 +583,181 @@ func (s *todoServiceImpl) CompleteTodo(todoID int64, email string) (*dto.TodoRes
 	return dto.TodoToDTO(todo), nil
 }
 
-func (s *todoServiceImpl) DeleteTodo(todoID int64, email string) (*dto.TodoResponse, error) {
-	todo, err := s.tr.Get(todoID)
+func (s *todoServiceImpl) UpdateChecklistItem(ctx context.Context, todoID int64, itemID int64, request *dto.UpdateChecklistItemRequest) (*dto.TodoResponse, error) {
+	_, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.getChecklistItem(ctx, todoID, itemID)
 	if err != nil {
 		return nil, err
 	}
 
-	if todo.Edges.User.ID != email {
-		return nil, echo.NewHTTPError(http.StatusForbidden, "해당 요청에 대한 권한이 없습니다.")
+	item.Title = request.Title
+	item.IsChecked = request.IsChecked
+	_, err = s.cr.Update(ctx, item)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = s.tr.Delete(todoID)
+	todo, err := s.autoComplete(ctx, todoID)
 	if err != nil {
 		return nil, err
 	}
 
 	return dto.TodoToDTO(todo), nil
 }
+
+func (s *todoServiceImpl) ReorderChecklist(ctx context.Context, todoID int64, request *dto.ReorderChecklistRequest) (*dto.TodoResponse, error) {
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := map[int64]bool{}
+	for _, item := range todo.Edges.ChecklistItems {
+		remaining[item.ID] = true
+	}
+	for _, itemID := range request.ItemIDs {
+		if !remaining[itemID] {
+			return nil, apperror.ErrInvalidChecklistOrder
+		}
+		delete(remaining, itemID)
+	}
+	if len(remaining) > 0 {
+		return nil, apperror.ErrInvalidChecklistOrder
+	}
+
+	err = s.cr.Reorder(ctx, todoID, request.ItemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err = s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(todo), nil
+}
+
+func (s *todoServiceImpl) DeleteChecklistItem(ctx context.Context, todoID int64, itemID int64) (*dto.TodoResponse, error) {
+	_, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.getChecklistItem(ctx, todoID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.cr.Delete(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := s.autoComplete(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(todo), nil
+}
+
+func (s *todoServiceImpl) AddReminder(ctx context.Context, todoID int64, request *dto.CreateReminderRequest) (*dto.TodoResponse, error) {
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if request.OffsetMinutes < 0 {
+		return nil, apperror.ErrInvalidReminderOffset
+	}
+	if todo.Deadline == nil {
+		return nil, apperror.ErrReminderNeedsDeadline
+	}
+
+	_, err = s.rr.Create(ctx, &ent.Reminder{
+		OffsetMinutes: request.OffsetMinutes,
+		Edges: ent.ReminderEdges{
+			Todo: &ent.Todo{ID: todoID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err = s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(todo), nil
+}
+
+func (s *todoServiceImpl) DeleteReminder(ctx context.Context, todoID int64, reminderID int64) (*dto.TodoResponse, error) {
+	_, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	reminder, err := s.rr.Get(ctx, reminderID)
+	if err != nil {
+		return nil, err
+	}
+	if reminder.Edges.Todo == nil || reminder.Edges.Todo.ID != todoID {
+		return nil, apperror.ErrReminderNotFound
+	}
+
+	_, err = s.rr.Delete(ctx, reminderID)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TodoToDTO(todo), nil
+}
+
+// autoComplete 는 auto_complete 가 켜진 Todo 의 체크리스트가 모두 완료되었으면 Todo 를 완료합니다.
+// 항목의 체크를 해제해도 이미 완료된 Todo 를 되돌리지는 않습니다.
+func (s *todoServiceImpl) autoComplete(ctx context.Context, todoID int64) (*ent.Todo, error) {
+	todo, err := s.tr.Get(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if !todo.AutoComplete || todo.IsCompleted || len(todo.Edges.ChecklistItems) == 0 {
+		return todo, nil
+	}
+	for _, item := range todo.Edges.ChecklistItems {
+		if !item.IsChecked {
+			return todo, nil
+		}
+	}
+
+	return s.complete(ctx, todo)
+}
+
+func (s *todoServiceImpl) getChecklistItem(ctx context.Context, todoID int64, itemID int64) (*ent.ChecklistItem, error) {
+	item, err := s.cr.Get(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Edges.Todo == nil || item.Edges.Todo.ID != todoID {
+		return nil, apperror.ErrChecklistItemNotFound
+	}
+
+	return item, nil
+}
+
+// projectOf 는 Todo 를 옮길 프로젝트를 조회합니다. projectID 가 nil 이면 Inbox 입니다.
+// 다른 사용자의 프로젝트는 Policy 가 걸러내므로 찾을 수 없습니다.
+func (s *todoServiceImpl) projectOf(ctx context.Context, projectID *int64) (*ent.Project, error) {
+	if projectID == nil {
+		return nil, nil
+	}
+
+	return s.pr.Get(ctx, *projectID)
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"halill/apperror"
+	"halill/ent"
+	"halill/ent/todo"
+	"time"
+)
+
+var priorityRanks = map[todo.Priority]float64{
+	todo.PriorityNone:   0,
+	todo.PriorityLow:    1,
+	todo.PriorityMedium: 2,
+	todo.PriorityHigh:   3,
+	todo.PriorityUrgent: 4,
+}
+
+// TodoScoreWeights 는 GET /todo/next 의 순위를 매기는 가중치입니다.
+// 점수는 Priority×(우선순위 0~1) + Deadline×(마감일 근접도 0~1) + Overdue×(마감일이 지났으면 1) 입니다.
+// 마감일 근접도는 마감일이 Horizon 보다 멀면 0 이고 가까워질수록 1 에 가까워지며, 마감일이 지나면 1 입니다.
+// 후보를 우선순위마다 마감일 순서로 잘라 가져오므로 가중치는 0 이상이어야 합니다.
+type TodoScoreWeights struct {
+	Priority float64
+	Deadline float64
+	Overdue  float64
+	Horizon  time.Duration
+}
+
+func DefaultTodoScoreWeights() TodoScoreWeights {
+	return TodoScoreWeights{
+		Priority: 1,
+		Deadline: 1,
+		Overdue:  1,
+		Horizon:  7 * 24 * time.Hour,
+	}
+}
+
+func (w TodoScoreWeights) Score(t *ent.Todo, now time.Time) float64 {
+	score := w.Priority * priorityRanks[t.Priority] / priorityRanks[todo.PriorityUrgent]
+	if t.Deadline == nil {
+		return score
+	}
+
+	remaining := t.Deadline.Sub(now)
+	switch {
+	case remaining <= 0:
+		score += w.Deadline + w.Overdue
+	case remaining < w.Horizon:
+		score += w.Deadline * (1 - float64(remaining)/float64(w.Horizon))
+	}
+
+	return score
+}
+
+// priorityOf 는 요청의 priority 를 검사합니다. 비어 있으면 none 입니다.
+func priorityOf(priority string) (todo.Priority, error) {
+	if priority == "" {
+		return todo.PriorityNone, nil
+	}
+	if err := todo.PriorityValidator(todo.Priority(priority)); err != nil {
+		return "", apperror.ErrInvalidPriority
+	}
+
+	return todo.Priority(priority), nil
+}
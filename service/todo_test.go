@@ -1,56 +1,242 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
+	"halill/apperror"
 	"halill/dto"
 	"halill/ent"
 	"halill/mocks"
-	"net/http"
+	"halill/repository"
+	"halill/search"
 	"testing"
 	"time"
 
-	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestGetAllTodos(t *testing.T) {
-	tr := new(mocks.TodoRepository)
+	deadline := time.Now().Add(24 * 3 * time.Hour)
+	expectedResponse := []*ent.Todo{
+		{
+			ID:          1,
+			Title:       "Go 언어 공부하기",
+			Content:     "장재휴의 Go 웹 프로그래밍 철저 입문",
+			Deadline:    &deadline,
+			IsCompleted: false,
+		}, {
+			ID:          2,
+			Title:       "CEO가 해야하는 일",
+			Content:     "역대 CEO가 공부하는 것들 찾아보기",
+			IsCompleted: false,
+		},
+	}
+	userID := int64(1)
 
 	t.Run("전체 Todo 조회 성공", func(t *testing.T) {
-		deadline := time.Now().Add(24 * 3 * time.Hour)
-		expectedResponse := []*ent.Todo{
-			{
-				ID:          1,
-				Title:       "Go 언어 공부하기",
-				Content:     "장재휴의 Go 웹 프로그래밍 철저 입문",
-				Deadline:    &deadline,
-				IsCompleted: false,
-			}, {
-				ID:          2,
-				Title:       "CEO가 해야하는 일",
-				Content:     "역대 CEO가 공부하는 것들 찾아보기",
-				IsCompleted: false,
-			},
-		}
-		tr.On("GetAllByEmail", mock.AnythingOfType("string")).Return(expectedResponse, nil)
-		ts := NewTodoService(tr)
+		tr := new(mocks.TodoRepository)
+		tr.On("GetAllByUserID", mock.Anything, userID, mock.AnythingOfType("*repository.TodoFilter")).Return(expectedResponse, 2, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
 
-		email := "hwc9169@gmail.com"
-		resp, err := ts.GetAllTodos(email)
+		resp, err := ts.GetAllTodos(context.Background(), &dto.TodoListRequest{}, userID)
 		assert.NoError(t, err)
 
 		expected := make([]*dto.TodoResponse, 0)
 		for _, todo := range expectedResponse {
 			expected = append(expected, dto.TodoToDTO(todo))
 		}
-		assert.Equal(t, expected, resp)
+		assert.Equal(t, expected, resp.Items)
+		assert.Equal(t, 2, resp.TotalCount)
+		assert.Empty(t, resp.NextCursor)
+
+		filter := tr.Calls[0].Arguments.Get(2).(*repository.TodoFilter)
+		assert.Equal(t, repository.TodoSortID, filter.SortBy)
+		assert.Equal(t, defaultTodoPageSize+1, filter.Limit)
+	})
+	t.Run("다음 페이지 cursor 반환", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("GetAllByUserID", mock.Anything, userID, mock.AnythingOfType("*repository.TodoFilter")).Return(expectedResponse, 5, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.GetAllTodos(context.Background(), &dto.TodoListRequest{Sort: "-deadline", Limit: 1}, userID)
+		assert.NoError(t, err)
+		assert.Len(t, resp.Items, 1)
+		assert.Equal(t, 5, resp.TotalCount)
+
+		cursor, err := repository.DecodeTodoCursor(resp.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), cursor.ID)
+		assert.True(t, deadline.Equal(*cursor.Deadline))
+
+		filter := tr.Calls[0].Arguments.Get(2).(*repository.TodoFilter)
+		assert.Equal(t, repository.TodoSortDeadline, filter.SortBy)
+		assert.True(t, filter.Desc)
+		assert.Equal(t, 2, filter.Limit)
+	})
+	t.Run("지원하지 않는 정렬 기준", func(t *testing.T) {
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.GetAllTodos(context.Background(), &dto.TodoListRequest{Sort: "content"}, userID)
+		assert.Equal(t, apperror.ErrInvalidSort, err)
+	})
+	t.Run("태그 필터 OR", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("GetAllByUserID", mock.Anything, userID, mock.AnythingOfType("*repository.TodoFilter")).Return(expectedResponse, 2, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.GetAllTodos(context.Background(), &dto.TodoListRequest{Tags: []int64{1, 2}, TagMode: "or"}, userID)
+		assert.NoError(t, err)
+
+		filter := tr.Calls[0].Arguments.Get(2).(*repository.TodoFilter)
+		assert.Equal(t, []int64{1, 2}, filter.TagIDs)
+		assert.False(t, filter.MatchAllTags)
+	})
+	t.Run("Inbox 필터", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("GetAllByUserID", mock.Anything, userID, mock.AnythingOfType("*repository.TodoFilter")).Return(expectedResponse, 2, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.GetAllTodos(context.Background(), &dto.TodoListRequest{Project: "inbox"}, userID)
+		assert.NoError(t, err)
+
+		filter := tr.Calls[0].Arguments.Get(2).(*repository.TodoFilter)
+		assert.True(t, filter.InboxOnly)
+		assert.Nil(t, filter.ProjectID)
+	})
+	t.Run("잘못된 project", func(t *testing.T) {
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.GetAllTodos(context.Background(), &dto.TodoListRequest{Project: "work"}, userID)
+		assert.Equal(t, apperror.ErrInvalidProjectFilter, err)
+	})
+	t.Run("지원하지 않는 tag_mode", func(t *testing.T) {
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.GetAllTodos(context.Background(), &dto.TodoListRequest{Tags: []int64{1}, TagMode: "xor"}, userID)
+		assert.Equal(t, apperror.ErrInvalidTagMode, err)
+	})
+	t.Run("잘못된 cursor", func(t *testing.T) {
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.GetAllTodos(context.Background(), &dto.TodoListRequest{Cursor: "!!"}, userID)
+		assert.Equal(t, apperror.ErrInvalidCursor, err)
+	})
+}
+
+func TestSearchTodos(t *testing.T) {
+	userID := int64(1)
+	results := []*search.Result{
+		{
+			Todo: &ent.Todo{
+				ID:      1,
+				Title:   "인보이스 발행",
+				Content: "3월 인보이스를 거래처에 보내기",
+			},
+			Score:   3,
+			Snippet: "3월 <mark>인보이스</mark>를 거래처에 보내기",
+		},
+	}
+
+	t.Run("Todo 검색 성공", func(t *testing.T) {
+		ti := new(mocks.TodoIndex)
+		ti.On("Search", mock.Anything, userID, "인보이스", defaultTodoPageSize).Return(results, nil)
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), ti, DefaultTodoScoreWeights())
+
+		resp, err := ts.SearchTodos(context.Background(), &dto.TodoSearchRequest{Query: "인보이스"}, userID)
+		assert.NoError(t, err)
+		assert.Len(t, resp, 1)
+		assert.Equal(t, dto.TodoToDTO(results[0].Todo), resp[0].TodoResponse)
+		assert.Equal(t, results[0].Snippet, resp[0].Snippet)
+		assert.Equal(t, float64(3), resp[0].Score)
+	})
+	t.Run("검색어가 비어 있음", func(t *testing.T) {
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.SearchTodos(context.Background(), &dto.TodoSearchRequest{Query: "  "}, userID)
+		assert.Equal(t, apperror.ErrEmptySearchQuery, err)
+	})
+}
+
+func TestTodoScoreWeights(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	w := TodoScoreWeights{Priority: 2, Deadline: 1, Overdue: 0.5, Horizon: 4 * 24 * time.Hour}
+	at := func(d time.Duration) *time.Time {
+		deadline := now.Add(d)
+		return &deadline
+	}
+
+	for _, tc := range []struct {
+		name  string
+		todo  *ent.Todo
+		score float64
+	}{
+		{"우선순위와 마감일 없음", &ent.Todo{Priority: "none"}, 0},
+		{"urgent", &ent.Todo{Priority: "urgent"}, 2},
+		{"medium, 마감일이 Horizon 밖", &ent.Todo{Priority: "medium", Deadline: at(5 * 24 * time.Hour)}, 1},
+		{"마감일까지 하루", &ent.Todo{Priority: "none", Deadline: at(24 * time.Hour)}, 0.75},
+		{"마감일이 지남", &ent.Todo{Priority: "low", Deadline: at(-time.Hour)}, 0.5 + 1 + 0.5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.score, w.Score(tc.todo, now), 1e-9)
+		})
+	}
+}
+
+func TestGetNextTodos(t *testing.T) {
+	user := &ent.User{ID: 1}
+	soon := time.Now().Add(time.Hour)
+	later := time.Now().Add(30 * 24 * time.Hour)
+	overdue := time.Now().Add(-time.Hour)
+	todos := []*ent.Todo{
+		{ID: 1, Title: "지난 마감", Priority: "none", Deadline: &overdue, Edges: ent.TodoEdges{User: user}},
+		{ID: 2, Title: "곧 마감", Priority: "low", Deadline: &soon, Edges: ent.TodoEdges{User: user}},
+		{ID: 3, Title: "한참 뒤", Priority: "urgent", Deadline: &later, Edges: ent.TodoEdges{User: user}},
+		{ID: 4, Title: "마감일 없음", Priority: "none", Edges: ent.TodoEdges{User: user}},
+	}
+
+	t.Run("점수 순서로 정렬", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("GetNextCandidates", mock.Anything, user.ID, 3).Return(todos, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), TodoScoreWeights{Priority: 1, Deadline: 1, Overdue: 1, Horizon: 7 * 24 * time.Hour})
+
+		resp, err := ts.GetNextTodos(context.Background(), &dto.NextTodosRequest{Limit: 3}, user.ID)
+		assert.NoError(t, err)
+		assert.Len(t, resp, 3)
+		assert.Equal(t, []int64{1, 2, 3}, []int64{resp[0].ID, resp[1].ID, resp[2].ID})
+		assert.Greater(t, resp[0].Score, resp[1].Score)
+	})
+	t.Run("가중치에 따라 순서가 바뀜", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("GetNextCandidates", mock.Anything, user.ID, defaultTodoPageSize).Return(todos, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), TodoScoreWeights{Priority: 10, Deadline: 1, Overdue: 0, Horizon: 7 * 24 * time.Hour})
+
+		resp, err := ts.GetNextTodos(context.Background(), &dto.NextTodosRequest{}, user.ID)
+		assert.NoError(t, err)
+		assert.Len(t, resp, 4)
+		assert.Equal(t, int64(3), resp[0].ID)
+	})
+	t.Run("점수가 같으면 마감일, 만든 순서", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		// 우선순위마다 따로 가져오므로 마감일 순서로 오지 않음
+		tr.On("GetNextCandidates", mock.Anything, user.ID, defaultTodoPageSize).Return([]*ent.Todo{
+			{ID: 6, Priority: "none", Edges: ent.TodoEdges{User: user}},
+			{ID: 5, Priority: "low", Deadline: &later, Edges: ent.TodoEdges{User: user}},
+			{ID: 4, Priority: "none", Deadline: &later, Edges: ent.TodoEdges{User: user}},
+			{ID: 7, Priority: "none", Deadline: &later, Edges: ent.TodoEdges{User: user}},
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), TodoScoreWeights{Priority: 0, Deadline: 1, Overdue: 1, Horizon: 7 * 24 * time.Hour})
+
+		resp, err := ts.GetNextTodos(context.Background(), &dto.NextTodosRequest{}, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{4, 5, 7, 6}, []int64{resp[0].ID, resp[1].ID, resp[2].ID, resp[3].ID})
 	})
 }
 
 func TestGetTodo(t *testing.T) {
 	tr := new(mocks.TodoRepository)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
 		Password: "password",
 		Name:     "조호원",
 	}
@@ -66,25 +252,23 @@ func TestGetTodo(t *testing.T) {
 		},
 	}
 	t.Run("Todo 조회 성공", func(t *testing.T) {
-		tr.On("Get", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		ts := NewTodoService(tr)
+		tr.On("Get", mock.Anything, mock.AnythingOfType("int64")).Return(expectedResponse, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
 
 		todoID := int64(1)
-		email := "hwc9169@gmail.com"
-		resp, err := ts.GetTodo(todoID, email)
+		resp, err := ts.GetTodo(context.Background(), todoID)
 		assert.NoError(t, err)
 
 		expected := dto.TodoToDTO(expectedResponse)
 		assert.Equal(t, expected, resp)
 	})
-	t.Run("권한 오류 발생", func(t *testing.T) {
-		tr.On("Get", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		ts := NewTodoService(tr)
+	t.Run("다른 사용자의 Todo", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTodoNotFound)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
 
-		todoID := int64(1)
-		email := "hwc9169@naver.com"
-		_, err := ts.GetTodo(todoID, email)
-		assert.Equal(t, err, echo.NewHTTPError(http.StatusForbidden, "해당 요청에 대한 권한이 없습니다."))
+		_, err := ts.GetTodo(context.Background(), 1)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
 	})
 }
 
@@ -100,26 +284,219 @@ func TestCreateTodo(t *testing.T) {
 			Deadline:    &deadline,
 			IsCompleted: false,
 		}
-		tr.On("Create", mock.AnythingOfType("*ent.Todo")).Return(expectedResponse, nil)
-		ts := NewTodoService(tr)
+		tr.On("Create", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(expectedResponse, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
 
-		email := "hwc9169@gmail.com"
-		resp, err := ts.CreateTodo(&dto.CreateTodoRequest{
+		userID := int64(1)
+		resp, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{
 			Title:    "Go 언어 공부하기",
 			Content:  "장재휴의 Go 웹 프로그래밍 철저 입문",
 			Deadline: &deadline,
-		}, email)
+		}, userID)
 		assert.NoError(t, err)
 
 		expected := dto.TodoToDTO(expectedResponse)
 		assert.Equal(t, expected, resp)
 	})
+	t.Run("프로젝트에 Todo 생성", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		pr := new(mocks.ProjectRepository)
+		project := &ent.Project{ID: 3, Name: "회사", Edges: ent.ProjectEdges{User: &ent.User{ID: 1}}}
+		pr.On("Get", mock.Anything, int64(3)).Return(project, nil)
+		tr.On("Create", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), pr, new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		projectID := int64(3)
+		resp, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{Title: "보고서 작성", ProjectID: &projectID}, int64(1))
+		assert.NoError(t, err)
+		assert.Equal(t, projectID, *resp.ProjectID)
+	})
+	t.Run("다른 사용자의 프로젝트", func(t *testing.T) {
+		pr := new(mocks.ProjectRepository)
+		pr.On("Get", mock.Anything, int64(3)).Return(nil, apperror.ErrProjectNotFound)
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), pr, new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		projectID := int64(3)
+		_, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{Title: "보고서 작성", ProjectID: &projectID}, int64(1))
+		assert.Equal(t, apperror.ErrProjectNotFound, err)
+	})
+}
+
+func TestTodoPriority(t *testing.T) {
+	user := &ent.User{ID: 1}
+
+	t.Run("priority 를 보내지 않으면 none", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Create", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{Title: "장보기"}, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "none", resp.Priority)
+	})
+	t.Run("PATCH 로 priority 변경", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(&ent.Todo{ID: 1, Title: "장보기", Priority: "none", Edges: ent.TodoEdges{User: user}}, nil)
+		tr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		var patch dto.PatchTodoRequest
+		assert.NoError(t, json.Unmarshal([]byte(`{"priority": "urgent"}`), &patch))
+		resp, err := ts.PatchTodo(context.Background(), 1, &patch)
+		assert.NoError(t, err)
+		assert.Equal(t, "urgent", resp.Priority)
+	})
+	t.Run("잘못된 priority", func(t *testing.T) {
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{Title: "장보기", Priority: "critical"}, user.ID)
+		assert.Equal(t, apperror.ErrInvalidPriority, err)
+	})
+}
+
+func TestUpdateTodo(t *testing.T) {
+	user := &ent.User{
+		ID:       1,
+		Password: "password",
+		Name:     "조호원",
+	}
+	deadline := time.Now().Add(24 * 3 * time.Hour)
+	newTodo := func() *ent.Todo {
+		return &ent.Todo{
+			ID:          1,
+			Title:       "Go 언어 공부하기",
+			Content:     "장재휴의 Go 웹 프로그래밍 철저 입문",
+			Deadline:    &deadline,
+			IsCompleted: true,
+			Edges: ent.TodoEdges{
+				User: user,
+			},
+		}
+	}
+	t.Run("Todo 전체 수정 성공", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(), nil)
+		tr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.UpdateTodo(context.Background(), 1, &dto.UpdateTodoRequest{
+			Title:   "Rust 공부하기",
+			Content: "The Rust Programming Language",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, dto.TodoToDTO(&ent.Todo{
+			ID:       1,
+			Title:    "Rust 공부하기",
+			Content:  "The Rust Programming Language",
+			Priority: "none",
+			Timezone: "UTC",
+		}), resp)
+	})
+	t.Run("다른 사용자의 Todo", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTodoNotFound)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.UpdateTodo(context.Background(), 1, &dto.UpdateTodoRequest{Title: "Rust 공부하기"})
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+		tr.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPatchTodo(t *testing.T) {
+	user := &ent.User{
+		ID:       1,
+		Password: "password",
+		Name:     "조호원",
+	}
+	deadline := time.Now().Add(24 * 3 * time.Hour)
+	newTodo := func() *ent.Todo {
+		return &ent.Todo{
+			ID:          1,
+			Title:       "Go 언어 공부하기",
+			Content:     "장재휴의 Go 웹 프로그래밍 철저 입문",
+			Deadline:    &deadline,
+			IsCompleted: true,
+			Edges: ent.TodoEdges{
+				User: user,
+			},
+		}
+	}
+	patch := func(body string) *dto.PatchTodoRequest {
+		request := &dto.PatchTodoRequest{}
+		assert.NoError(t, json.Unmarshal([]byte(body), request))
+		return request
+	}
+	t.Run("보낸 필드만 수정", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(), nil)
+		tr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.PatchTodo(context.Background(), 1, patch(`{"title": "Rust 공부하기"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "Rust 공부하기", resp.Title)
+		assert.Equal(t, "장재휴의 Go 웹 프로그래밍 철저 입문", resp.Content)
+		assert.Equal(t, &deadline, resp.Deadline)
+		assert.True(t, resp.IsCompleted)
+	})
+	t.Run("null 로 마감일 삭제 및 미완료로 되돌리기", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(), nil)
+		tr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.PatchTodo(context.Background(), 1, patch(`{"deadline": null, "is_completed": false}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "Go 언어 공부하기", resp.Title)
+		assert.Nil(t, resp.Deadline)
+		assert.False(t, resp.IsCompleted)
+	})
+	t.Run("project_id 를 null 로 보내 Inbox 로 이동", func(t *testing.T) {
+		todo := newTodo()
+		todo.Edges.Project = &ent.Project{ID: 3}
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(todo, nil)
+		tr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.PatchTodo(context.Background(), 1, patch(`{"project_id": null}`))
+		assert.NoError(t, err)
+		assert.Nil(t, resp.ProjectID)
+	})
+	t.Run("비울 수 없는 필드에 null", func(t *testing.T) {
+		request := &dto.PatchTodoRequest{}
+		assert.Error(t, json.Unmarshal([]byte(`{"title": null}`), request))
+	})
+	t.Run("다른 사용자의 Todo", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTodoNotFound)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.PatchTodo(context.Background(), 1, patch(`{"title": "Rust 공부하기"}`))
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+		tr.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
 }
 
 func TestCompleteTodo(t *testing.T) {
 	tr := new(mocks.TodoRepository)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
 		Password: "password",
 		Name:     "조호원",
 	}
@@ -135,34 +512,197 @@ func TestCompleteTodo(t *testing.T) {
 		},
 	}
 	t.Run("Todo 생성 성공", func(t *testing.T) {
-		tr.On("Get", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		tr.On("Complete", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		ts := NewTodoService(tr)
+		tr.On("Get", mock.Anything, mock.AnythingOfType("int64")).Return(expectedResponse, nil)
+		tr.On("Complete", mock.Anything, mock.AnythingOfType("int64"), mock.Anything).Return(expectedResponse, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
 
 		todoID := int64(1)
-		email := "hwc9169@gmail.com"
-		resp, err := ts.CompleteTodo(todoID, email)
+		resp, err := ts.CompleteTodo(context.Background(), todoID)
 		assert.NoError(t, err)
 
 		expected := dto.TodoToDTO(expectedResponse)
 		assert.Equal(t, expected, resp)
 	})
-	t.Run("권한 오류 발생", func(t *testing.T) {
-		tr.On("Get", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		tr.On("Complete", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		ts := NewTodoService(tr)
+	t.Run("다른 사용자의 Todo", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTodoNotFound)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
 
-		todoID := int64(1)
-		email := "hwc9169@naver.com"
-		_, err := ts.CompleteTodo(todoID, email)
-		assert.Equal(t, err, echo.NewHTTPError(http.StatusForbidden, "해당 요청에 대한 권한이 없습니다."))
+		_, err := ts.CompleteTodo(context.Background(), 1)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+		tr.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRecurringTodo(t *testing.T) {
+	user := &ent.User{ID: 1}
+	deadline := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	recurring := func() *ent.Todo {
+		return &ent.Todo{
+			ID:         1,
+			Title:      "분리수거",
+			Deadline:   &deadline,
+			Recurrence: "FREQ=WEEKLY;BYDAY=MO",
+			Occurrence: 1,
+			Edges:      ent.TodoEdges{User: user},
+		}
+	}
+	nextAt := func(want time.Time) interface{} {
+		return mock.MatchedBy(func(next *time.Time) bool {
+			return next != nil && next.Equal(want)
+		})
+	}
+	noNext := mock.MatchedBy(func(next *time.Time) bool {
+		return next == nil
+	})
+
+	t.Run("반복 규칙 정규화", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Create", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{Title: "분리수거", Deadline: &deadline, Recurrence: "RRULE:freq=weekly;byday=mo"}, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO", resp.Recurrence)
+	})
+	t.Run("잘못된 반복 규칙", func(t *testing.T) {
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{Title: "분리수거", Deadline: &deadline, Recurrence: "FREQ=HOURLY"}, user.ID)
+		assert.Equal(t, apperror.ErrInvalidRecurrence, err)
+	})
+	t.Run("마감일 없는 반복 Todo", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(recurring(), nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		var patch dto.PatchTodoRequest
+		assert.NoError(t, json.Unmarshal([]byte(`{"deadline": null}`), &patch))
+		_, err := ts.PatchTodo(context.Background(), 1, &patch)
+		assert.Equal(t, apperror.ErrRecurrenceNeedsDeadline, err)
+	})
+	t.Run("완료하면 다음 회차 생성", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		completed := recurring()
+		completed.IsCompleted = true
+		tr.On("Get", mock.Anything, int64(1)).Return(recurring(), nil)
+		tr.On("Complete", mock.Anything, int64(1), nextAt(deadline.AddDate(0, 0, 7))).Return(completed, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.CompleteTodo(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.True(t, resp.IsCompleted)
+		tr.AssertExpectations(t)
+	})
+	t.Run("Todo 의 시간대 기준으로 다음 회차 계산", func(t *testing.T) {
+		// 서울 기준 매주 월요일 오전 8 시는 DB 에서 읽으면 UTC 일요일 23 시입니다.
+		sunday := time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)
+		tr := new(mocks.TodoRepository)
+		todo := recurring()
+		todo.Deadline = &sunday
+		todo.Timezone = "Asia/Seoul"
+		tr.On("Get", mock.Anything, int64(1)).Return(todo, nil)
+		tr.On("Complete", mock.Anything, int64(1), nextAt(time.Date(2026, 10, 25, 23, 0, 0, 0, time.UTC))).Return(todo, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.CompleteTodo(context.Background(), 1)
+		assert.NoError(t, err)
+		tr.AssertExpectations(t)
+	})
+	t.Run("시간대 기본값은 UTC", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Create", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{Title: "분리수거", Deadline: &deadline, Recurrence: "FREQ=WEEKLY"}, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "UTC", resp.Timezone)
+	})
+	t.Run("잘못된 시간대", func(t *testing.T) {
+		ts := NewTodoService(new(mocks.TodoRepository), new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		for _, timezone := range []string{"Mars/Olympus", "Local"} {
+			_, err := ts.CreateTodo(context.Background(), &dto.CreateTodoRequest{Title: "분리수거", Deadline: &deadline, Recurrence: "FREQ=WEEKLY", Timezone: timezone}, user.ID)
+			assert.Equal(t, apperror.ErrInvalidTimezone, err)
+		}
+	})
+	t.Run("PATCH 로 완료해도 다음 회차 생성", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		completed := recurring()
+		completed.IsCompleted = true
+		tr.On("Get", mock.Anything, int64(1)).Return(recurring(), nil)
+		tr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(func(_ context.Context, todo *ent.Todo) *ent.Todo {
+			return todo
+		}, nil)
+		tr.On("Complete", mock.Anything, int64(1), nextAt(deadline.AddDate(0, 0, 7))).Return(completed, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		var patch dto.PatchTodoRequest
+		assert.NoError(t, json.Unmarshal([]byte(`{"is_completed": true}`), &patch))
+		resp, err := ts.PatchTodo(context.Background(), 1, &patch)
+		assert.NoError(t, err)
+		assert.True(t, resp.IsCompleted)
+		tr.AssertCalled(t, "Update", mock.Anything, mock.MatchedBy(func(todo *ent.Todo) bool {
+			return !todo.IsCompleted
+		}))
+		tr.AssertExpectations(t)
+	})
+	t.Run("이미 완료된 회차는 다시 만들지 않음", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		completed := recurring()
+		completed.IsCompleted = true
+		tr.On("Get", mock.Anything, int64(1)).Return(completed, nil)
+		tr.On("Complete", mock.Anything, int64(1), noNext).Return(completed, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.CompleteTodo(context.Background(), 1)
+		assert.NoError(t, err)
+		tr.AssertExpectations(t)
+	})
+	t.Run("COUNT 에 도달하면 반복 종료", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		last := recurring()
+		last.Recurrence = "FREQ=WEEKLY;COUNT=3"
+		last.Occurrence = 3
+		tr.On("Get", mock.Anything, int64(1)).Return(last, nil)
+		tr.On("Complete", mock.Anything, int64(1), noNext).Return(last, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.CompleteTodo(context.Background(), 1)
+		assert.NoError(t, err)
+		tr.AssertExpectations(t)
+	})
+	t.Run("회차 기록 조회", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		second := &ent.Todo{ID: 2, Occurrence: 2, Edges: ent.TodoEdges{User: user, Origin: &ent.Todo{ID: 1}}}
+		tr.On("Get", mock.Anything, int64(2)).Return(second, nil)
+		tr.On("GetOccurrences", mock.Anything, int64(2)).Return([]*ent.Todo{recurring(), second}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.GetTodoHistory(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Len(t, resp, 2)
+		assert.Equal(t, int64(1), *resp[1].OriginID)
+	})
+	t.Run("다른 사용자의 회차 기록", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTodoNotFound)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.GetTodoHistory(context.Background(), 1)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+		tr.AssertNotCalled(t, "GetOccurrences", mock.Anything, mock.Anything)
 	})
 }
 
 func TestDeleteTodo(t *testing.T) {
 	tr := new(mocks.TodoRepository)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
 		Password: "password",
 		Name:     "조호원",
 	}
@@ -178,27 +718,265 @@ func TestDeleteTodo(t *testing.T) {
 		},
 	}
 	t.Run("Todo 삭제 성공", func(t *testing.T) {
-		tr.On("Get", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		tr.On("Delete", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		ts := NewTodoService(tr)
+		tr.On("Get", mock.Anything, mock.AnythingOfType("int64")).Return(expectedResponse, nil)
+		tr.On("Delete", mock.Anything, mock.AnythingOfType("int64")).Return(expectedResponse, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
 
 		todoID := int64(1)
-		email := "hwc9169@gmail.com"
-		resp, err := ts.DeleteTodo(todoID, email)
+		resp, err := ts.DeleteTodo(context.Background(), todoID)
 		assert.NoError(t, err)
 
 		expected := dto.TodoToDTO(expectedResponse)
 		assert.Equal(t, expected, resp)
 	})
-	t.Run("권한 오류 발생", func(t *testing.T) {
-		tr.On("Get", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		tr.On("Delete", mock.AnythingOfType("int64")).Return(expectedResponse, nil)
-		ts := NewTodoService(tr)
+	t.Run("다른 사용자의 Todo", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTodoNotFound)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
 
-		todoID := int64(1)
-		email := "hwc9169@naver.com"
-		_, err := ts.DeleteTodo(todoID, email)
-		assert.Equal(t, err, echo.NewHTTPError(http.StatusForbidden, "해당 요청에 대한 권한이 없습니다."))
+		_, err := ts.DeleteTodo(context.Background(), 1)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+		tr.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTrash(t *testing.T) {
+	user := &ent.User{ID: 1}
+	deletedAt := time.Now()
+	trashed := &ent.Todo{ID: 1, Title: "분리수거", DeletedAt: &deletedAt, Edges: ent.TodoEdges{User: user}}
+	restored := &ent.Todo{ID: 1, Title: "분리수거", Edges: ent.TodoEdges{User: user}}
 
+	t.Run("휴지통 조회 성공", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("GetTrashByUserID", mock.Anything, user.ID).Return([]*ent.Todo{trashed}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.GetTrash(context.Background(), user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []*dto.TodoResponse{dto.TodoToDTO(trashed)}, resp)
+	})
+	t.Run("복원 성공", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("GetTrashed", mock.Anything, int64(1)).Return(trashed, nil)
+		tr.On("Restore", mock.Anything, int64(1)).Return(restored, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.RestoreTodo(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Nil(t, resp.DeletedAt)
+	})
+	t.Run("다른 사용자의 Todo 는 복원 불가", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("GetTrashed", mock.Anything, int64(1)).Return(nil, apperror.ErrTrashedTodoNotFound)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.RestoreTodo(context.Background(), 1)
+		assert.Equal(t, apperror.ErrTrashedTodoNotFound, err)
+		tr.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAddTags(t *testing.T) {
+	user := &ent.User{ID: 1}
+	todo := &ent.Todo{ID: 1, Title: "보고서 작성", Edges: ent.TodoEdges{User: user}}
+	tagged := &ent.Todo{ID: 1, Title: "보고서 작성", Edges: ent.TodoEdges{
+		User: user,
+		Tags: []*ent.Tag{{ID: 1, Name: "업무"}},
+	}}
+
+	t.Run("태그 연결 성공", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tgr := new(mocks.TagRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(todo, nil)
+		tgr.On("Get", mock.Anything, int64(1)).Return(&ent.Tag{ID: 1, Name: "업무", Edges: ent.TagEdges{User: user}}, nil)
+		tr.On("AddTags", mock.Anything, int64(1), int64(1)).Return(tagged, nil)
+		ts := NewTodoService(tr, tgr, new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.AddTags(context.Background(), 1, &dto.TodoTagsRequest{TagIDs: []int64{1}})
+		assert.NoError(t, err)
+		assert.Equal(t, []*dto.TagResponse{{ID: 1, Name: "업무"}}, resp.Tags)
+	})
+	t.Run("다른 사용자의 태그", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tgr := new(mocks.TagRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(todo, nil)
+		tgr.On("Get", mock.Anything, int64(2)).Return(nil, apperror.ErrTagNotFound)
+		ts := NewTodoService(tr, tgr, new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.AddTags(context.Background(), 1, &dto.TodoTagsRequest{TagIDs: []int64{2}})
+		assert.Equal(t, apperror.ErrTagNotFound, err)
+		tr.AssertNotCalled(t, "AddTags", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRemoveTag(t *testing.T) {
+	user := &ent.User{ID: 1}
+	todo := &ent.Todo{ID: 1, Title: "보고서 작성", Edges: ent.TodoEdges{User: user}}
+
+	t.Run("태그 연결 해제 성공", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(todo, nil)
+		tr.On("RemoveTags", mock.Anything, int64(1), int64(1)).Return(todo, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.RemoveTag(context.Background(), 1, 1)
+		assert.NoError(t, err)
+		assert.Empty(t, resp.Tags)
+	})
+	t.Run("다른 사용자의 Todo", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(nil, apperror.ErrTodoNotFound)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.RemoveTag(context.Background(), 1, 1)
+		assert.Equal(t, apperror.ErrTodoNotFound, err)
+		tr.AssertNotCalled(t, "RemoveTags", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestChecklist(t *testing.T) {
+	user := &ent.User{ID: 1}
+	newTodo := func(autoComplete bool, checked ...bool) *ent.Todo {
+		todo := &ent.Todo{ID: 1, Title: "이사 준비", AutoComplete: autoComplete, Edges: ent.TodoEdges{User: user}}
+		for i, c := range checked {
+			todo.Edges.ChecklistItems = append(todo.Edges.ChecklistItems, &ent.ChecklistItem{ID: int64(i + 1), Title: "항목", IsChecked: c, Position: i})
+		}
+		return todo
+	}
+	item := &ent.ChecklistItem{ID: 2, Title: "청소", Edges: ent.ChecklistItemEdges{Todo: &ent.Todo{ID: 1}}}
+
+	t.Run("항목 추가 후 진행률 반환", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		cr := new(mocks.ChecklistItemRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(false), nil).Once()
+		cr.On("Create", mock.Anything, mock.AnythingOfType("*ent.ChecklistItem")).Return(item, nil)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(false, true, false), nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), cr, new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.AddChecklistItem(context.Background(), 1, &dto.CreateChecklistItemRequest{Title: "청소"})
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.ProgressResponse{Done: 1, Total: 2}, resp.Progress)
+		assert.Len(t, resp.Checklist, 2)
+	})
+	t.Run("모든 항목을 체크하면 자동 완료", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		cr := new(mocks.ChecklistItemRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(true, true, false), nil).Once()
+		cr.On("Get", mock.Anything, int64(2)).Return(item, nil)
+		cr.On("Update", mock.Anything, mock.AnythingOfType("*ent.ChecklistItem")).Return(item, nil)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(true, true, true), nil)
+		completed := newTodo(true, true, true)
+		completed.IsCompleted = true
+		tr.On("Complete", mock.Anything, int64(1), mock.Anything).Return(completed, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), cr, new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.UpdateChecklistItem(context.Background(), 1, 2, &dto.UpdateChecklistItemRequest{Title: "청소", IsChecked: true})
+		assert.NoError(t, err)
+		assert.True(t, resp.IsCompleted)
+		assert.Equal(t, &dto.ProgressResponse{Done: 2, Total: 2}, resp.Progress)
+	})
+	t.Run("auto_complete 가 꺼져 있으면 완료하지 않음", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		cr := new(mocks.ChecklistItemRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(false, true, true), nil)
+		cr.On("Get", mock.Anything, int64(2)).Return(item, nil)
+		cr.On("Update", mock.Anything, mock.AnythingOfType("*ent.ChecklistItem")).Return(item, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), cr, new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.UpdateChecklistItem(context.Background(), 1, 2, &dto.UpdateChecklistItemRequest{Title: "청소", IsChecked: true})
+		assert.NoError(t, err)
+		assert.False(t, resp.IsCompleted)
+		tr.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("다른 Todo 의 항목", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		cr := new(mocks.ChecklistItemRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(false), nil)
+		cr.On("Get", mock.Anything, int64(9)).Return(&ent.ChecklistItem{ID: 9, Edges: ent.ChecklistItemEdges{Todo: &ent.Todo{ID: 7}}}, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), cr, new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.DeleteChecklistItem(context.Background(), 1, 9)
+		assert.Equal(t, apperror.ErrChecklistItemNotFound, err)
+		cr.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+	t.Run("순서 변경", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		cr := new(mocks.ChecklistItemRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(false, false, false), nil)
+		cr.On("Reorder", mock.Anything, int64(1), []int64{2, 1}).Return(nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), cr, new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.ReorderChecklist(context.Background(), 1, &dto.ReorderChecklistRequest{ItemIDs: []int64{2, 1}})
+		assert.NoError(t, err)
+		cr.AssertCalled(t, "Reorder", mock.Anything, int64(1), []int64{2, 1})
+	})
+	t.Run("일부 항목만 보낸 순서 변경", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(false, false, false), nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.ReorderChecklist(context.Background(), 1, &dto.ReorderChecklistRequest{ItemIDs: []int64{2, 2}})
+		assert.Equal(t, apperror.ErrInvalidChecklistOrder, err)
+	})
+}
+
+func TestReminders(t *testing.T) {
+	user := &ent.User{ID: 1}
+	deadline := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	newTodo := func(reminders ...*ent.Reminder) *ent.Todo {
+		return &ent.Todo{ID: 1, Title: "보고서 제출", Deadline: &deadline, Edges: ent.TodoEdges{User: user, Reminders: reminders}}
+	}
+	remindAt := deadline.Add(-time.Hour)
+	reminder := &ent.Reminder{ID: 3, OffsetMinutes: 60, RemindAt: &remindAt, Edges: ent.ReminderEdges{Todo: &ent.Todo{ID: 1}}}
+
+	t.Run("알림 추가", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		rr := new(mocks.ReminderRepository)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(), nil).Once()
+		rr.On("Create", mock.Anything, mock.AnythingOfType("*ent.Reminder")).Return(reminder, nil)
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(reminder), nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), rr, new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		resp, err := ts.AddReminder(context.Background(), 1, &dto.CreateReminderRequest{OffsetMinutes: 60})
+		assert.NoError(t, err)
+		assert.Equal(t, []*dto.ReminderResponse{{ID: 3, OffsetMinutes: 60, RemindAt: &remindAt}}, resp.Reminders)
+	})
+	t.Run("마감일 없는 Todo 에 알림 추가", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		todo := newTodo()
+		todo.Deadline = nil
+		tr.On("Get", mock.Anything, int64(1)).Return(todo, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), new(mocks.ReminderRepository), new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.AddReminder(context.Background(), 1, &dto.CreateReminderRequest{OffsetMinutes: 60})
+		assert.Equal(t, apperror.ErrReminderNeedsDeadline, err)
+	})
+	t.Run("다른 Todo 의 알림 삭제", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		rr := new(mocks.ReminderRepository)
+		tr.On("Get", mock.Anything, int64(2)).Return(&ent.Todo{ID: 2, Edges: ent.TodoEdges{User: user}}, nil)
+		rr.On("Get", mock.Anything, int64(3)).Return(reminder, nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), rr, new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		_, err := ts.DeleteReminder(context.Background(), 2, 3)
+		assert.Equal(t, apperror.ErrReminderNotFound, err)
+		rr.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+	t.Run("마감일을 바꾸면 알림 시각 재계산", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		rr := new(mocks.ReminderRepository)
+		later := deadline.Add(24 * time.Hour)
+		moved := newTodo(reminder)
+		moved.Deadline = &later
+		tr.On("Get", mock.Anything, int64(1)).Return(newTodo(reminder), nil)
+		tr.On("Update", mock.Anything, mock.AnythingOfType("*ent.Todo")).Return(moved, nil)
+		rr.On("Reschedule", mock.Anything, int64(1), &later).Return(nil)
+		ts := NewTodoService(tr, new(mocks.TagRepository), new(mocks.ProjectRepository), new(mocks.ChecklistItemRepository), rr, new(mocks.TodoIndex), DefaultTodoScoreWeights())
+
+		var patch dto.PatchTodoRequest
+		assert.NoError(t, json.Unmarshal([]byte(`{"deadline": "2026-10-20T09:00:00Z"}`), &patch))
+		_, err := ts.PatchTodo(context.Background(), 1, &patch)
+		assert.NoError(t, err)
+		rr.AssertCalled(t, "Reschedule", mock.Anything, int64(1), &later)
 	})
 }
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"halill/repository"
+	"halill/viewer"
+	"log"
+	"time"
+)
+
+type TrashPurgerConfig struct {
+	// Retention 은 휴지통의 Todo 를 완전히 삭제하기 전까지 보관하는 기간입니다.
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+type TrashPurger interface {
+	Run(context.Context)
+	RunOnce(context.Context, time.Time) (int, error)
+}
+
+type trashPurgerImpl struct {
+	tr     repository.TodoRepository
+	config TrashPurgerConfig
+}
+
+func NewTrashPurger(tr repository.TodoRepository, config TrashPurgerConfig) TrashPurger {
+	if config.Retention <= 0 {
+		config.Retention = 30 * 24 * time.Hour
+	}
+	if config.Interval <= 0 {
+		config.Interval = time.Hour
+	}
+	return &trashPurgerImpl{
+		tr:     tr,
+		config: config,
+	}
+}
+
+// Run 은 ctx 가 끝날 때까지 Interval 마다 보관 기간이 지난 휴지통의 Todo 를 삭제합니다.
+// 모든 사용자의 휴지통을 비우므로 system viewer 로 삭제합니다.
+func (p *trashPurgerImpl) Run(ctx context.Context) {
+	ctx = viewer.NewSystemContext(ctx)
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.RunOnce(ctx, time.Now()); err != nil {
+			log.Printf("trash purger: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce 는 now 기준으로 Retention 보다 오래 휴지통에 있던 Todo 를 삭제하고 삭제한 개수를 반환합니다.
+// 여러 인스턴스가 동시에 실행해도 같은 행을 지울 뿐이므로 따로 잡지 않습니다.
+func (p *trashPurgerImpl) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	return p.tr.Purge(ctx, now.Add(-p.config.Retention))
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"halill/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTrashPurgerRunOnce(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	t.Run("보존 기간이 지난 Todo 삭제", func(t *testing.T) {
+		tr := new(mocks.TodoRepository)
+		tr.On("Purge", mock.Anything, now.Add(-30*24*time.Hour)).Return(2, nil)
+		p := NewTrashPurger(tr, TrashPurgerConfig{Retention: 30 * 24 * time.Hour})
+
+		purged, err := p.RunOnce(context.Background(), now)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+	})
+}
@@ -1,41 +1,118 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"halill/apperror"
 	"halill/dto"
 	"halill/ent"
+	"halill/i18n"
+	"halill/notify"
 	"halill/repository"
 	"halill/security"
-	"net/http"
+	"halill/viewer"
+	"log"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/golang-jwt/jwt"
-	"github.com/labstack/echo/v4"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type UserService interface {
-	LoginUser(*dto.LoginRequest) (*dto.TokenResponse, error)
-	RegistUser(*dto.RegistRequest) (*dto.UserResponse, error)
-	RefreshToken(*dto.RefreshTokenRequest) (*dto.TokenResponse, error)
+	LoginUser(context.Context, *dto.LoginRequest) (*dto.TokenResponse, error)
+	RegistUser(context.Context, *dto.RegistRequest) (*dto.UserResponse, error)
+	RefreshToken(context.Context, *dto.RefreshTokenRequest) (*dto.TokenResponse, error)
+	Logout(context.Context, *security.JwtCustomClaims, *dto.LogoutRequest) error
+	LogoutAll(context.Context, *security.JwtCustomClaims) error
+	VerifyAccessToken(context.Context, *security.JwtCustomClaims) (*dto.UserResponse, error)
+	UpdateLocale(context.Context, *dto.UpdateLocaleRequest, int64) (*dto.UserResponse, error)
+	ChangePassword(context.Context, *dto.ChangePasswordRequest, int64) (*dto.TokenResponse, error)
+	ForgotPassword(context.Context, *dto.ForgotPasswordRequest) error
+	ResetPassword(context.Context, *dto.ResetPasswordRequest) error
+	VerifyEmail(context.Context, *dto.VerifyEmailRequest) (*dto.UserResponse, error)
+	ResendVerification(context.Context, *dto.ResendVerificationRequest) error
+	ChangeEmail(context.Context, *dto.ChangeEmailRequest, int64) error
+	ConfirmEmailChange(context.Context, *dto.ConfirmEmailChangeRequest) (*dto.UserResponse, error)
+}
+
+// 서명 토큰의 용도입니다. 한 용도로 발급한 토큰을 다른 흐름에서 쓸 수 없게 구분합니다.
+const (
+	emailVerificationPurpose = "email_verification"
+	emailChangePurpose       = "email_change"
+)
+
+// PasswordResetConfig 는 비밀번호 재설정 메일의 설정입니다.
+type PasswordResetConfig struct {
+	// TTL 은 재설정 토큰의 유효 기간입니다.
+	TTL time.Duration
+	// URL 은 메일에 넣을 재설정 링크입니다. {token} 을 발급한 토큰으로 바꿉니다.
+	URL string
+}
+
+// EmailVerificationConfig 는 가입 인증 메일과 인증하지 않은 사용자의 제한에 대한 설정입니다.
+type EmailVerificationConfig struct {
+	// TTL 은 인증 링크의 유효 기간입니다.
+	TTL time.Duration
+	// URL 은 메일에 넣을 인증 링크입니다. {token} 을 서명한 토큰으로 바꿉니다.
+	URL string
+	// ChangeURL 은 이메일을 바꿀 때 새 주소로 보내는 확인 링크입니다. 유효 기간은 TTL 을 따릅니다.
+	ChangeURL string
+	// ResendCooldown 은 인증 메일을 다시 보내기까지 기다려야 하는 시간입니다.
+	ResendCooldown time.Duration
+	// MaxSends 는 ResendWindow 안에 보낼 수 있는 인증 메일의 수입니다.
+	MaxSends int
+	// ResendWindow 동안 인증 메일을 보내지 않으면 보낸 횟수를 다시 0 부터 셉니다.
+	ResendWindow time.Duration
+	// RequiredForLogin 이면 인증하지 않은 사용자는 로그인할 수 없습니다.
+	RequiredForLogin bool
 }
 
 type userServiceImpl struct {
-	ur repository.UserRepository
-	jp security.JWTProvider
+	ur          repository.UserRepository
+	rtr         repository.RefreshTokenRepository
+	rvr         repository.RevokedTokenRepository
+	jp          security.JWTProvider
+	pp          security.PasswordPolicy
+	prr         repository.PasswordResetTokenRepository
+	mailer      notify.Mailer
+	resetConfig PasswordResetConfig
+	signer      security.TokenSigner
+	evConfig    EmailVerificationConfig
 }
 
-func NewUserSerice(ur repository.UserRepository, jp security.JWTProvider) UserService {
+func NewUserService(ur repository.UserRepository, rtr repository.RefreshTokenRepository, rvr repository.RevokedTokenRepository, jp security.JWTProvider, pp security.PasswordPolicy, prr repository.PasswordResetTokenRepository, mailer notify.Mailer, resetConfig PasswordResetConfig, signer security.TokenSigner, evConfig EmailVerificationConfig) UserService {
 	return &userServiceImpl{
-		ur: ur,
-		jp: jp,
+		ur:          ur,
+		rtr:         rtr,
+		rvr:         rvr,
+		jp:          jp,
+		pp:          pp,
+		prr:         prr,
+		mailer:      mailer,
+		resetConfig: resetConfig,
+		signer:      signer,
+		evConfig:    evConfig,
 	}
 }
 
-func (s *userServiceImpl) LoginUser(r *dto.LoginRequest) (*dto.TokenResponse, error) {
-	user, err := s.verifyUser(r)
+func (s *userServiceImpl) LoginUser(ctx context.Context, r *dto.LoginRequest) (*dto.TokenResponse, error) {
+	// 아직 누구인지 확인하기 전이므로 system viewer 로 사용자를 찾음
+	ctx = viewer.NewSystemContext(ctx)
+	user, err := s.verifyUser(ctx, r)
 	if err != nil {
 		return nil, err
 	}
+	if s.evConfig.RequiredForLogin && user.EmailVerifiedAt == nil {
+		return nil, apperror.ErrEmailNotVerified
+	}
 
+	// 로그인할 때마다 새로운 토큰 family 를 시작
+	return s.issueTokens(ctx, user, uuid.NewString())
+}
+
+func (s *userServiceImpl) issueTokens(ctx context.Context, user *ent.User, familyID string) (*dto.TokenResponse, error) {
 	at, err := s.jp.GenerateAccessToken(user)
 	if err != nil {
 		return nil, err
@@ -46,36 +123,63 @@ func (s *userServiceImpl) LoginUser(r *dto.LoginRequest) (*dto.TokenResponse, er
 		return nil, err
 	}
 
+	_, err = s.rtr.Create(ctx, &ent.RefreshToken{
+		FamilyID:  familyID,
+		TokenHash: security.HashToken(rt),
+		ExpiresAt: time.Now().Add(security.RefreshTokenExpiry),
+		Edges: ent.RefreshTokenEdges{
+			User: user,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &dto.TokenResponse{
 		AccessToken:  at,
 		RefreshToken: rt,
 	}, nil
 }
 
-func (s *userServiceImpl) verifyUser(r *dto.LoginRequest) (*ent.User, error) {
-	user, err := s.ur.GetByEmail(r.Email)
+func (s *userServiceImpl) verifyUser(ctx context.Context, r *dto.LoginRequest) (*ent.User, error) {
+	// 없는 이메일과 틀린 비밀번호를 구분하지 않아 가입 여부가 드러나지 않도록 함
+	user, err := s.ur.GetByEmail(ctx, r.Email)
 	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, apperror.ErrInvalidCredentials
+		}
 		return nil, err
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(r.Password))
 	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return nil, apperror.ErrInvalidCredentials
+		}
 		return nil, err
 	}
 
 	return user, nil
 }
 
-func (s *userServiceImpl) RegistUser(r *dto.RegistRequest) (*dto.UserResponse, error) {
-	_, err := s.ur.GetByEmail(r.Email)
+func (s *userServiceImpl) RegistUser(ctx context.Context, r *dto.RegistRequest) (*dto.UserResponse, error) {
+	locale, err := parseLocale(r.Locale)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.pp.Validate("password", r.Password, []string{r.Email, r.Name}); err != nil {
+		return nil, err
+	}
+
+	ctx = viewer.NewSystemContext(ctx)
+	_, err = s.ur.GetByEmail(ctx, r.Email)
 
 	// 이미 사용중인 이메일이면 실패
 	if err == nil {
-		err = echo.NewHTTPError(http.StatusBadRequest, "이미 사용중인 이메일입니다.")
-		return nil, err
+		return nil, apperror.ErrEmailTaken
 	}
 	// Notfound 에러가 아니면 실패
-	if _, ok := err.(*echo.HTTPError); !ok {
+	if !errors.Is(err, apperror.ErrUserNotFound) {
 		return nil, err
 	}
 
@@ -85,39 +189,461 @@ func (s *userServiceImpl) RegistUser(r *dto.RegistRequest) (*dto.UserResponse, e
 	}
 
 	user := &ent.User{
-		ID:       r.Email,
+		Email:    r.Email,
 		Password: string(hashedPassword),
 		Name:     r.Name,
+		Locale:   locale,
 	}
-	newUser, err := s.ur.CreateUser(user)
+	newUser, err := s.ur.CreateUser(ctx, user)
 	if err != nil {
 		return nil, err
 	}
+	// 메일을 보내지 못해도 가입은 유지하고, 사용자가 인증 메일을 다시 요청하도록 함
+	if err := s.sendVerification(ctx, newUser, time.Now()); err != nil {
+		log.Printf("regist user: verification mail to %s failed: %v", newUser.Email, err)
+	}
 
 	return dto.UserToDTO(newUser), nil
 }
 
-func (s *userServiceImpl) RefreshToken(r *dto.RefreshTokenRequest) (*dto.TokenResponse, error) {
-	claims := &security.JwtCustomClaims{}
-	_, err := jwt.ParseWithClaims(r.RefreshToken, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.jp.JwtSecret()), nil
+func (s *userServiceImpl) RefreshToken(ctx context.Context, r *dto.RefreshTokenRequest) (*dto.TokenResponse, error) {
+	token, err := s.jp.ParseToken(r.RefreshToken)
+	if err != nil {
+		return nil, apperror.ErrInvalidToken
+	}
+	claims := token.Claims.(*security.JwtCustomClaims)
+	// access token 으로는 토큰을 재발급할 수 없음
+	if claims.TokenType != security.RefreshTokenType {
+		return nil, apperror.ErrInvalidToken
+	}
+	ctx = viewer.NewContext(ctx, &viewer.Viewer{UserID: claims.UserID})
+
+	stored, err := s.rtr.GetByHash(ctx, security.HashToken(r.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	// 이미 교체된 토큰이 다시 사용되면 탈취된 것으로 보고 family 전체를 폐기
+	if stored.Revoked {
+		if err := s.rtr.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, apperror.ErrReusedToken
+	}
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, apperror.ErrExpiredToken
+	}
+
+	revoked, err := s.rtr.Revoke(ctx, stored.ID)
+	if err != nil {
+		return nil, err
+	}
+	// 동시에 들어온 다른 요청이 먼저 교체한 경우도 재사용으로 취급
+	if !revoked {
+		if err := s.rtr.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, apperror.ErrReusedToken
+	}
+
+	user, err := s.ur.Get(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, user, stored.FamilyID)
+}
+
+func (s *userServiceImpl) Logout(ctx context.Context, claims *security.JwtCustomClaims, r *dto.LogoutRequest) error {
+	_, err := s.rvr.Create(ctx, &ent.RevokedToken{
+		Jti:       claims.Id,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
 	})
+	if err != nil {
+		return err
+	}
+	if _, err := s.rvr.DeleteExpired(ctx, time.Now()); err != nil {
+		return err
+	}
+
+	if r.RefreshToken == "" {
+		return nil
+	}
+	stored, err := s.rtr.GetByHash(ctx, security.HashToken(r.RefreshToken))
+	if err != nil {
+		// 이미 알 수 없는 refresh token 이면 끊을 세션도 없음
+		if errors.Is(err, apperror.ErrInvalidToken) {
+			return nil
+		}
+		return err
+	}
+	if stored.Edges.User == nil || stored.Edges.User.ID != claims.UserID {
+		return apperror.ErrForbidden
+	}
+
+	return s.rtr.RevokeFamily(ctx, stored.FamilyID)
+}
+
+func (s *userServiceImpl) LogoutAll(ctx context.Context, claims *security.JwtCustomClaims) error {
+	_, err := s.ur.IncrementTokenVersion(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+
+	return s.rtr.RevokeAllByUserID(ctx, claims.UserID)
+}
+
+// VerifyAccessToken 은 토큰이 아직 유효한지 확인하고 토큰의 사용자를 반환합니다.
+func (s *userServiceImpl) VerifyAccessToken(ctx context.Context, claims *security.JwtCustomClaims) (*dto.UserResponse, error) {
+	revoked, err := s.rvr.Exists(ctx, claims.Id)
 	if err != nil {
 		return nil, err
 	}
+	if revoked {
+		return nil, apperror.ErrRevokedToken
+	}
 
-	user, err := s.ur.GetByEmail(claims.Email)
+	user, err := s.ur.Get(ctx, claims.UserID)
 	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, apperror.ErrInvalidToken
+		}
 		return nil, err
 	}
+	// 전체 로그아웃이나 비밀번호 변경 이전에 발급된 토큰은 거부
+	if claims.TokenVersion != user.TokenVersion {
+		return nil, apperror.ErrRevokedToken
+	}
 
-	at, err := s.jp.GenerateAccessToken(user)
+	return dto.UserToDTO(user), nil
+}
+
+// UpdateLocale 은 응답 언어 설정을 바꿉니다. 빈 값이면 설정을 지우고 Accept-Language 를 따릅니다.
+func (s *userServiceImpl) UpdateLocale(ctx context.Context, r *dto.UpdateLocaleRequest, userID int64) (*dto.UserResponse, error) {
+	locale, err := parseLocale(r.Locale)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.TokenResponse{
-		AccessToken:  at,
-		RefreshToken: r.RefreshToken,
-	}, nil
+	user, err := s.ur.UpdateLocale(ctx, userID, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.UserToDTO(user), nil
+}
+
+// ChangePassword 는 현재 비밀번호를 확인한 뒤 비밀번호를 바꿉니다.
+// 다른 세션은 모두 끊기므로 요청한 세션이 계속 쓸 새 토큰을 발급합니다.
+func (s *userServiceImpl) ChangePassword(ctx context.Context, r *dto.ChangePasswordRequest, userID int64) (*dto.TokenResponse, error) {
+	user, err := s.ur.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(r.CurrentPassword))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return nil, apperror.ErrValidationFailed.WithFields(apperror.FieldError{Field: "current_password", Rule: "incorrect"})
+		}
+		return nil, err
+	}
+	if err := s.pp.Validate("new_password", r.NewPassword, []string{user.Email, user.Name}); err != nil {
+		return nil, err
+	}
+
+	// 새 토큰에는 올라간 token_version 이 들어가야 하므로 바뀐 사용자로 발급
+	user, err = s.replacePassword(ctx, user, r.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, user, uuid.NewString())
+}
+
+// ForgotPassword 는 재설정 토큰을 메일로 보냅니다.
+// 가입 여부가 드러나지 않도록 없는 이메일이어도 성공으로 응답합니다.
+func (s *userServiceImpl) ForgotPassword(ctx context.Context, r *dto.ForgotPasswordRequest) error {
+	ctx = viewer.NewSystemContext(ctx)
+	user, err := s.ur.GetByEmail(ctx, r.Email)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := security.GenerateToken()
+	if err != nil {
+		return err
+	}
+	_, err = s.prr.Create(ctx, &ent.PasswordResetToken{
+		TokenHash: security.HashToken(token),
+		ExpiresAt: time.Now().Add(s.resetConfig.TTL),
+		Edges: ent.PasswordResetTokenEdges{
+			User: user,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	locale := mailLocale(ctx, user)
+	replacer := strings.NewReplacer(
+		"{name}", user.Name,
+		"{minutes}", strconv.Itoa(int(s.resetConfig.TTL/time.Minute)),
+		"{link}", strings.ReplaceAll(s.resetConfig.URL, "{token}", token),
+	)
+	return s.mailer.Send(&notify.Mail{
+		To:      user.Email,
+		Subject: i18n.Message(locale, "mail.password_reset.subject"),
+		Body:    replacer.Replace(i18n.Message(locale, "mail.password_reset.body")),
+	})
+}
+
+// ResetPassword 는 메일로 받은 토큰으로 비밀번호를 바꿉니다. 토큰은 한 번만 사용할 수 있습니다.
+func (s *userServiceImpl) ResetPassword(ctx context.Context, r *dto.ResetPasswordRequest) error {
+	ctx = viewer.NewSystemContext(ctx)
+	stored, err := s.prr.GetByHash(ctx, security.HashToken(r.Token))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	// 사용자가 없는 토큰은 재설정할 대상이 없으므로 잘못된 토큰으로 취급
+	if stored.UsedAt != nil || !now.Before(stored.ExpiresAt) || stored.Edges.User == nil {
+		return apperror.ErrInvalidResetToken
+	}
+
+	user := stored.Edges.User
+	// 정책을 통과하지 못하면 토큰을 쓰지 않고 남겨 다시 시도할 수 있게 함
+	if err := s.pp.Validate("new_password", r.NewPassword, []string{user.Email, user.Name}); err != nil {
+		return err
+	}
+	used, err := s.prr.Use(ctx, stored.ID, now)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return apperror.ErrInvalidResetToken
+	}
+
+	_, err = s.replacePassword(ctx, user, r.NewPassword)
+	return err
+}
+
+// replacePassword 는 비밀번호를 바꾸고 기존 세션과 남은 재설정 토큰을 모두 무효화한 뒤 바뀐 사용자를 반환합니다.
+func (s *userServiceImpl) replacePassword(ctx context.Context, user *ent.User, password string) (*ent.User, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ur.UpdatePassword(ctx, user.ID, string(hashedPassword)); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.ur.IncrementTokenVersion(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.rtr.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if err := s.prr.InvalidateAllByUserID(ctx, user.ID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// VerifyEmail 은 인증 메일의 링크로 받은 토큰을 확인하고 사용자를 인증된 상태로 바꿉니다.
+// 이미 인증한 사용자가 링크를 다시 열어도 성공으로 응답합니다.
+func (s *userServiceImpl) VerifyEmail(ctx context.Context, r *dto.VerifyEmailRequest) (*dto.UserResponse, error) {
+	ctx = viewer.NewSystemContext(ctx)
+	now := time.Now()
+	user, fields, err := s.verifySignedUser(ctx, emailVerificationPurpose, r.Token, now, 1)
+	if err != nil {
+		return nil, err
+	}
+	// 인증 메일을 보낸 뒤 이메일을 바꿨으면 예전 주소로 보낸 링크로는 인증할 수 없음
+	if user.Email != fields[0] {
+		return nil, apperror.ErrInvalidVerificationToken
+	}
+	if user.EmailVerifiedAt != nil {
+		return dto.UserToDTO(user), nil
+	}
+
+	user, err = s.ur.MarkEmailVerified(ctx, user.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.UserToDTO(user), nil
+}
+
+// ResendVerification 은 인증 메일을 다시 보냅니다.
+// ForgotPassword 처럼 없는 이메일이나 이미 인증한 사용자여도 성공으로 응답합니다.
+func (s *userServiceImpl) ResendVerification(ctx context.Context, r *dto.ResendVerificationRequest) error {
+	ctx = viewer.NewSystemContext(ctx)
+	user, err := s.ur.GetByEmail(ctx, r.Email)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+	if user.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	if sentAt := user.VerificationSentAt; sentAt != nil {
+		elapsed := now.Sub(*sentAt)
+		if elapsed < s.evConfig.ResendCooldown || (elapsed < s.evConfig.ResendWindow && user.VerificationSends >= s.evConfig.MaxSends) {
+			return apperror.ErrVerificationRateLimited
+		}
+	}
+
+	return s.sendVerification(ctx, user, now)
+}
+
+// sendVerification 은 전송 기록을 먼저 남긴 뒤 인증 메일을 보냅니다.
+// 메일 전송이 실패해도 횟수에 포함해 재전송 제한을 우회할 수 없게 합니다.
+func (s *userServiceImpl) sendVerification(ctx context.Context, user *ent.User, now time.Time) error {
+	sends := 1
+	if user.VerificationSentAt != nil && now.Sub(*user.VerificationSentAt) < s.evConfig.ResendWindow {
+		sends = user.VerificationSends + 1
+	}
+	if _, err := s.ur.UpdateVerificationSent(ctx, user.ID, now, sends); err != nil {
+		return err
+	}
+
+	token := s.signer.Sign(emailVerificationPurpose, signedSubject(user.ID, user.Email), now.Add(s.evConfig.TTL))
+	locale := mailLocale(ctx, user)
+	replacer := strings.NewReplacer(
+		"{name}", user.Name,
+		"{hours}", strconv.Itoa(int(s.evConfig.TTL/time.Hour)),
+		"{link}", strings.ReplaceAll(s.evConfig.URL, "{token}", token),
+	)
+	return s.mailer.Send(&notify.Mail{
+		To:      user.Email,
+		Subject: i18n.Message(locale, "mail.email_verification.subject"),
+		Body:    replacer.Replace(i18n.Message(locale, "mail.email_verification.body")),
+	})
+}
+
+// ChangeEmail 은 비밀번호를 확인한 뒤 새 주소로 확인 링크를 보냅니다.
+// 새 주소의 주인이 링크를 열어 ConfirmEmailChange 를 호출해야 이메일이 바뀝니다.
+func (s *userServiceImpl) ChangeEmail(ctx context.Context, r *dto.ChangeEmailRequest, userID int64) error {
+	user, err := s.ur.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(r.Password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return apperror.ErrValidationFailed.WithFields(apperror.FieldError{Field: "password", Rule: "incorrect"})
+		}
+		return err
+	}
+
+	// 다른 사용자의 정보는 viewer 로 조회할 수 없으므로 system viewer 로 확인
+	_, err = s.ur.GetByEmail(viewer.NewSystemContext(ctx), r.NewEmail)
+	if err == nil {
+		return apperror.ErrEmailTaken
+	}
+	if !errors.Is(err, apperror.ErrUserNotFound) {
+		return err
+	}
+
+	now := time.Now()
+	token := s.signer.Sign(emailChangePurpose, signedSubject(user.ID, user.Email, r.NewEmail), now.Add(s.evConfig.TTL))
+	locale := mailLocale(ctx, user)
+	replacer := strings.NewReplacer(
+		"{name}", user.Name,
+		"{email}", r.NewEmail,
+		"{hours}", strconv.Itoa(int(s.evConfig.TTL/time.Hour)),
+		"{link}", strings.ReplaceAll(s.evConfig.ChangeURL, "{token}", token),
+	)
+	return s.mailer.Send(&notify.Mail{
+		To:      r.NewEmail,
+		Subject: i18n.Message(locale, "mail.email_change.subject"),
+		Body:    replacer.Replace(i18n.Message(locale, "mail.email_change.body")),
+	})
+}
+
+// ConfirmEmailChange 는 새 주소로 받은 토큰을 확인하고 이메일을 바꿉니다.
+// 새 주소는 링크로 확인되었으므로 인증된 상태가 되고, 예전 주소로 보낸 재설정 토큰은 무효화합니다.
+func (s *userServiceImpl) ConfirmEmailChange(ctx context.Context, r *dto.ConfirmEmailChangeRequest) (*dto.UserResponse, error) {
+	ctx = viewer.NewSystemContext(ctx)
+	now := time.Now()
+	user, fields, err := s.verifySignedUser(ctx, emailChangePurpose, r.Token, now, 2)
+	if err != nil {
+		return nil, err
+	}
+	// 링크를 보낸 뒤 이메일이 이미 바뀌었으면 남은 링크는 쓸 수 없음
+	if user.Email != fields[0] {
+		return nil, apperror.ErrInvalidVerificationToken
+	}
+
+	user, err = s.ur.UpdateEmail(ctx, user.ID, fields[1], now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.prr.InvalidateAllByUserID(ctx, user.ID, now); err != nil {
+		return nil, err
+	}
+
+	return dto.UserToDTO(user), nil
+}
+
+// verifySignedUser 는 서명 토큰을 확인하고 토큰의 사용자와 사용자 ID 뒤에 서명된 n 개의 값을 반환합니다.
+func (s *userServiceImpl) verifySignedUser(ctx context.Context, purpose string, token string, now time.Time, n int) (*ent.User, []string, error) {
+	subject, err := s.signer.Verify(purpose, token, now)
+	if err != nil {
+		return nil, nil, apperror.ErrInvalidVerificationToken.Wrap(err)
+	}
+	fields := strings.Split(subject, "\n")
+	if len(fields) != n+1 {
+		return nil, nil, apperror.ErrInvalidVerificationToken
+	}
+	userID, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, nil, apperror.ErrInvalidVerificationToken.Wrap(err)
+	}
+
+	user, err := s.ur.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, nil, apperror.ErrInvalidVerificationToken
+		}
+		return nil, nil, err
+	}
+
+	return user, fields[1:], nil
+}
+
+// signedSubject 는 서명 토큰에 넣을 사용자 ID 와 값들을 줄바꿈으로 이어 붙입니다.
+// 이메일에는 줄바꿈이 들어갈 수 없으므로 구분자로 안전합니다.
+func signedSubject(userID int64, values ...string) string {
+	return strings.Join(append([]string{strconv.FormatInt(userID, 10)}, values...), "\n")
+}
+
+// mailLocale 은 메일의 언어를 고릅니다. 사용자가 언어를 정했으면 요청한 브라우저의 언어보다 우선합니다.
+func mailLocale(ctx context.Context, user *ent.User) i18n.Locale {
+	if locale, ok := i18n.Parse(user.Locale); ok {
+		return locale
+	}
+	return i18n.FromContext(ctx)
+}
+
+func parseLocale(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	locale, ok := i18n.Parse(s)
+	if !ok {
+		return "", apperror.ErrInvalidLocale
+	}
+	return string(locale), nil
 }
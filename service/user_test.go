@@ -1,14 +1,19 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"halill/apperror"
 	"halill/dto"
 	"halill/ent"
 	"halill/mocks"
+	"halill/notify"
 	"halill/security"
-	"net/http"
+	"strings"
 	"testing"
+	"time"
 
-	"github.com/labstack/echo/v4"
+	"github.com/golang-jwt/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/crypto/bcrypt"
@@ -16,12 +21,15 @@ import (
 
 func TestLoginUser(t *testing.T) {
 	ur := new(mocks.UserRepository)
+	rtr := new(mocks.RefreshTokenRepository)
+	rvr := new(mocks.RevokedTokenRepository)
 	jp := new(mocks.JWTProvider)
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
 	assert.NoError(t, err)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
 		Password: string(hashedPassword),
 		Name:     "조호원",
 	}
@@ -30,29 +38,57 @@ func TestLoginUser(t *testing.T) {
 			AccessToken:  "asdf.asdf.asdf",
 			RefreshToken: "asdf.asdf.asdf",
 		}
-		ur.On("GetByEmail", mock.AnythingOfType("string")).Return(user, nil)
+		ur.On("GetByEmail", mock.Anything, mock.AnythingOfType("string")).Return(user, nil)
 		jp.On("GenerateAccessToken", mock.AnythingOfType("*ent.User")).Return("asdf.asdf.asdf", nil)
 		jp.On("GenerateRefreshToken", mock.AnythingOfType("*ent.User")).Return("asdf.asdf.asdf", nil)
-		us := NewUserSerice(ur, jp)
+		rtr.On("Create", mock.Anything, mock.AnythingOfType("*ent.RefreshToken")).Return(&ent.RefreshToken{}, nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
 
-		resp, err := us.LoginUser(&dto.LoginRequest{
+		resp, err := us.LoginUser(context.Background(), &dto.LoginRequest{
 			Email:    "hwc9169@gmail.com",
 			Password: "password",
 		})
 		assert.NoError(t, err)
 		assert.Equal(t, expectedResponse, resp)
+		rtr.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(rt *ent.RefreshToken) bool {
+			return rt.TokenHash == security.HashToken("asdf.asdf.asdf") && rt.FamilyID != ""
+		}))
 	})
 	t.Run("User 로그인 실패", func(t *testing.T) {
-		ur.On("GetByEmail", mock.AnythingOfType("string")).Return(user, nil)
+		ur.On("GetByEmail", mock.Anything, mock.AnythingOfType("string")).Return(user, nil)
 		jp.On("GenerateAccessToken", mock.AnythingOfType("*ent.User")).Return("asdf.asdf.asdf", nil)
 		jp.On("GenerateRefreshToken", mock.AnythingOfType("*ent.User")).Return("asdf.asdf.asdf", nil)
-		us := NewUserSerice(ur, jp)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
 
-		_, err := us.LoginUser(&dto.LoginRequest{
+		_, err := us.LoginUser(context.Background(), &dto.LoginRequest{
 			Email:    "hwc9169@gmail.com",
 			Password: "different_password",
 		})
-		assert.EqualError(t, err, bcrypt.ErrMismatchedHashAndPassword.Error())
+		assert.Equal(t, apperror.ErrInvalidCredentials, err)
+	})
+	t.Run("인증하지 않은 사용자의 로그인을 막도록 설정", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		jp := new(mocks.JWTProvider)
+		ur.On("GetByEmail", mock.Anything, "hwc9169@gmail.com").Return(user, nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{RequiredForLogin: true})
+
+		_, err := us.LoginUser(context.Background(), &dto.LoginRequest{
+			Email:    "hwc9169@gmail.com",
+			Password: "password",
+		})
+		assert.Equal(t, apperror.ErrEmailNotVerified, err)
+		jp.AssertNotCalled(t, "GenerateAccessToken", mock.Anything)
+	})
+	t.Run("가입하지 않은 이메일", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		ur.On("GetByEmail", mock.Anything, "hwc9169@naver.com").Return(nil, apperror.ErrUserNotFound)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err := us.LoginUser(context.Background(), &dto.LoginRequest{
+			Email:    "hwc9169@naver.com",
+			Password: "password",
+		})
+		assert.Equal(t, apperror.ErrInvalidCredentials, err)
 	})
 }
 
@@ -60,23 +96,32 @@ func TestRegistUser(t *testing.T) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
 	assert.NoError(t, err)
 	user := &ent.User{
-		ID:       "hwc9169@gmail.com",
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
 		Password: string(hashedPassword),
 		Name:     "조호원",
 	}
 
 	t.Run("유저 회원가입 성공", func(t *testing.T) {
 		expectedResponse := &dto.UserResponse{
+			ID:    1,
 			Email: "hwc9169@gmail.com",
 			Name:  "조호원",
 		}
 		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
 		jp := new(mocks.JWTProvider)
-		ur.On("GetByEmail", mock.AnythingOfType("string")).Return(nil, &ent.NotFoundError{})
-		ur.On("CreateUser", mock.AnythingOfType("*ent.User")).Return(user, nil)
-		us := NewUserSerice(ur, jp)
+		ur.On("GetByEmail", mock.Anything, mock.AnythingOfType("string")).Return(nil, apperror.ErrUserNotFound)
+		ur.On("CreateUser", mock.Anything, mock.AnythingOfType("*ent.User")).Return(user, nil)
+		pp := new(mocks.PasswordPolicy)
+		pp.On("Validate", "password", "password", []string{"hwc9169@gmail.com", "조호원"}).Return(nil)
+		ur.On("UpdateVerificationSent", mock.Anything, int64(1), mock.AnythingOfType("time.Time"), 1).Return(user, nil)
+		mailer := new(mocks.Mailer)
+		mailer.On("Send", mock.AnythingOfType("*notify.Mail")).Return(nil)
+		us := NewUserService(ur, rtr, rvr, jp, pp, new(mocks.PasswordResetTokenRepository), mailer, PasswordResetConfig{}, security.NewTokenSigner([]byte("test_secret")), EmailVerificationConfig{TTL: 48 * time.Hour})
 
-		resp, err := us.RegistUser(&dto.RegistRequest{
+		resp, err := us.RegistUser(context.Background(), &dto.RegistRequest{
 			Email:    "hwc9169@gmail.com",
 			Password: "password",
 			Name:     "조호원",
@@ -84,52 +129,718 @@ func TestRegistUser(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedResponse, resp)
+		mail := mailer.Calls[0].Arguments.Get(0).(*notify.Mail)
+		assert.Equal(t, "hwc9169@gmail.com", mail.To)
+		assert.Contains(t, mail.Body, "48시간")
+	})
+	t.Run("인증 메일을 보내지 못해도 가입 성공", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		pp := new(mocks.PasswordPolicy)
+		mailer := new(mocks.Mailer)
+		ur.On("GetByEmail", mock.Anything, mock.AnythingOfType("string")).Return(nil, apperror.ErrUserNotFound)
+		ur.On("CreateUser", mock.Anything, mock.AnythingOfType("*ent.User")).Return(user, nil)
+		ur.On("UpdateVerificationSent", mock.Anything, int64(1), mock.AnythingOfType("time.Time"), 1).Return(user, nil)
+		pp.On("Validate", "password", mock.Anything, mock.Anything).Return(nil)
+		mailer.On("Send", mock.Anything).Return(errors.New("smtp down"))
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), pp, new(mocks.PasswordResetTokenRepository), mailer, PasswordResetConfig{}, security.NewTokenSigner([]byte("test_secret")), EmailVerificationConfig{})
+
+		resp, err := us.RegistUser(context.Background(), &dto.RegistRequest{
+			Email:    "hwc9169@gmail.com",
+			Password: "password",
+			Name:     "조호원",
+		})
+		assert.NoError(t, err)
+		assert.False(t, resp.EmailVerified)
 	})
 
 	t.Run("이미 사용중인 이메일일 때", func(t *testing.T) {
 		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
 		jp := new(mocks.JWTProvider)
-		ur.On("GetByEmail", mock.AnythingOfType("string")).Return(user, nil)
-		us := NewUserSerice(ur, jp)
+		ur.On("GetByEmail", mock.Anything, mock.AnythingOfType("string")).Return(user, nil)
+		pp := new(mocks.PasswordPolicy)
+		pp.On("Validate", "password", mock.Anything, mock.Anything).Return(nil)
+		us := NewUserService(ur, rtr, rvr, jp, pp, new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
 
-		_, err := us.RegistUser(&dto.RegistRequest{
+		_, err := us.RegistUser(context.Background(), &dto.RegistRequest{
 			Email:    "hwc9169@gmail.com",
 			Password: "password",
 			Name:     "조호원",
 		})
-		assert.EqualError(t, err, echo.NewHTTPError(http.StatusBadRequest, "이미 사용중인 이메일입니다.").Error())
+		assert.EqualError(t, err, apperror.ErrEmailTaken.Error())
+	})
+
+	t.Run("비밀번호 정책을 통과하지 못할 때", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		pp := security.NewPasswordPolicy(security.PasswordPolicyConfig{MinScore: 2}, nil)
+		us := NewUserService(ur, rtr, rvr, jp, pp, new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err := us.RegistUser(context.Background(), &dto.RegistRequest{
+			Email:    "hwc9169@gmail.com",
+			Password: "password",
+			Name:     "조호원",
+		})
+		assert.ErrorIs(t, err, apperror.ErrValidationFailed)
+		ur.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
 	})
 }
 
 func TestRefreshToken(t *testing.T) {
-	ur := new(mocks.UserRepository)
-	jp := new(mocks.JWTProvider)
-
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
 	assert.NoError(t, err)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: string(hashedPassword),
+		Name:     "조호원",
+	}
+	refreshToken, err := security.NewJWTProvider("test_secret").GenerateRefreshToken(user)
+	assert.NoError(t, err)
+	parsedToken, err := security.NewJWTProvider("test_secret").ParseToken(refreshToken)
+	assert.NoError(t, err)
+
 	t.Run("User 토큰 리프레시 성공", func(t *testing.T) {
-		user := &ent.User{
-			ID:       "hwc9169@gmail.com",
-			Password: string(hashedPassword),
-			Name:     "조호원",
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		stored := &ent.RefreshToken{
+			ID:        1,
+			FamilyID:  "family",
+			TokenHash: security.HashToken(refreshToken),
+			ExpiresAt: time.Now().Add(time.Hour),
 		}
-
-		refreshToken, err := security.NewJWTProvider("test_secret").GenerateRefreshToken(user)
-		assert.NoError(t, err)
-
 		expectedResponse := &dto.TokenResponse{
 			AccessToken:  "asdf.asdf.asdf",
-			RefreshToken: refreshToken,
+			RefreshToken: "qwer.qwer.qwer",
 		}
-		ur.On("GetByEmail", mock.AnythingOfType("string")).Return(user, nil)
-		jp.On("JwtSecret").Return("test_secret")
+		ur.On("Get", mock.Anything, int64(1)).Return(user, nil)
+		rtr.On("GetByHash", mock.Anything, security.HashToken(refreshToken)).Return(stored, nil)
+		rtr.On("Revoke", mock.Anything, int64(1)).Return(true, nil)
+		rtr.On("Create", mock.Anything, mock.AnythingOfType("*ent.RefreshToken")).Return(&ent.RefreshToken{}, nil)
+		jp.On("ParseToken", refreshToken).Return(parsedToken, nil)
 		jp.On("GenerateAccessToken", mock.AnythingOfType("*ent.User")).Return("asdf.asdf.asdf", nil)
-		us := NewUserSerice(ur, jp)
+		jp.On("GenerateRefreshToken", mock.AnythingOfType("*ent.User")).Return("qwer.qwer.qwer", nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
 
-		resp, err := us.RefreshToken(&dto.RefreshTokenRequest{
+		resp, err := us.RefreshToken(context.Background(), &dto.RefreshTokenRequest{
 			RefreshToken: refreshToken,
 		})
 		assert.NoError(t, err)
 		assert.Equal(t, expectedResponse, resp)
+		rtr.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(rt *ent.RefreshToken) bool {
+			return rt.FamilyID == "family" && rt.TokenHash == security.HashToken("qwer.qwer.qwer")
+		}))
+	})
+	t.Run("이미 사용된 토큰이면 family 전체 폐기", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		stored := &ent.RefreshToken{
+			ID:        1,
+			FamilyID:  "family",
+			TokenHash: security.HashToken(refreshToken),
+			ExpiresAt: time.Now().Add(time.Hour),
+			Revoked:   true,
+		}
+		rtr.On("GetByHash", mock.Anything, security.HashToken(refreshToken)).Return(stored, nil)
+		rtr.On("RevokeFamily", mock.Anything, "family").Return(nil)
+		jp.On("ParseToken", refreshToken).Return(parsedToken, nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err := us.RefreshToken(context.Background(), &dto.RefreshTokenRequest{
+			RefreshToken: refreshToken,
+		})
+		assert.Equal(t, apperror.ErrReusedToken, err)
+		rtr.AssertCalled(t, "RevokeFamily", mock.Anything, "family")
+		rtr.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+	t.Run("잘못된 서명의 토큰", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		jp.On("ParseToken", refreshToken).Return(nil, jwt.ErrSignatureInvalid)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err := us.RefreshToken(context.Background(), &dto.RefreshTokenRequest{
+			RefreshToken: refreshToken,
+		})
+		assert.Equal(t, apperror.ErrInvalidToken, err)
+		rtr.AssertNotCalled(t, "GetByHash", mock.Anything, mock.Anything)
+	})
+	t.Run("access token 으로는 재발급 불가", func(t *testing.T) {
+		accessToken, err := security.NewJWTProvider("test_secret").GenerateAccessToken(user)
+		assert.NoError(t, err)
+		parsedAccessToken, err := security.NewJWTProvider("test_secret").ParseToken(accessToken)
+		assert.NoError(t, err)
+
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		jp.On("ParseToken", accessToken).Return(parsedAccessToken, nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err = us.RefreshToken(context.Background(), &dto.RefreshTokenRequest{
+			RefreshToken: accessToken,
+		})
+		assert.Equal(t, apperror.ErrInvalidToken, err)
+		rtr.AssertNotCalled(t, "GetByHash", mock.Anything, mock.Anything)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	claims := &security.JwtCustomClaims{
+		UserID: 1,
+		StandardClaims: jwt.StandardClaims{
+			Id:        "access-jti",
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		},
+	}
+
+	t.Run("현재 세션 로그아웃 성공", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		rvr.On("Create", mock.Anything, mock.AnythingOfType("*ent.RevokedToken")).Return(&ent.RevokedToken{}, nil)
+		rvr.On("DeleteExpired", mock.Anything, mock.AnythingOfType("time.Time")).Return(0, nil)
+		rtr.On("GetByHash", mock.Anything, security.HashToken("refresh")).Return(&ent.RefreshToken{
+			FamilyID: "family",
+			Edges: ent.RefreshTokenEdges{
+				User: &ent.User{ID: 1, Email: "hwc9169@gmail.com"},
+			},
+		}, nil)
+		rtr.On("RevokeFamily", mock.Anything, "family").Return(nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		err := us.Logout(context.Background(), claims, &dto.LogoutRequest{RefreshToken: "refresh"})
+		assert.NoError(t, err)
+		rvr.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(rt *ent.RevokedToken) bool {
+			return rt.Jti == "access-jti"
+		}))
+		rtr.AssertCalled(t, "RevokeFamily", mock.Anything, "family")
+	})
+	t.Run("다른 사용자의 refresh token", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		rvr.On("Create", mock.Anything, mock.AnythingOfType("*ent.RevokedToken")).Return(&ent.RevokedToken{}, nil)
+		rvr.On("DeleteExpired", mock.Anything, mock.AnythingOfType("time.Time")).Return(0, nil)
+		rtr.On("GetByHash", mock.Anything, security.HashToken("refresh")).Return(&ent.RefreshToken{
+			FamilyID: "family",
+			Edges: ent.RefreshTokenEdges{
+				User: &ent.User{ID: 2, Email: "hwc9169@naver.com"},
+			},
+		}, nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		err := us.Logout(context.Background(), claims, &dto.LogoutRequest{RefreshToken: "refresh"})
+		assert.Equal(t, apperror.ErrForbidden, err)
+		rtr.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+	})
+}
+
+func TestLogoutAll(t *testing.T) {
+	t.Run("전체 로그아웃 성공", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		ur.On("IncrementTokenVersion", mock.Anything, int64(1)).Return(&ent.User{}, nil)
+		rtr.On("RevokeAllByUserID", mock.Anything, int64(1)).Return(nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		err := us.LogoutAll(context.Background(), &security.JwtCustomClaims{UserID: 1})
+		assert.NoError(t, err)
+		rtr.AssertCalled(t, "RevokeAllByUserID", mock.Anything, int64(1))
+	})
+}
+
+func TestVerifyAccessToken(t *testing.T) {
+	now := time.Now()
+	claims := &security.JwtCustomClaims{
+		UserID: 1,
+		StandardClaims: jwt.StandardClaims{
+			Id:       "access-jti",
+			IssuedAt: now.Add(-time.Hour).Unix(),
+		},
+	}
+
+	t.Run("유효한 토큰", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		rvr.On("Exists", mock.Anything, "access-jti").Return(false, nil)
+		ur.On("Get", mock.Anything, int64(1)).Return(&ent.User{ID: 1, Email: "hwc9169@gmail.com"}, nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		user, err := us.VerifyAccessToken(context.Background(), claims)
+		assert.NoError(t, err)
+		assert.Equal(t, "hwc9169@gmail.com", user.Email)
+	})
+	t.Run("로그아웃된 토큰", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		rvr.On("Exists", mock.Anything, "access-jti").Return(true, nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err := us.VerifyAccessToken(context.Background(), claims)
+		assert.Equal(t, apperror.ErrRevokedToken, err)
+	})
+	t.Run("전체 로그아웃 이전에 발급된 토큰", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		jp := new(mocks.JWTProvider)
+		rvr.On("Exists", mock.Anything, "access-jti").Return(false, nil)
+		ur.On("Get", mock.Anything, int64(1)).Return(&ent.User{ID: 1, Email: "hwc9169@gmail.com", TokenVersion: claims.TokenVersion + 1}, nil)
+		us := NewUserService(ur, rtr, rvr, jp, new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err := us.VerifyAccessToken(context.Background(), claims)
+		assert.Equal(t, apperror.ErrRevokedToken, err)
+	})
+}
+
+func TestUpdateLocale(t *testing.T) {
+	t.Run("언어 설정 변경", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		ur.On("UpdateLocale", mock.Anything, int64(1), "en").Return(&ent.User{ID: 1, Email: "hwc9169@gmail.com", Locale: "en"}, nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		resp, err := us.UpdateLocale(context.Background(), &dto.UpdateLocaleRequest{Locale: "EN"}, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "en", resp.Locale)
+	})
+	t.Run("지원하지 않는 언어", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err := us.UpdateLocale(context.Background(), &dto.UpdateLocaleRequest{Locale: "ja"}, 1)
+		assert.Equal(t, apperror.ErrInvalidLocale, err)
+		ur.AssertNotCalled(t, "UpdateLocale", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	assert.NoError(t, err)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: string(hashedPassword),
+		Name:     "조호원",
+	}
+
+	t.Run("비밀번호 변경 후 다른 세션 종료", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		jp := new(mocks.JWTProvider)
+		pp := new(mocks.PasswordPolicy)
+		prr := new(mocks.PasswordResetTokenRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(user, nil)
+		pp.On("Validate", "new_password", "n3w-Passw0rd", []string{"hwc9169@gmail.com", "조호원"}).Return(nil)
+		ur.On("UpdatePassword", mock.Anything, int64(1), mock.AnythingOfType("string")).Return(user, nil)
+		ur.On("IncrementTokenVersion", mock.Anything, int64(1)).Return(user, nil)
+		rtr.On("RevokeAllByUserID", mock.Anything, int64(1)).Return(nil)
+		prr.On("InvalidateAllByUserID", mock.Anything, int64(1), mock.AnythingOfType("time.Time")).Return(nil)
+		jp.On("GenerateAccessToken", user).Return("access", nil)
+		jp.On("GenerateRefreshToken", user).Return("refresh", nil)
+		rtr.On("Create", mock.Anything, mock.AnythingOfType("*ent.RefreshToken")).Return(&ent.RefreshToken{}, nil)
+		us := NewUserService(ur, rtr, new(mocks.RevokedTokenRepository), jp, pp, prr, new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		resp, err := us.ChangePassword(context.Background(), &dto.ChangePasswordRequest{
+			CurrentPassword: "password",
+			NewPassword:     "n3w-Passw0rd",
+		}, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.TokenResponse{AccessToken: "access", RefreshToken: "refresh"}, resp)
+		ur.AssertCalled(t, "UpdatePassword", mock.Anything, int64(1), mock.MatchedBy(func(hashed string) bool {
+			return bcrypt.CompareHashAndPassword([]byte(hashed), []byte("n3w-Passw0rd")) == nil
+		}))
+		rtr.AssertCalled(t, "RevokeAllByUserID", mock.Anything, int64(1))
+	})
+	t.Run("현재 비밀번호가 틀림", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(user, nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		_, err := us.ChangePassword(context.Background(), &dto.ChangePasswordRequest{
+			CurrentPassword: "wrong",
+			NewPassword:     "n3w-Passw0rd",
+		}, 1)
+		ae, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.ErrValidationFailed.Code, ae.Code)
+		assert.Equal(t, []apperror.FieldError{{Field: "current_password", Rule: "incorrect"}}, ae.Fields)
+		ur.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("변경 후 받은 토큰은 바로 사용 가능", func(t *testing.T) {
+		stored := *user
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		rvr := new(mocks.RevokedTokenRepository)
+		pp := new(mocks.PasswordPolicy)
+		prr := new(mocks.PasswordResetTokenRepository)
+		jp := security.NewJWTProvider("test_secret")
+		ur.On("Get", mock.Anything, int64(1)).Return(&stored, nil)
+		pp.On("Validate", "new_password", "n3w-Passw0rd", []string{"hwc9169@gmail.com", "조호원"}).Return(nil)
+		ur.On("UpdatePassword", mock.Anything, int64(1), mock.AnythingOfType("string")).Return(&stored, nil)
+		ur.On("IncrementTokenVersion", mock.Anything, int64(1)).
+			Run(func(mock.Arguments) {
+				stored.TokenVersion++
+			}).
+			Return(&stored, nil)
+		rtr.On("RevokeAllByUserID", mock.Anything, int64(1)).Return(nil)
+		prr.On("InvalidateAllByUserID", mock.Anything, int64(1), mock.AnythingOfType("time.Time")).Return(nil)
+		rtr.On("Create", mock.Anything, mock.AnythingOfType("*ent.RefreshToken")).Return(&ent.RefreshToken{}, nil)
+		rvr.On("Exists", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+		us := NewUserService(ur, rtr, rvr, jp, pp, prr, new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+		before, err := jp.GenerateAccessToken(&stored)
+		assert.NoError(t, err)
+
+		resp, err := us.ChangePassword(context.Background(), &dto.ChangePasswordRequest{
+			CurrentPassword: "password",
+			NewPassword:     "n3w-Passw0rd",
+		}, 1)
+		assert.NoError(t, err)
+		token, err := jp.ParseToken(resp.AccessToken)
+		assert.NoError(t, err)
+
+		_, err = us.VerifyAccessToken(context.Background(), token.Claims.(*security.JwtCustomClaims))
+		assert.NoError(t, err)
+
+		// 변경 직전에 같은 초 안에 발급된 토큰은 거부
+		token, err = jp.ParseToken(before)
+		assert.NoError(t, err)
+		_, err = us.VerifyAccessToken(context.Background(), token.Claims.(*security.JwtCustomClaims))
+		assert.Equal(t, apperror.ErrRevokedToken, err)
+	})
+}
+
+func TestForgotPassword(t *testing.T) {
+	user := &ent.User{ID: 1, Email: "hwc9169@gmail.com", Name: "조호원", Locale: "en"}
+	config := PasswordResetConfig{TTL: 30 * time.Minute, URL: "http://127.0.0.1/password/reset?token={token}"}
+
+	t.Run("재설정 링크를 메일로 전송", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		prr := new(mocks.PasswordResetTokenRepository)
+		mailer := new(mocks.Mailer)
+		ur.On("GetByEmail", mock.Anything, "hwc9169@gmail.com").Return(user, nil)
+		prr.On("Create", mock.Anything, mock.AnythingOfType("*ent.PasswordResetToken")).Return(&ent.PasswordResetToken{}, nil)
+		mailer.On("Send", mock.AnythingOfType("*notify.Mail")).Return(nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), prr, mailer, config, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		err := us.ForgotPassword(context.Background(), &dto.ForgotPasswordRequest{Email: "hwc9169@gmail.com"})
+		assert.NoError(t, err)
+
+		stored := prr.Calls[0].Arguments.Get(1).(*ent.PasswordResetToken)
+		assert.WithinDuration(t, time.Now().Add(30*time.Minute), stored.ExpiresAt, time.Minute)
+		mail := mailer.Calls[0].Arguments.Get(0).(*notify.Mail)
+		assert.Equal(t, "hwc9169@gmail.com", mail.To)
+		assert.Equal(t, "[halill] Reset your password", mail.Subject)
+		assert.Contains(t, mail.Body, "within 30 minutes")
+		// 메일에는 원문을, 저장소에는 해시만 남김
+		token := mail.Body[strings.Index(mail.Body, "token=")+len("token=") : strings.Index(mail.Body, "\n\n")]
+		assert.Equal(t, security.HashToken(token), stored.TokenHash)
+	})
+	t.Run("가입하지 않은 이메일도 성공", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		prr := new(mocks.PasswordResetTokenRepository)
+		mailer := new(mocks.Mailer)
+		ur.On("GetByEmail", mock.Anything, "nobody@gmail.com").Return(nil, apperror.ErrUserNotFound)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), prr, mailer, config, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		err := us.ForgotPassword(context.Background(), &dto.ForgotPasswordRequest{Email: "nobody@gmail.com"})
+		assert.NoError(t, err)
+		prr.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		mailer.AssertNotCalled(t, "Send", mock.Anything)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	user := &ent.User{ID: 1, Email: "hwc9169@gmail.com", Name: "조호원"}
+	storedToken := func(expiresAt time.Time, usedAt *time.Time) *ent.PasswordResetToken {
+		return &ent.PasswordResetToken{
+			ID:        1,
+			TokenHash: security.HashToken("reset-token"),
+			ExpiresAt: expiresAt,
+			UsedAt:    usedAt,
+			Edges:     ent.PasswordResetTokenEdges{User: user},
+		}
+	}
+
+	t.Run("토큰으로 비밀번호 재설정", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		rtr := new(mocks.RefreshTokenRepository)
+		pp := new(mocks.PasswordPolicy)
+		prr := new(mocks.PasswordResetTokenRepository)
+		prr.On("GetByHash", mock.Anything, security.HashToken("reset-token")).Return(storedToken(time.Now().Add(time.Hour), nil), nil)
+		pp.On("Validate", "new_password", "n3w-Passw0rd", []string{"hwc9169@gmail.com", "조호원"}).Return(nil)
+		prr.On("Use", mock.Anything, int64(1), mock.AnythingOfType("time.Time")).Return(true, nil)
+		ur.On("UpdatePassword", mock.Anything, int64(1), mock.AnythingOfType("string")).Return(user, nil)
+		ur.On("IncrementTokenVersion", mock.Anything, int64(1)).Return(user, nil)
+		rtr.On("RevokeAllByUserID", mock.Anything, int64(1)).Return(nil)
+		prr.On("InvalidateAllByUserID", mock.Anything, int64(1), mock.AnythingOfType("time.Time")).Return(nil)
+		us := NewUserService(ur, rtr, new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), pp, prr, new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		err := us.ResetPassword(context.Background(), &dto.ResetPasswordRequest{Token: "reset-token", NewPassword: "n3w-Passw0rd"})
+		assert.NoError(t, err)
+		rtr.AssertCalled(t, "RevokeAllByUserID", mock.Anything, int64(1))
+	})
+	t.Run("만료되거나 이미 사용한 토큰", func(t *testing.T) {
+		usedAt := time.Now().Add(-time.Minute)
+		for name, stored := range map[string]*ent.PasswordResetToken{
+			"만료": storedToken(time.Now().Add(-time.Minute), nil),
+			"사용": storedToken(time.Now().Add(time.Hour), &usedAt),
+		} {
+			prr := new(mocks.PasswordResetTokenRepository)
+			prr.On("GetByHash", mock.Anything, security.HashToken("reset-token")).Return(stored, nil)
+			us := NewUserService(new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), prr, new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+			err := us.ResetPassword(context.Background(), &dto.ResetPasswordRequest{Token: "reset-token", NewPassword: "n3w-Passw0rd"})
+			assert.Equal(t, apperror.ErrInvalidResetToken, err, name)
+		}
+	})
+	t.Run("사용자가 없는 토큰", func(t *testing.T) {
+		stored := storedToken(time.Now().Add(time.Hour), nil)
+		stored.Edges.User = nil
+		pp := new(mocks.PasswordPolicy)
+		prr := new(mocks.PasswordResetTokenRepository)
+		prr.On("GetByHash", mock.Anything, security.HashToken("reset-token")).Return(stored, nil)
+		us := NewUserService(new(mocks.UserRepository), new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), pp, prr, new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		err := us.ResetPassword(context.Background(), &dto.ResetPasswordRequest{Token: "reset-token", NewPassword: "n3w-Passw0rd"})
+		assert.Equal(t, apperror.ErrInvalidResetToken, err)
+		pp.AssertNotCalled(t, "Validate", mock.Anything, mock.Anything, mock.Anything)
+		prr.AssertNotCalled(t, "Use", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("정책을 통과하지 못하면 토큰을 남김", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		prr := new(mocks.PasswordResetTokenRepository)
+		prr.On("GetByHash", mock.Anything, security.HashToken("reset-token")).Return(storedToken(time.Now().Add(time.Hour), nil), nil)
+		pp := security.NewPasswordPolicy(security.PasswordPolicyConfig{MinScore: 2}, nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), pp, prr, new(mocks.Mailer), PasswordResetConfig{}, new(mocks.TokenSigner), EmailVerificationConfig{})
+
+		err := us.ResetPassword(context.Background(), &dto.ResetPasswordRequest{Token: "reset-token", NewPassword: "password"})
+		assert.ErrorIs(t, err, apperror.ErrValidationFailed)
+		prr.AssertNotCalled(t, "Use", mock.Anything, mock.Anything, mock.Anything)
+		ur.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestVerifyEmail(t *testing.T) {
+	signer := security.NewTokenSigner([]byte("test_secret"))
+	token := signer.Sign(emailVerificationPurpose, signedSubject(1, "hwc9169@gmail.com"), time.Now().Add(time.Hour))
+
+	t.Run("인증 링크로 인증 완료", func(t *testing.T) {
+		verifiedAt := time.Now()
+		ur := new(mocks.UserRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(&ent.User{ID: 1, Email: "hwc9169@gmail.com"}, nil)
+		ur.On("MarkEmailVerified", mock.Anything, int64(1), mock.AnythingOfType("time.Time")).Return(&ent.User{ID: 1, Email: "hwc9169@gmail.com", EmailVerifiedAt: &verifiedAt}, nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, signer, EmailVerificationConfig{})
+
+		resp, err := us.VerifyEmail(context.Background(), &dto.VerifyEmailRequest{Token: token})
+		assert.NoError(t, err)
+		assert.True(t, resp.EmailVerified)
+	})
+	t.Run("이미 인증한 사용자", func(t *testing.T) {
+		verifiedAt := time.Now().Add(-time.Hour)
+		ur := new(mocks.UserRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(&ent.User{ID: 1, Email: "hwc9169@gmail.com", EmailVerifiedAt: &verifiedAt}, nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, signer, EmailVerificationConfig{})
+
+		resp, err := us.VerifyEmail(context.Background(), &dto.VerifyEmailRequest{Token: token})
+		assert.NoError(t, err)
+		assert.True(t, resp.EmailVerified)
+		ur.AssertNotCalled(t, "MarkEmailVerified", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("만료되었거나 다른 용도의 토큰", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, signer, EmailVerificationConfig{})
+
+		for _, token := range []string{
+			signer.Sign(emailVerificationPurpose, signedSubject(1, "hwc9169@gmail.com"), time.Now().Add(-time.Minute)),
+			signer.Sign("password_reset", signedSubject(1, "hwc9169@gmail.com"), time.Now().Add(time.Hour)),
+		} {
+			_, err := us.VerifyEmail(context.Background(), &dto.VerifyEmailRequest{Token: token})
+			assert.ErrorIs(t, err, apperror.ErrInvalidVerificationToken)
+		}
+		ur.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+	t.Run("이메일을 바꾸기 전에 보낸 인증 링크", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(&ent.User{ID: 1, Email: "hwc9169@naver.com"}, nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, signer, EmailVerificationConfig{})
+
+		_, err := us.VerifyEmail(context.Background(), &dto.VerifyEmailRequest{Token: token})
+		assert.ErrorIs(t, err, apperror.ErrInvalidVerificationToken)
+		ur.AssertNotCalled(t, "MarkEmailVerified", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestChangeEmail(t *testing.T) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	assert.NoError(t, err)
+	user := &ent.User{
+		ID:       1,
+		Email:    "hwc9169@gmail.com",
+		Password: string(hashedPassword),
+		Name:     "조호원",
+	}
+	signer := security.NewTokenSigner([]byte("test_secret"))
+	config := EmailVerificationConfig{TTL: 48 * time.Hour, ChangeURL: "https://halill.example/confirm-email-change?token={token}"}
+
+	t.Run("새 주소로 확인 링크 전송", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(user, nil)
+		ur.On("GetByEmail", mock.Anything, "hwc9169@naver.com").Return(nil, apperror.ErrUserNotFound)
+		mailer := new(mocks.Mailer)
+		mailer.On("Send", mock.AnythingOfType("*notify.Mail")).Return(nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), mailer, PasswordResetConfig{}, signer, config)
+
+		err := us.ChangeEmail(context.Background(), &dto.ChangeEmailRequest{NewEmail: "hwc9169@naver.com", Password: "password"}, 1)
+		assert.NoError(t, err)
+		mail := mailer.Calls[0].Arguments.Get(0).(*notify.Mail)
+		assert.Equal(t, "hwc9169@naver.com", mail.To)
+		assert.Contains(t, mail.Body, "hwc9169@naver.com")
+
+		token := mail.Body[strings.Index(mail.Body, "token=")+len("token=") : strings.Index(mail.Body, "\n\n")]
+		subject, err := signer.Verify(emailChangePurpose, token, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, signedSubject(1, "hwc9169@gmail.com", "hwc9169@naver.com"), subject)
+		ur.AssertNotCalled(t, "UpdateEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("비밀번호가 틀림", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(user, nil)
+		mailer := new(mocks.Mailer)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), mailer, PasswordResetConfig{}, signer, config)
+
+		err := us.ChangeEmail(context.Background(), &dto.ChangeEmailRequest{NewEmail: "hwc9169@naver.com", Password: "wrong"}, 1)
+		ae, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, []apperror.FieldError{{Field: "password", Rule: "incorrect"}}, ae.Fields)
+		mailer.AssertNotCalled(t, "Send", mock.Anything)
+	})
+	t.Run("이미 사용중인 이메일", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(user, nil)
+		ur.On("GetByEmail", mock.Anything, "hwc9169@naver.com").Return(&ent.User{ID: 2, Email: "hwc9169@naver.com"}, nil)
+		mailer := new(mocks.Mailer)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), mailer, PasswordResetConfig{}, signer, config)
+
+		err := us.ChangeEmail(context.Background(), &dto.ChangeEmailRequest{NewEmail: "hwc9169@naver.com", Password: "password"}, 1)
+		assert.Equal(t, apperror.ErrEmailTaken, err)
+		mailer.AssertNotCalled(t, "Send", mock.Anything)
+	})
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	signer := security.NewTokenSigner([]byte("test_secret"))
+	token := signer.Sign(emailChangePurpose, signedSubject(1, "hwc9169@gmail.com", "hwc9169@naver.com"), time.Now().Add(time.Hour))
+
+	t.Run("이메일 변경 완료", func(t *testing.T) {
+		verifiedAt := time.Now()
+		ur := new(mocks.UserRepository)
+		prr := new(mocks.PasswordResetTokenRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(&ent.User{ID: 1, Email: "hwc9169@gmail.com"}, nil)
+		ur.On("UpdateEmail", mock.Anything, int64(1), "hwc9169@naver.com", mock.AnythingOfType("time.Time")).Return(&ent.User{ID: 1, Email: "hwc9169@naver.com", EmailVerifiedAt: &verifiedAt}, nil)
+		prr.On("InvalidateAllByUserID", mock.Anything, int64(1), mock.AnythingOfType("time.Time")).Return(nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), prr, new(mocks.Mailer), PasswordResetConfig{}, signer, EmailVerificationConfig{})
+
+		resp, err := us.ConfirmEmailChange(context.Background(), &dto.ConfirmEmailChangeRequest{Token: token})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), resp.ID)
+		assert.Equal(t, "hwc9169@naver.com", resp.Email)
+		assert.True(t, resp.EmailVerified)
+		prr.AssertCalled(t, "InvalidateAllByUserID", mock.Anything, int64(1), mock.AnythingOfType("time.Time"))
+	})
+	t.Run("이미 이메일이 바뀐 뒤의 링크", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		ur.On("Get", mock.Anything, int64(1)).Return(&ent.User{ID: 1, Email: "hwc9169@daum.net"}, nil)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, signer, EmailVerificationConfig{})
+
+		_, err := us.ConfirmEmailChange(context.Background(), &dto.ConfirmEmailChangeRequest{Token: token})
+		assert.ErrorIs(t, err, apperror.ErrInvalidVerificationToken)
+		ur.AssertNotCalled(t, "UpdateEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("인증 링크 토큰으로는 변경 불가", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		us := NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), new(mocks.Mailer), PasswordResetConfig{}, signer, EmailVerificationConfig{})
+
+		forged := signer.Sign(emailVerificationPurpose, signedSubject(1, "hwc9169@gmail.com", "hwc9169@naver.com"), time.Now().Add(time.Hour))
+		_, err := us.ConfirmEmailChange(context.Background(), &dto.ConfirmEmailChangeRequest{Token: forged})
+		assert.ErrorIs(t, err, apperror.ErrInvalidVerificationToken)
+		ur.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+}
+
+func TestResendVerification(t *testing.T) {
+	config := EmailVerificationConfig{
+		TTL:            48 * time.Hour,
+		ResendCooldown: time.Minute,
+		MaxSends:       3,
+		ResendWindow:   24 * time.Hour,
+	}
+	unverified := func(sentAgo time.Duration, sends int) *ent.User {
+		sentAt := time.Now().Add(-sentAgo)
+		return &ent.User{ID: 1, Email: "hwc9169@gmail.com", Name: "조호원", VerificationSentAt: &sentAt, VerificationSends: sends}
+	}
+	newService := func(ur *mocks.UserRepository, mailer *mocks.Mailer) UserService {
+		return NewUserService(ur, new(mocks.RefreshTokenRepository), new(mocks.RevokedTokenRepository), new(mocks.JWTProvider), new(mocks.PasswordPolicy), new(mocks.PasswordResetTokenRepository), mailer, PasswordResetConfig{}, security.NewTokenSigner([]byte("test_secret")), config)
+	}
+
+	t.Run("인증 메일 재전송", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		mailer := new(mocks.Mailer)
+		ur.On("GetByEmail", mock.Anything, "hwc9169@gmail.com").Return(unverified(10*time.Minute, 1), nil)
+		ur.On("UpdateVerificationSent", mock.Anything, int64(1), mock.AnythingOfType("time.Time"), 2).Return(&ent.User{}, nil)
+		mailer.On("Send", mock.AnythingOfType("*notify.Mail")).Return(nil)
+
+		err := newService(ur, mailer).ResendVerification(context.Background(), &dto.ResendVerificationRequest{Email: "hwc9169@gmail.com"})
+		assert.NoError(t, err)
+		mailer.AssertNumberOfCalls(t, "Send", 1)
+	})
+	t.Run("너무 빨리 다시 요청하거나 횟수를 넘으면 거부", func(t *testing.T) {
+		for _, user := range []*ent.User{unverified(10*time.Second, 1), unverified(time.Hour, 3)} {
+			ur := new(mocks.UserRepository)
+			mailer := new(mocks.Mailer)
+			ur.On("GetByEmail", mock.Anything, "hwc9169@gmail.com").Return(user, nil)
+
+			err := newService(ur, mailer).ResendVerification(context.Background(), &dto.ResendVerificationRequest{Email: "hwc9169@gmail.com"})
+			assert.Equal(t, apperror.ErrVerificationRateLimited, err)
+			mailer.AssertNotCalled(t, "Send", mock.Anything)
+		}
+	})
+	t.Run("한동안 보내지 않았으면 횟수를 다시 셈", func(t *testing.T) {
+		ur := new(mocks.UserRepository)
+		mailer := new(mocks.Mailer)
+		ur.On("GetByEmail", mock.Anything, "hwc9169@gmail.com").Return(unverified(25*time.Hour, 3), nil)
+		ur.On("UpdateVerificationSent", mock.Anything, int64(1), mock.AnythingOfType("time.Time"), 1).Return(&ent.User{}, nil)
+		mailer.On("Send", mock.AnythingOfType("*notify.Mail")).Return(nil)
+
+		err := newService(ur, mailer).ResendVerification(context.Background(), &dto.ResendVerificationRequest{Email: "hwc9169@gmail.com"})
+		assert.NoError(t, err)
+	})
+	t.Run("이미 인증한 사용자에게는 보내지 않음", func(t *testing.T) {
+		verifiedAt := time.Now()
+		ur := new(mocks.UserRepository)
+		mailer := new(mocks.Mailer)
+		ur.On("GetByEmail", mock.Anything, "hwc9169@gmail.com").Return(&ent.User{ID: 1, Email: "hwc9169@gmail.com", EmailVerifiedAt: &verifiedAt}, nil)
+
+		err := newService(ur, mailer).ResendVerification(context.Background(), &dto.ResendVerificationRequest{Email: "hwc9169@gmail.com"})
+		assert.NoError(t, err)
+		mailer.AssertNotCalled(t, "Send", mock.Anything)
 	})
 }
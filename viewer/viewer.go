@@ -0,0 +1,28 @@
+// Package viewer 는 요청을 보낸 사용자를 context 로 데이터 계층까지 전달합니다.
+// ent 의 privacy 정책은 이 값으로 조회하거나 수정할 수 있는 행을 결정합니다.
+package viewer
+
+import "context"
+
+type Viewer struct {
+	UserID int64
+	// System 은 로그인 전 요청이나 백그라운드 작업처럼 특정 사용자로 한정되지 않는 접근입니다.
+	System bool
+}
+
+type ctxKey struct{}
+
+func NewContext(parent context.Context, v *Viewer) context.Context {
+	return context.WithValue(parent, ctxKey{}, v)
+}
+
+// NewSystemContext 는 모든 사용자의 행에 접근할 수 있는 context 를 반환합니다.
+func NewSystemContext(parent context.Context) context.Context {
+	return NewContext(parent, &Viewer{System: true})
+}
+
+// FromContext 는 ctx 의 viewer 를 반환합니다. 없으면 nil 입니다.
+func FromContext(ctx context.Context) *Viewer {
+	v, _ := ctx.Value(ctxKey{}).(*Viewer)
+	return v
+}